@@ -26,23 +26,25 @@ import (
 )
 
 type BenchmarkOptions struct {
-	masters          *string
-	concurrency      *int
-	numberOfFiles    *int
-	fileSize         *int
-	idListFile       *string
-	write            *bool
-	deletePercentage *int
-	read             *bool
-	sequentialRead   *bool
-	collection       *string
-	replication      *string
-	diskType         *string
-	cpuprofile       *string
-	maxCpu           *int
-	grpcDialOption   grpc.DialOption
-	masterClient     *wdclient.MasterClient
-	fsync            *bool
+	masters           *string
+	concurrency       *int
+	numberOfFiles     *int
+	fileSize          *int
+	idListFile        *string
+	write             *bool
+	deletePercentage  *int
+	read              *bool
+	sequentialRead    *bool
+	collection        *string
+	replication       *string
+	diskType          *string
+	cpuprofile        *string
+	maxCpu            *int
+	grpcDialOption    grpc.DialOption
+	masterClient      *wdclient.MasterClient
+	fsync             *bool
+	backendDir        *string
+	backendFileSizeMB *int
 }
 
 var (
@@ -69,6 +71,8 @@ func init() {
 	b.cpuprofile = cmdBenchmark.Flag.String("cpuprofile", "", "cpu profile output file")
 	b.maxCpu = cmdBenchmark.Flag.Int("maxCpu", 0, "maximum number of CPUs. 0 means all available CPUs")
 	b.fsync = cmdBenchmark.Flag.Bool("fsync", false, "flush data to disk after write")
+	b.backendDir = cmdBenchmark.Flag.String("backend.dir", "", "compare random reads of the buffered and direct io volume backends on a file in this local directory, instead of benchmarking the cluster")
+	b.backendFileSizeMB = cmdBenchmark.Flag.Int("backend.fileSizeMB", 4096, "size of the file read by -backend.dir, larger than the memory to benchmark uncached reads")
 	sharedBytes = make([]byte, 1024)
 }
 
@@ -101,6 +105,11 @@ var cmdBenchmark = &Command{
   After benchmarking, you can clean up the written data by deleting the benchmark collection
     http://localhost:9333/col/delete?collection=benchmark
 
+  With -backend.dir, no cluster is needed. It compares random reads of "-size" bytes
+  on a local file, read by the buffered backend and by the direct io backend,
+  which volume servers use with "-dir.io=direct".
+    weed benchmark -backend.dir=/data -backend.fileSizeMB=8192 -c=16 -n=100000
+
   `,
 }
 
@@ -129,6 +138,11 @@ func runBenchmark(cmd *Command, args []string) bool {
 		defer pprof.StopCPUProfile()
 	}
 
+	if *b.backendDir != "" {
+		benchBackends()
+		return true
+	}
+
 	b.masterClient = wdclient.NewMasterClient(b.grpcDialOption, "", "client", "", "", "", *pb.ServerAddresses(*b.masters).ToServiceDiscovery())
 	ctx := context.Background()
 	go b.masterClient.KeepConnectedToMaster(ctx)
@@ -0,0 +1,89 @@
+package command
+
+import (
+	"fmt"
+	"math/rand"
+	"os"
+	"path/filepath"
+	"time"
+
+	"github.com/gateway-dao/seaweedfs/weed/storage/backend"
+	"github.com/gateway-dao/seaweedfs/weed/storage/types"
+)
+
+// benchBackends compares random reads of the volume data file backends on the local disk.
+func benchBackends() {
+	fileName := filepath.Join(*b.backendDir, "benchmark_backend.dat")
+	f, err := os.OpenFile(fileName, os.O_RDWR|os.O_CREATE|os.O_TRUNC, 0644)
+	if err != nil {
+		fmt.Printf("create %s: %v\n", fileName, err)
+		return
+	}
+	defer os.Remove(fileName)
+	diskFile := backend.NewDiskFile(f)
+	defer diskFile.Close()
+
+	fileSize := int64(*b.backendFileSizeMB) * 1024 * 1024
+	fmt.Printf("Writing %d MB to %s\n", *b.backendFileSizeMB, fileName)
+	chunk := make([]byte, 4*1024*1024)
+	for written := int64(0); written < fileSize; written += int64(len(chunk)) {
+		rand.Read(chunk)
+		if _, err = diskFile.Write(chunk); err != nil {
+			fmt.Printf("write %s: %v\n", fileName, err)
+			return
+		}
+	}
+
+	directFile, err := backend.NewDirectFile(diskFile)
+	if err != nil {
+		fmt.Printf("direct io is not available on %s: %v\n", *b.backendDir, err)
+		return
+	}
+	// flush the written data, and drop it from the page cache
+	if err = directFile.Sync(); err != nil {
+		fmt.Printf("sync %s: %v\n", fileName, err)
+		return
+	}
+
+	benchBackendRead("Buffered Reading Benchmark", diskFile, fileSize)
+	benchBackendRead("Direct Reading Benchmark", directFile, fileSize)
+}
+
+func benchBackendRead(testName string, file backend.BackendStorageFile, fileSize int64) {
+	finishChan := make(chan bool)
+	readStats = newStats(*b.concurrency)
+	readStats.total = *b.numberOfFiles
+	readStats.start = time.Now()
+	go readStats.checkProgress(testName, finishChan)
+
+	maxOffset := (fileSize - int64(*b.fileSize) - 64) / types.NeedlePaddingSize
+	for i := 0; i < *b.concurrency; i++ {
+		count := *b.numberOfFiles / *b.concurrency
+		if i < *b.numberOfFiles%*b.concurrency {
+			count++
+		}
+		wait.Add(1)
+		go func(s *stat, count int) {
+			defer wait.Done()
+			for j := 0; j < count; j++ {
+				p := make([]byte, *b.fileSize+rand.Intn(64))
+				offset := rand.Int63n(maxOffset) * types.NeedlePaddingSize
+				start := time.Now()
+				if n, err := file.ReadAt(p, offset); err != nil {
+					s.failed++
+				} else {
+					s.completed++
+					s.transferred += int64(n)
+					readStats.addSample(time.Now().Sub(start))
+				}
+			}
+		}(&readStats.localStats[i], count)
+	}
+	wait.Wait()
+	readStats.end = time.Now()
+	wait.Add(1)
+	finishChan <- true
+	wait.Wait()
+	close(finishChan)
+	readStats.printStats()
+}
@@ -25,6 +25,7 @@ import (
 	_ "github.com/gateway-dao/seaweedfs/weed/filer/mongodb"
 	_ "github.com/gateway-dao/seaweedfs/weed/filer/mysql"
 	_ "github.com/gateway-dao/seaweedfs/weed/filer/mysql2"
+	_ "github.com/gateway-dao/seaweedfs/weed/filer/pebble"
 	_ "github.com/gateway-dao/seaweedfs/weed/filer/postgres"
 	_ "github.com/gateway-dao/seaweedfs/weed/filer/postgres2"
 	_ "github.com/gateway-dao/seaweedfs/weed/filer/redis"
@@ -60,6 +60,7 @@ type MasterOptions struct {
 	raftHashicorp      *bool
 	raftBootstrap      *bool
 	eventsDir          string
+	encryptionKeyring  *string
 }
 
 func init() {
@@ -85,6 +86,7 @@ func init() {
 	m.electionTimeout = cmdMaster.Flag.Duration("electionTimeout", 10*time.Second, "election timeout of master servers")
 	m.raftHashicorp = cmdMaster.Flag.Bool("raftHashicorp", false, "use hashicorp raft")
 	m.raftBootstrap = cmdMaster.Flag.Bool("raftBootstrap", false, "Whether to bootstrap the Raft cluster")
+	m.encryptionKeyring = cmdMaster.Flag.String("encryption.keyring", "", "keyring file, or <kms>://... location, of the master keys wrapping the data keys of new volumes. Needed if volume servers encrypt data at rest.")
 	m.eventsDir = *masterEventsDir
 }
 
@@ -348,6 +350,11 @@ func (m *MasterOptions) toMasterOption(whiteList []string) *weed_server.MasterOp
 		glog.Fatalf("Unable to establish connection to EventStore (LevelDB): %s", es_err)
 	}
 
+	keyWrapper, keyringErr := security.NewKeyWrapper(*m.encryptionKeyring)
+	if keyringErr != nil {
+		glog.Fatalf("load encryption keyring: %v", keyringErr)
+	}
+
 	return &weed_server.MasterOption{
 		Master:            masterAddress,
 		MetaFolder:        *m.metaFolder,
@@ -361,5 +368,6 @@ func (m *MasterOptions) toMasterOption(whiteList []string) *weed_server.MasterOp
 		MetricsAddress:          *m.metricsAddress,
 		MetricsIntervalSec:      *m.metricsIntervalSec,
 		EventStore:              eventStore,
+		KeyWrapper:              keyWrapper,
 	}
 }
@@ -125,9 +125,11 @@ func init() {
 	serverOptions.v.publicPort = cmdServer.Flag.Int("volume.port.public", 0, "volume server public port")
 	serverOptions.v.indexType = cmdServer.Flag.String("volume.index", "memory", "Choose [memory|leveldb|leveldbMedium|leveldbLarge] mode for memory~performance balance.")
 	serverOptions.v.diskType = cmdServer.Flag.String("volume.disk", "", "[hdd|ssd|<tag>] hard drive or solid state drive or any tag")
+	serverOptions.v.diskIo = cmdServer.Flag.String("volume.dir.io", "buffered", "[buffered|direct] how to read volume data files, comma separated for each -dir. direct bypasses the page cache with O_DIRECT and io_uring, on linux only")
 	serverOptions.v.fixJpgOrientation = cmdServer.Flag.Bool("volume.images.fix.orientation", false, "Adjust jpg orientation when uploading.")
 	serverOptions.v.readMode = cmdServer.Flag.String("volume.readMode", "proxy", "[local|proxy|redirect] how to deal with non-local volume: 'not found|read in remote node|redirect volume location'.")
 	serverOptions.v.compactionMBPerSecond = cmdServer.Flag.Int("volume.compactionMBps", 0, "limit compaction speed in mega bytes per second")
+	serverOptions.v.compactionSegmentMB = cmdServer.Flag.Int("volume.compactionSegmentMB", 0, "if positive, vacuum compacts volumes in place in segments of this size, needing only one segment of free disk space instead of a full volume copy")
 	serverOptions.v.fileSizeLimitMB = cmdServer.Flag.Int("volume.fileSizeLimitMB", 256, "limit file size to avoid out of memory")
 	serverOptions.v.ldbTimeout = cmdServer.Flag.Int64("volume.index.leveldbTimeout", 0, "alive time for leveldb (default to 0). If leveldb of volume is not accessed in ldbTimeout hours, it will be off loaded to reduce opened files and memory consumption.")
 	serverOptions.v.concurrentUploadLimitMB = cmdServer.Flag.Int("volume.concurrentUploadLimitMB", 64, "limit total concurrent upload size")
@@ -139,6 +141,15 @@ func init() {
 	serverOptions.v.inflightUploadDataTimeout = cmdServer.Flag.Duration("volume.inflightUploadDataTimeout", 60*time.Second, "inflight upload data wait timeout of volume servers")
 	serverOptions.v.hasSlowRead = cmdServer.Flag.Bool("volume.hasSlowRead", true, "<experimental> if true, this prevents slow reads from blocking other requests, but large file read P99 latency will increase.")
 	serverOptions.v.readBufferSizeMB = cmdServer.Flag.Int("volume.readBufferSizeMB", 4, "<experimental> larger values can optimize query performance but will increase some memory usage,Use with hasSlowRead normally")
+	serverOptions.v.scrubInterval = cmdServer.Flag.Duration("volume.scrub.interval", 0, "interval to verify needle checksums of all local volumes and ec shards, 0 to disable. Corrupted needles are quarantined and repaired from replicas if possible.")
+	serverOptions.v.scrubMBps = cmdServer.Flag.Int("volume.scrub.MBps", 10, "limit background scrubbing reads in MB/s, 0 means unlimited")
+	serverOptions.v.readRepair = cmdServer.Flag.Bool("volume.readRepair", true, "fetch a corrupted or missing needle of a replicated volume from other replicas when reading, and rewrite the local copy")
+	serverOptions.v.cacheMemoryMB = cmdServer.Flag.Int("volume.cache.memoryMB", 0, "memory of the cache for popular small needles, 0 to disable")
+	serverOptions.v.cacheDir = cmdServer.Flag.String("volume.cache.dir", "", "directory of the on disk layer of the needle cache, usually on a ssd")
+	serverOptions.v.cacheDiskMB = cmdServer.Flag.Int("volume.cache.diskMB", 0, "disk space of the on disk layer of the needle cache in -volume.cache.dir")
+	serverOptions.v.cacheMaxNeedleKB = cmdServer.Flag.Int("volume.cache.maxNeedleKB", 256, "only cache needles up to this size")
+	serverOptions.v.compression = cmdServer.Flag.String("volume.compression", "gzip", "[gzip|zstd] how to compress compressible uploads not compressed by clients. zstd uses the dictionary trained for the collection by collection.compression.train, if any.")
+	serverOptions.v.encryptionKeyring = cmdServer.Flag.String("volume.encryption.keyring", "", "keyring file, or <kms>://... location, of the master keys to encrypt needle data of new volumes at rest. Existing encrypted volumes also need it to be read.")
 
 	s3Options.port = cmdServer.Flag.Int("s3.port", 8333, "s3 server http listen port")
 	s3Options.portHttps = cmdServer.Flag.Int("s3.port.https", 0, "s3 server https listen port")
@@ -237,6 +248,8 @@ func runServer(cmd *Command, args []string) bool {
 	s3Options.dataCenter = serverDataCenter
 	filerOptions.disableHttp = serverDisableHttp
 	masterOptions.disableHttp = serverDisableHttp
+	// the master wraps the data keys with the same keyring the volume server unwraps them with
+	masterOptions.encryptionKeyring = serverOptions.v.encryptionKeyring
 
 	filerAddress := string(pb.NewServerAddress(*serverIp, *filerOptions.port, *filerOptions.portGrpc))
 	s3Options.filer = &filerAddress
@@ -31,7 +31,9 @@ import (
 	weed_server "github.com/gateway-dao/seaweedfs/weed/server"
 	stats_collect "github.com/gateway-dao/seaweedfs/weed/stats"
 	"github.com/gateway-dao/seaweedfs/weed/storage"
+	"github.com/gateway-dao/seaweedfs/weed/storage/backend"
 	"github.com/gateway-dao/seaweedfs/weed/util"
+	"github.com/gateway-dao/seaweedfs/weed/util/chunk_cache"
 )
 
 var (
@@ -56,11 +58,13 @@ type VolumeServerOptions struct {
 	whiteList                 []string
 	indexType                 *string
 	diskType                  *string
+	diskIo                    *string
 	fixJpgOrientation         *bool
 	readMode                  *string
 	cpuProfile                *string
 	memProfile                *string
 	compactionMBPerSecond     *int
+	compactionSegmentMB       *int
 	fileSizeLimitMB           *int
 	concurrentUploadLimitMB   *int
 	concurrentDownloadLimitMB *int
@@ -72,6 +76,15 @@ type VolumeServerOptions struct {
 	hasSlowRead               *bool
 	readBufferSizeMB          *int
 	ldbTimeout                *int64
+	scrubInterval             *time.Duration
+	scrubMBps                 *int
+	readRepair                *bool
+	encryptionKeyring         *string
+	compression               *string
+	cacheMemoryMB             *int
+	cacheDir                  *string
+	cacheDiskMB               *int
+	cacheMaxNeedleKB          *int
 	eventsDir                 string
 	eventBrokers              *string
 	eventBrokerIsConfluent    *bool
@@ -93,11 +106,13 @@ func init() {
 	v.rack = cmdVolume.Flag.String("rack", "", "current volume server's rack name")
 	v.indexType = cmdVolume.Flag.String("index", "memory", "Choose [memory|leveldb|leveldbMedium|leveldbLarge] mode for memory~performance balance.")
 	v.diskType = cmdVolume.Flag.String("disk", "", "[hdd|ssd|<tag>] hard drive or solid state drive or any tag")
+	v.diskIo = cmdVolume.Flag.String("dir.io", "buffered", "[buffered|direct] how to read volume data files, comma separated for each -dir. direct bypasses the page cache with O_DIRECT and io_uring, on linux only")
 	v.fixJpgOrientation = cmdVolume.Flag.Bool("images.fix.orientation", false, "Adjust jpg orientation when uploading.")
 	v.readMode = cmdVolume.Flag.String("readMode", "proxy", "[local|proxy|redirect] how to deal with non-local volume: 'not found|proxy to remote node|redirect volume location'.")
 	v.cpuProfile = cmdVolume.Flag.String("cpuprofile", "", "cpu profile output file")
 	v.memProfile = cmdVolume.Flag.String("memprofile", "", "memory profile output file")
 	v.compactionMBPerSecond = cmdVolume.Flag.Int("compactionMBps", 0, "limit background compaction or copying speed in mega bytes per second")
+	v.compactionSegmentMB = cmdVolume.Flag.Int("compactionSegmentMB", 0, "if positive, vacuum compacts volumes in place in segments of this size, needing only one segment of free disk space instead of a full volume copy")
 	v.fileSizeLimitMB = cmdVolume.Flag.Int("fileSizeLimitMB", 256, "limit file size to avoid out of memory")
 	v.ldbTimeout = cmdVolume.Flag.Int64("index.leveldbTimeout", 0, "alive time for leveldb (default to 0). If leveldb of volume is not accessed in ldbTimeout hours, it will be off loaded to reduce opened files and memory consumption.")
 	v.concurrentUploadLimitMB = cmdVolume.Flag.Int("concurrentUploadLimitMB", 256, "limit total concurrent upload size")
@@ -108,6 +123,15 @@ func init() {
 	v.inflightUploadDataTimeout = cmdVolume.Flag.Duration("inflightUploadDataTimeout", 60*time.Second, "inflight upload data wait timeout of volume servers")
 	v.hasSlowRead = cmdVolume.Flag.Bool("hasSlowRead", true, "<experimental> if true, this prevents slow reads from blocking other requests, but large file read P99 latency will increase.")
 	v.readBufferSizeMB = cmdVolume.Flag.Int("readBufferSizeMB", 4, "<experimental> larger values can optimize query performance but will increase some memory usage,Use with hasSlowRead normally.")
+	v.scrubInterval = cmdVolume.Flag.Duration("scrub.interval", 0, "interval to verify needle checksums of all local volumes and ec shards, 0 to disable. Corrupted needles are quarantined and repaired from replicas if possible.")
+	v.scrubMBps = cmdVolume.Flag.Int("scrub.MBps", 10, "limit background scrubbing reads in MB/s, 0 means unlimited")
+	v.readRepair = cmdVolume.Flag.Bool("readRepair", true, "fetch a corrupted or missing needle of a replicated volume from other replicas when reading, and rewrite the local copy")
+	v.compression = cmdVolume.Flag.String("compression", "gzip", "[gzip|zstd] how to compress compressible uploads not compressed by clients. zstd uses the dictionary trained for the collection by collection.compression.train, if any.")
+	v.cacheMemoryMB = cmdVolume.Flag.Int("cache.memoryMB", 0, "memory of the cache for popular small needles, 0 to disable")
+	v.cacheDir = cmdVolume.Flag.String("cache.dir", "", "directory of the on disk layer of the needle cache, usually on a ssd")
+	v.cacheDiskMB = cmdVolume.Flag.Int("cache.diskMB", 0, "disk space of the on disk layer of the needle cache in -cache.dir")
+	v.cacheMaxNeedleKB = cmdVolume.Flag.Int("cache.maxNeedleKB", 256, "only cache needles up to this size")
+	v.encryptionKeyring = cmdVolume.Flag.String("encryption.keyring", "", "keyring file, or <kms>://... location, of the master keys to encrypt needle data of new volumes at rest. Existing encrypted volumes also need it to be read.")
 	v.eventsDir = *eventsDir
 	v.eventBrokers = cmdVolume.Flag.String("events.brokers", "", "comma-separated list of Kafka broker addresses for events")
 	v.eventBrokerIsConfluent = cmdVolume.Flag.Bool("events.brokers.isConfluent", false, "Set this flag to 'true' if the event broker is Confluent Kafka. This enables specific configurations required for interacting with Confluent Kafka services.")
@@ -202,6 +226,24 @@ func (v VolumeServerOptions) startVolumeServer(volumeFolders, maxVolumeCounts, v
 		glog.Fatalf("%d directories by -dir, but only %d disk types is set by -disk", len(v.folders), len(diskTypes))
 	}
 
+	// set disk io modes
+	var diskIos []backend.DiskIoMode
+	for _, diskIoString := range strings.Split(*v.diskIo, ",") {
+		diskIo, err := backend.ParseDiskIoMode(diskIoString)
+		if err != nil {
+			glog.Fatalf("%v", err)
+		}
+		diskIos = append(diskIos, diskIo)
+	}
+	if len(diskIos) == 1 && len(v.folders) > 1 {
+		for i := 0; i < len(v.folders)-1; i++ {
+			diskIos = append(diskIos, diskIos[0])
+		}
+	}
+	if len(v.folders) != len(diskIos) {
+		glog.Fatalf("%d directories by -dir, but only %d disk io modes is set by -dir.io", len(v.folders), len(diskIos))
+	}
+
 	// security related white list configuration
 	v.whiteList = util.StringSplit(volumeWhiteListOption, ",")
 
@@ -289,15 +331,35 @@ func (v VolumeServerOptions) startVolumeServer(volumeFolders, maxVolumeCounts, v
 		glog.Fatalf("Unable to establish connection to EventStore (LevelDB): %s", es_err)
 	}
 
+	if *v.compression != "gzip" && *v.compression != "zstd" {
+		glog.Fatalf("unknown compression %q", *v.compression)
+	}
+
+	keyWrapper, keyringErr := security.NewKeyWrapper(*v.encryptionKeyring)
+	if keyringErr != nil {
+		glog.Fatalf("load encryption keyring: %v", keyringErr)
+	}
+
+	var needleCache *chunk_cache.NeedleCache
+	if *v.cacheMemoryMB > 0 || (*v.cacheDir != "" && *v.cacheDiskMB > 0) {
+		if *v.cacheDir != "" {
+			if err := os.MkdirAll(*v.cacheDir, 0755); err != nil {
+				glog.Fatalf("create needle cache dir %s: %v", *v.cacheDir, err)
+			}
+		}
+		needleCache = chunk_cache.NewNeedleCache(int64(*v.cacheMemoryMB), *v.cacheDir, int64(*v.cacheDiskMB), int64(*v.cacheMaxNeedleKB))
+	}
+
 	volumeServer := weed_server.NewVolumeServer(volumeMux, publicVolumeMux,
 		*v.ip, *v.port, *v.portGrpc, *v.publicUrl,
-		v.folders, v.folderMaxLimits, minFreeSpaces, diskTypes,
+		v.folders, v.folderMaxLimits, minFreeSpaces, diskTypes, diskIos,
 		*v.idxFolder,
 		volumeNeedleMapKind,
 		v.masters, constants.VolumePulseSeconds, *v.dataCenter, *v.rack,
 		v.whiteList,
 		*v.fixJpgOrientation, *v.readMode,
 		*v.compactionMBPerSecond,
+		*v.compactionSegmentMB,
 		*v.fileSizeLimitMB,
 		int64(*v.concurrentUploadLimitMB)*1024*1024,
 		int64(*v.concurrentDownloadLimitMB)*1024*1024,
@@ -305,6 +367,12 @@ func (v VolumeServerOptions) startVolumeServer(volumeFolders, maxVolumeCounts, v
 		*v.hasSlowRead,
 		*v.readBufferSizeMB,
 		*v.ldbTimeout,
+		*v.scrubInterval,
+		*v.scrubMBps,
+		*v.readRepair,
+		*v.compression,
+		needleCache,
+		keyWrapper,
 		eventStore,
 	)
 	// starting grpc server
@@ -16,6 +16,8 @@ const (
 	WRITE
 	DELETE
 	VACUUM
+	REPAIR
+	APPEND
 )
 
 var vsEventTypes = map[VolumeServerEventType]string{
@@ -23,6 +25,8 @@ var vsEventTypes = map[VolumeServerEventType]string{
 	WRITE:  "WRITE",
 	DELETE: "DELETE",
 	VACUUM: "VACUUM",
+	REPAIR: "REPAIR",
+	APPEND: "APPEND",
 }
 
 type VolumeServerEvent struct {
@@ -82,9 +82,32 @@ func (f *Filer) LoadConfiguration(config *util.ViperProxy) (isFresh bool) {
 		glog.V(0).Infof("configure filer %s for %s", store.GetName(), location)
 	}
 
+	f.loadStoreMigration(config)
+
 	return
 }
 
+// loadStoreMigration starts migrating the default filer store to the store configured in [migration.<store name>].
+func (f *Filer) loadStoreMigration(config *util.ViperProxy) {
+	for _, store := range Stores {
+		key := "migration." + store.GetName()
+		if !config.GetBool(key + ".enabled") {
+			continue
+		}
+		store = reflect.New(reflect.ValueOf(store).Elem().Type()).Interface().(FilerStore)
+		if err := store.Initialize(config, key+"."); err != nil {
+			glog.Fatalf("failed to initialize store for %s: %+v", key, err)
+		}
+		m, err := f.Store.MigrateDefaultStore(store)
+		if err != nil {
+			glog.Fatalf("migrate filer store to %s: %v", store.GetName(), err)
+		}
+		f.StoreMigration = m
+		glog.V(0).Infof("migrating filer store to %s, phase %s", store.GetName(), m.Phase())
+		return
+	}
+}
+
 func validateOneEnabledStore(config *util.ViperProxy) {
 	enabledStore := ""
 	for _, store := range Stores {
@@ -6,6 +6,8 @@ import (
 	"os"
 	"sort"
 	"strings"
+	"sync"
+	"sync/atomic"
 	"time"
 
 	"github.com/gateway-dao/seaweedfs/weed/cluster/lock_manager"
@@ -52,6 +54,21 @@ type Filer struct {
 	RemoteStorage       *FilerRemoteStorage
 	Dlm                 *lock_manager.DistributedLockManager
 	MaxFilenameLength   uint32
+
+	// directory snapshots, see filer_snapshot.go
+	snapshotLock      sync.Mutex
+	snapshotsCreating atomic.Int32
+	hasSnapshots      atomic.Bool
+
+	// directory quotas, see filer_quota.go
+	quotaLock      sync.RWMutex
+	quotas         map[util.FullPath]directoryQuota
+	quotaUsageLock sync.Mutex
+	// guarded by quotaUsageLock
+	quotaRecalculations []*quotaRecalculation
+
+	// the default store migration, see filerstore_migration.go
+	StoreMigration *StoreMigration
 }
 
 func NewFiler(masters pb.ServerDiscovery, grpcDialOption grpc.DialOption, filerHost pb.ServerAddress, filerGroup string, collection string, replication string, dataCenter string, maxFilenameLength uint32, notifyFn func()) *Filer {
@@ -191,12 +208,19 @@ func (f *Filer) CreateEntry(ctx context.Context, entry *Entry, o_excl bool, isFr
 		return nil
 	}
 
+	if err := CheckSnapshotWrite(entry.FullPath, isFromOtherCluster); err != nil {
+		return err
+	}
+
 	if entry.FullPath.IsLongerFileName(maxFilenameLength) {
 		return fmt.Errorf("entry name too long")
 	}
 
 	oldEntry, _ := f.FindEntry(ctx, entry.FullPath)
 
+	// renames are checked as a whole before moving, see CheckMoveQuota
+	checkQuota := !isFromOtherCluster && !isQuotaRename(ctx)
+
 	/*
 		if !hasWritePermission(lastDirectoryEntry, entry) {
 			glog.V(0).Infof("directory %s: %v, entry: uid=%d gid=%d",
@@ -207,25 +231,43 @@ func (f *Filer) CreateEntry(ctx context.Context, entry *Entry, o_excl bool, isFr
 
 	if oldEntry == nil {
 
+		if checkQuota {
+			var err error
+			if ctx, err = f.ReserveQuota(ctx, entry.FullPath, quotaSize(entry), 1); err != nil {
+				return err
+			}
+		}
+
 		if !skipCreateParentDir {
 			dirParts := strings.Split(string(entry.FullPath), "/")
 			if err := f.ensureParentDirectoryEntry(ctx, entry, dirParts, len(dirParts)-1, isFromOtherCluster); err != nil {
+				f.ReleaseQuota(ctx)
 				return err
 			}
 		}
 
 		glog.V(4).Infof("InsertEntry %s: new entry: %v", entry.FullPath, entry.Name())
 		if err := f.Store.InsertEntry(ctx, entry); err != nil {
+			f.ReleaseQuota(ctx)
 			glog.Errorf("insert entry %s: %v", entry.FullPath, err)
 			return fmt.Errorf("insert entry %s: %v", entry.FullPath, err)
 		}
+		f.accountQuota(ctx, entry.FullPath, 0, 1)
+		f.onQuotaEntryChange(ctx, nil, entry)
 	} else {
 		if o_excl {
 			glog.V(3).Infof("EEXIST: entry %s already exists", entry.FullPath)
 			return fmt.Errorf("EEXIST: entry %s already exists", entry.FullPath)
 		}
+		if checkQuota {
+			var err error
+			if ctx, err = f.ReserveUpdateQuota(ctx, oldEntry, entry); err != nil {
+				return err
+			}
+		}
 		glog.V(4).Infof("UpdateEntry %s: old entry: %v", entry.FullPath, oldEntry.Name())
 		if err := f.UpdateEntry(ctx, oldEntry, entry); err != nil {
+			f.ReleaseQuota(ctx)
 			glog.Errorf("update entry %s: %v", entry.FullPath, err)
 			return fmt.Errorf("update entry %s: %v", entry.FullPath, err)
 		}
@@ -285,6 +327,7 @@ func (f *Filer) ensureParentDirectoryEntry(ctx context.Context, entry *Entry, di
 				return fmt.Errorf("mkdir %s: %v", dirPath, mkdirErr)
 			}
 		} else {
+			f.accountQuota(ctx, dirEntry.FullPath, 0, 1)
 			if !strings.HasPrefix("/"+util.Join(dirParts[:]...), SystemLogDir) {
 				f.NotifyUpdateEvent(ctx, nil, dirEntry, false, isFromOtherCluster, nil)
 			}
@@ -310,7 +353,23 @@ func (f *Filer) UpdateEntry(ctx context.Context, oldEntry, entry *Entry) (err er
 			return fmt.Errorf("existing %s is a file", oldEntry.FullPath)
 		}
 	}
-	return f.Store.UpdateEntry(ctx, entry)
+	keepsVersion := f.KeepsVersion(oldEntry, entry)
+	now := time.Now()
+	if keepsVersion {
+		if err = f.saveVersion(ctx, oldEntry, now); err != nil {
+			return err
+		}
+	}
+	if err = f.Store.UpdateEntry(ctx, entry); err != nil {
+		return err
+	}
+	f.onQuotaEntryChange(ctx, oldEntry, entry)
+	if keepsVersion {
+		if _, err := f.pruneVersions(ctx, entry.FullPath, now); err != nil {
+			glog.Errorf("prune versions of %s: %v", entry.FullPath, err)
+		}
+	}
+	return nil
 }
 
 var (
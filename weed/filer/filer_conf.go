@@ -170,6 +170,17 @@ func mergePathConf(a, b *filer_pb.FilerConf_PathConf) {
 	a.DataCenter = util.Nvl(b.DataCenter, a.DataCenter)
 	a.Rack = util.Nvl(b.Rack, a.Rack)
 	a.DataNode = util.Nvl(b.DataNode, a.DataNode)
+	a.Trash = b.Trash || a.Trash
+	if b.TrashRetentionDays > 0 {
+		a.TrashRetentionDays = b.TrashRetentionDays
+	}
+	a.Versioning = b.Versioning || a.Versioning
+	if b.MaxVersions > 0 {
+		a.MaxVersions = b.MaxVersions
+	}
+	if b.VersionRetentionDays > 0 {
+		a.VersionRetentionDays = b.VersionRetentionDays
+	}
 }
 
 func (fc *FilerConf) ToProto() *filer_pb.FilerConf {
@@ -3,6 +3,7 @@ package filer
 import (
 	"context"
 	"fmt"
+	"time"
 
 	"github.com/gateway-dao/seaweedfs/weed/glog"
 	"github.com/gateway-dao/seaweedfs/weed/pb/filer_pb"
@@ -21,12 +22,30 @@ func (f *Filer) DeleteEntryMetaAndData(ctx context.Context, p util.FullPath, isR
 	if p == "/" {
 		return nil
 	}
+	if err = CheckSnapshotWrite(p, isFromOtherCluster); err != nil {
+		return err
+	}
 
 	entry, findErr := f.FindEntry(ctx, p)
 	if findErr != nil {
 		return findErr
 	}
+	if retention, found := f.trashRetention(ctx, entry, isRecursive, shouldDeleteChunks, isFromOtherCluster); found {
+		return f.moveToTrash(ctx, entry, retention, signatures)
+	}
 	isDeleteCollection := f.isBucket(entry)
+
+	keepsVersion := shouldDeleteChunks && f.keepsDeletedVersion(ctx, entry, isFromOtherCluster)
+	if keepsVersion {
+		shouldDeleteChunks = false
+	}
+
+	var usedBytes, usedEntries int64
+	if len(f.quotaDirectoriesOf(p)) > 0 {
+		if usedBytes, usedEntries, err = f.treeUsage(ctx, entry); err != nil {
+			return err
+		}
+	}
 	if entry.IsDirectory() {
 		// delete the folder children, not including the folder itself
 		err = f.doBatchDeleteFolderMetaAndData(ctx, entry, isRecursive, ignoreRecursiveError, shouldDeleteChunks && !isDeleteCollection, isDeleteCollection, isFromOtherCluster, signatures, func(hardLinkIds []HardLinkId) error {
@@ -48,6 +67,10 @@ func (f *Filer) DeleteEntryMetaAndData(ctx context.Context, p util.FullPath, isR
 	if err != nil {
 		return fmt.Errorf("delete file %s: %v", p, err)
 	}
+	f.onQuotaEntryDelete(ctx, p, usedBytes, usedEntries)
+	if keepsVersion {
+		f.keepDeletedVersion(ctx, entry, time.Now())
+	}
 
 	if shouldDeleteChunks && !isDeleteCollection {
 		f.DirectDeleteChunks(entry.GetChunks())
@@ -65,6 +88,7 @@ func (f *Filer) doBatchDeleteFolderMetaAndData(ctx context.Context, entry *Entry
 
 	//collect all the chunks of this layer and delete them together at the end
 	var chunksToDelete []*filer_pb.FileChunk
+	var keptVersions []*Entry
 	lastFileName := ""
 	includeLastFile := false
 	if !isDeletingBucket || !f.Store.CanDropWholeBucket() {
@@ -85,6 +109,9 @@ func (f *Filer) doBatchDeleteFolderMetaAndData(ctx context.Context, entry *Entry
 				if sub.IsDirectory() {
 					subIsDeletingBucket := f.isBucket(sub)
 					err = f.doBatchDeleteFolderMetaAndData(ctx, sub, isRecursive, ignoreRecursiveError, shouldDeleteChunks, subIsDeletingBucket, false, nil, onHardLinkIdsFn)
+				} else if shouldDeleteChunks && f.keepsDeletedVersion(ctx, sub, isFromOtherCluster) {
+					f.NotifyUpdateEvent(ctx, sub, nil, false, isFromOtherCluster, nil)
+					keptVersions = append(keptVersions, sub)
 				} else {
 					f.NotifyUpdateEvent(ctx, sub, nil, shouldDeleteChunks, isFromOtherCluster, nil)
 					if len(sub.HardLinkId) != 0 {
@@ -115,6 +142,10 @@ func (f *Filer) doBatchDeleteFolderMetaAndData(ctx context.Context, entry *Entry
 
 	f.NotifyUpdateEvent(ctx, entry, nil, shouldDeleteChunks, isFromOtherCluster, signatures)
 	f.DeleteChunks(entry.FullPath, chunksToDelete)
+	now := time.Now()
+	for _, sub := range keptVersions {
+		f.keepDeletedVersion(ctx, sub, now)
+	}
 
 	return nil
 }
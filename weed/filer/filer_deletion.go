@@ -52,7 +52,11 @@ func (f *Filer) loopProcessingDeletion() {
 					toDeleteFileIds = fileIds
 					fileIds = fileIds[:0]
 				}
+				toDeleteFileIds = f.filterSnapshotFileIds(toDeleteFileIds)
 				deletionCount = len(toDeleteFileIds)
+				if len(toDeleteFileIds) == 0 {
+					continue
+				}
 				_, err := operation.DeleteFilesWithLookupVolumeId(f.GrpcDialOption, toDeleteFileIds, lookupFunc)
 				if err != nil {
 					if !strings.Contains(err.Error(), storage.ErrorDeleted.Error()) {
@@ -84,7 +88,11 @@ func (f *Filer) doDeleteFileIds(fileIds []string) {
 			toDeleteFileIds = fileIds
 			fileIds = fileIds[:0]
 		}
+		toDeleteFileIds = f.filterSnapshotFileIds(toDeleteFileIds)
 		deletionCount := len(toDeleteFileIds)
+		if deletionCount == 0 {
+			continue
+		}
 		_, err := operation.DeleteFilesWithLookupVolumeId(f.GrpcDialOption, toDeleteFileIds, lookupFunc)
 		if err != nil {
 			if !strings.Contains(err.Error(), storage.ErrorDeleted.Error()) {
@@ -150,6 +158,9 @@ func (f *Filer) DeleteChunksNotRecursive(chunks []*filer_pb.FileChunk) {
 }
 
 func (f *Filer) deleteChunksIfNotNew(oldEntry, newEntry *Entry) {
+	if f.KeepsVersion(oldEntry, newEntry) {
+		return
+	}
 	var oldChunks, newChunks []*filer_pb.FileChunk
 	if oldEntry != nil {
 		oldChunks = oldEntry.GetChunks()
@@ -12,6 +12,26 @@ func (f *Filer) onMetadataChangeEvent(event *filer_pb.SubscribeMetadataResponse)
 	f.maybeReloadFilerConfiguration(event)
 	f.maybeReloadRemoteStorageConfigurationAndMapping(event)
 	f.onBucketEvents(event)
+	f.onSnapshotEvents(event)
+	f.onQuotaEvents(event)
+}
+
+func (f *Filer) onSnapshotEvents(event *filer_pb.SubscribeMetadataResponse) {
+	if event.Directory == SnapshotsDir {
+		f.LoadSnapshots()
+	}
+}
+
+func (f *Filer) onQuotaEvents(event *filer_pb.SubscribeMetadataResponse) {
+	for _, entry := range []*filer_pb.Entry{event.EventNotification.OldEntry, event.EventNotification.NewEntry} {
+		if entry == nil || !entry.IsDirectory {
+			continue
+		}
+		if _, found := quotaOfExtended(entry.Extended); found {
+			f.LoadDirectoryQuotas()
+			return
+		}
+	}
 }
 
 func (f *Filer) onBucketEvents(event *filer_pb.SubscribeMetadataResponse) {
@@ -0,0 +1,668 @@
+package filer
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/gateway-dao/seaweedfs/weed/glog"
+	"github.com/gateway-dao/seaweedfs/weed/pb"
+	"github.com/gateway-dao/seaweedfs/weed/pb/filer_pb"
+	"github.com/gateway-dao/seaweedfs/weed/util"
+)
+
+/*
+A directory quota limits the bytes and the entry count of a directory tree,
+not counting the directory itself. The limits are extended attributes of the directory.
+
+The directories with quotas are registered in the filer store kv, and cached by every filer
+after their metadata events. The usage of each of them is kept in the kv, and adjusted when
+entries under it are created, updated, deleted or renamed. Filers sharing the store send the
+changes to the filer owning the usage, picked like the owner of a distributed lock, which
+applies them one by one, and also recalculates the usage, see fs.quota.recalculate, keeping
+the changes made while recalculating.
+
+Creating or updating an entry reserves its usage before it is written, checking the limits in
+the same change of the usage, and releases it if the write fails. Writes over a quota fail with
+MsgQuotaExceeded, except renames within the quota directory, and changes replicated from other
+clusters.
+
+The usage can still exceed the limits by:
+  - renames, which are checked as a whole before moving the entries one by one
+  - the parent directories created for a new entry, which are counted but not checked
+  - changes made while the filers join or leave, when two filers may own a usage for a moment,
+    or when the owner can not be reached and a filer changes the usage itself
+Entries expiring by ttl are not counted out either. The drift is fixed by recalculating.
+*/
+
+const (
+	MsgQuotaExceeded = "directory quota exceeded"
+
+	quotaMaxBytesKey    = "quota.bytes"
+	quotaMaxEntriesKey  = "quota.entries"
+	quotaDirectoriesKey = "quota.directories"
+	quotaUsagePrefix    = "quota.usage."
+)
+
+type quotaReservationKey struct{}
+
+// quotaReservation is the usage reserved for writing the entry at the path, which is not counted again.
+type quotaReservation struct {
+	path         util.FullPath
+	deltaBytes   int64
+	deltaEntries int64
+}
+
+type directoryQuota struct {
+	maxBytes   int64
+	maxEntries int64
+}
+
+func quotaOfExtended(extended map[string][]byte) (q directoryQuota, found bool) {
+	q.maxBytes = bytesToInt64(extended[quotaMaxBytesKey])
+	q.maxEntries = bytesToInt64(extended[quotaMaxEntriesKey])
+	return q, q.maxBytes > 0 || q.maxEntries > 0
+}
+
+// quotaOf returns the quota of the directory, which does not apply in the trash.
+func quotaOf(entry *Entry) (directoryQuota, bool) {
+	if entry == nil || !entry.IsDirectory() || IsTrashPath(entry.FullPath) {
+		return directoryQuota{}, false
+	}
+	return quotaOfExtended(entry.Extended)
+}
+
+// quotaSize is the bytes of the entry counted by quotas
+func quotaSize(entry *Entry) int64 {
+	if entry == nil || entry.IsDirectory() {
+		return 0
+	}
+	return int64(entry.Size())
+}
+
+func isQuotaRename(ctx context.Context) bool {
+	return ctx.Value("OP") == "MV"
+}
+
+// quotaDirectoriesOf returns the directories with quotas containing the path.
+func (f *Filer) quotaDirectoriesOf(p util.FullPath) (dirs []util.FullPath) {
+	f.quotaLock.RLock()
+	defer f.quotaLock.RUnlock()
+	for dir := range f.quotas {
+		if p.IsUnder(dir) {
+			dirs = append(dirs, dir)
+		}
+	}
+	return
+}
+
+func quotaUsageKey(dir util.FullPath) []byte {
+	return []byte(quotaUsagePrefix + string(dir))
+}
+
+func (f *Filer) getQuotaUsage(ctx context.Context, dir util.FullPath) (usedBytes, usedEntries int64, err error) {
+	value, err := f.Store.KvGet(ctx, quotaUsageKey(dir))
+	if err == ErrKvNotFound {
+		return 0, 0, nil
+	}
+	if err != nil {
+		return 0, 0, err
+	}
+	if len(value) != 16 {
+		return 0, 0, fmt.Errorf("invalid quota usage of %s", dir)
+	}
+	return bytesToInt64(value[:8]), bytesToInt64(value[8:]), nil
+}
+
+func (f *Filer) putQuotaUsage(ctx context.Context, dir util.FullPath, usedBytes, usedEntries int64) error {
+	return f.Store.KvPut(ctx, quotaUsageKey(dir), append(int64ToBytes(usedBytes), int64ToBytes(usedEntries)...))
+}
+
+// quotaUsageOwner returns the filer keeping the usage of the quota directory, the same as the one
+// keeping a distributed lock of the usage key.
+func (f *Filer) quotaUsageOwner(dir util.FullPath) pb.ServerAddress {
+	servers := f.Dlm.LockRing.GetSnapshot()
+	if len(servers) == 0 {
+		return f.Dlm.Host
+	}
+	return f.Dlm.CalculateTargetServer(string(quotaUsageKey(dir)), servers)
+}
+
+func quotaExceeded(dir util.FullPath, q directoryQuota, usedBytes, usedEntries, deltaBytes, deltaEntries int64) error {
+	if deltaBytes > 0 && q.maxBytes > 0 && usedBytes+deltaBytes > q.maxBytes {
+		return fmt.Errorf("%s: %s uses %d of %d bytes", MsgQuotaExceeded, dir, usedBytes, q.maxBytes)
+	}
+	if deltaEntries > 0 && q.maxEntries > 0 && usedEntries+deltaEntries > q.maxEntries {
+		return fmt.Errorf("%s: %s has %d of %d entries", MsgQuotaExceeded, dir, usedEntries, q.maxEntries)
+	}
+	return nil
+}
+
+// UpdateLocalQuotaUsage changes the usage of the quota directory by the change of the entry at the path.
+// With checkLimits, the usage is not changed if it grows over the limits.
+func (f *Filer) UpdateLocalQuotaUsage(ctx context.Context, dir, p util.FullPath, deltaBytes, deltaEntries int64, checkLimits bool) error {
+	f.quotaUsageLock.Lock()
+	defer f.quotaUsageLock.Unlock()
+	usedBytes, usedEntries, err := f.getQuotaUsage(ctx, dir)
+	if err != nil {
+		return fmt.Errorf("quota usage of %s: %v", dir, err)
+	}
+	if checkLimits {
+		f.quotaLock.RLock()
+		q, found := f.quotas[dir]
+		f.quotaLock.RUnlock()
+		if found {
+			if err = quotaExceeded(dir, q, usedBytes, usedEntries, deltaBytes, deltaEntries); err != nil {
+				return err
+			}
+		}
+	}
+	if err = f.putQuotaUsage(ctx, dir, max(usedBytes+deltaBytes, 0), max(usedEntries+deltaEntries, 0)); err != nil {
+		return fmt.Errorf("update quota usage of %s: %v", dir, err)
+	}
+	for _, r := range f.quotaRecalculations {
+		if r.dir == dir && r.hasListed(p) {
+			r.deltaBytes += deltaBytes
+			r.deltaEntries += deltaEntries
+		}
+	}
+	return nil
+}
+
+// updateQuotaUsage changes the usage of the quota directory on the filer owning it.
+// If the owner can not be reached, the usage is changed here.
+func (f *Filer) updateQuotaUsage(ctx context.Context, dir, p util.FullPath, deltaBytes, deltaEntries int64, checkLimits bool) error {
+	owner := f.quotaUsageOwner(dir)
+	if owner == f.Dlm.Host {
+		return f.UpdateLocalQuotaUsage(ctx, dir, p, deltaBytes, deltaEntries, checkLimits)
+	}
+	var updateErr error
+	err := pb.WithFilerClient(false, 0, owner, f.GrpcDialOption, func(client filer_pb.SeaweedFilerClient) error {
+		resp, err := client.UpdateDirectoryQuotaUsage(ctx, &filer_pb.UpdateDirectoryQuotaUsageRequest{
+			Directory:    string(dir),
+			Path:         string(p),
+			DeltaBytes:   deltaBytes,
+			DeltaEntries: deltaEntries,
+			CheckLimits:  checkLimits,
+		})
+		if err != nil {
+			return err
+		}
+		if resp.Error != "" {
+			updateErr = errors.New(resp.Error)
+		}
+		return nil
+	})
+	if err != nil {
+		glog.Warningf("update quota usage of %s on %s: %v", dir, owner, err)
+		return f.UpdateLocalQuotaUsage(ctx, dir, p, deltaBytes, deltaEntries, checkLimits)
+	}
+	return updateErr
+}
+
+// ReserveQuota adds the bytes and entries to be written at the path to the quotas containing it,
+// or fails if any quota is exceeded. The returned context carries the reservation for writing the
+// entry, which is released by ReleaseQuota if the write fails.
+func (f *Filer) ReserveQuota(ctx context.Context, p util.FullPath, deltaBytes, deltaEntries int64) (context.Context, error) {
+	if deltaBytes == 0 && deltaEntries == 0 {
+		return ctx, nil
+	}
+	dirs := f.quotaDirectoriesOf(p)
+	if len(dirs) == 0 {
+		return ctx, nil
+	}
+	checkLimits := deltaBytes > 0 || deltaEntries > 0
+	for i, dir := range dirs {
+		if err := f.updateQuotaUsage(ctx, dir, p, deltaBytes, deltaEntries, checkLimits); err != nil {
+			for _, reserved := range dirs[:i] {
+				if releaseErr := f.updateQuotaUsage(ctx, reserved, p, -deltaBytes, -deltaEntries, false); releaseErr != nil {
+					glog.Errorf("release quota of %s: %v", reserved, releaseErr)
+				}
+			}
+			return ctx, err
+		}
+	}
+	return context.WithValue(ctx, quotaReservationKey{}, &quotaReservation{
+		path:         p,
+		deltaBytes:   deltaBytes,
+		deltaEntries: deltaEntries,
+	}), nil
+}
+
+// ReserveUpdateQuota reserves the growth of the entry, see ReserveQuota.
+func (f *Filer) ReserveUpdateQuota(ctx context.Context, oldEntry, newEntry *Entry) (context.Context, error) {
+	return f.ReserveQuota(ctx, newEntry.FullPath, quotaSize(newEntry)-quotaSize(oldEntry), 0)
+}
+
+// ReleaseQuota gives back the usage reserved in the context, after the write failed.
+func (f *Filer) ReleaseQuota(ctx context.Context) {
+	r, found := ctx.Value(quotaReservationKey{}).(*quotaReservation)
+	if !found {
+		return
+	}
+	for _, dir := range f.quotaDirectoriesOf(r.path) {
+		if err := f.updateQuotaUsage(ctx, dir, r.path, -r.deltaBytes, -r.deltaEntries, false); err != nil {
+			glog.Errorf("release quota of %s: %v", dir, err)
+		}
+	}
+}
+
+// CheckMoveQuota fails if moving the entry exceeds the quotas of the new path.
+// The quotas containing both paths do not change.
+func (f *Filer) CheckMoveQuota(ctx context.Context, oldPath, newPath util.FullPath) error {
+	var dirs []util.FullPath
+	for _, dir := range f.quotaDirectoriesOf(newPath) {
+		if !oldPath.IsUnder(dir) {
+			dirs = append(dirs, dir)
+		}
+	}
+	if len(dirs) == 0 {
+		return nil
+	}
+	entry, err := f.FindEntry(ctx, oldPath)
+	if err != nil {
+		return err
+	}
+	usedBytes, usedEntries, err := f.treeUsage(ctx, entry)
+	if err != nil {
+		return err
+	}
+	for _, dir := range dirs {
+		f.quotaLock.RLock()
+		q, found := f.quotas[dir]
+		f.quotaLock.RUnlock()
+		if !found {
+			continue
+		}
+		dirBytes, dirEntries, err := f.getQuotaUsage(ctx, dir)
+		if err != nil {
+			return fmt.Errorf("quota usage of %s: %v", dir, err)
+		}
+		if err = quotaExceeded(dir, q, dirBytes, dirEntries, usedBytes, usedEntries); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// accountQuota counts the change of the entry at the path, unless it is reserved in the context.
+func (f *Filer) accountQuota(ctx context.Context, p util.FullPath, deltaBytes, deltaEntries int64) {
+	if deltaBytes == 0 && deltaEntries == 0 {
+		return
+	}
+	if r, found := ctx.Value(quotaReservationKey{}).(*quotaReservation); found && r.path == p {
+		return
+	}
+	for _, dir := range f.quotaDirectoriesOf(p) {
+		if err := f.updateQuotaUsage(ctx, dir, p, deltaBytes, deltaEntries, false); err != nil {
+			glog.Errorf("%v", err)
+		}
+	}
+}
+
+// quotaRecalculation follows the walk of a quota directory being recalculated, and collects
+// the usage changes of the entries the walk has listed already, to be added to its result.
+// The changes of entries not listed yet are seen by the walk.
+type quotaRecalculation struct {
+	dir          util.FullPath
+	listing      map[util.FullPath]string // the directories being listed, to the last name listed
+	lastEntered  util.FullPath            // the last directory the walk has started listing
+	deltaBytes   int64
+	deltaEntries int64
+}
+
+// hasListed tells whether the walk has listed the entry at the path, or the place where it is created.
+func (r *quotaRecalculation) hasListed(p util.FullPath) bool {
+	dir, name := p.DirAndName()
+	if lastName, found := r.listing[util.FullPath(dir)]; found {
+		return name <= lastName
+	}
+	// other directories are listed completely if they are walked before the last one entered
+	return util.FullPath(dir) == r.lastEntered || isWalkedBefore(util.FullPath(dir), r.lastEntered)
+}
+
+// isWalkedBefore tells whether the depth-first walk in name order reaches a before b.
+func isWalkedBefore(a, b util.FullPath) bool {
+	aNames, bNames := a.Split(), b.Split()
+	for i := 0; i < len(aNames) && i < len(bNames); i++ {
+		if aNames[i] != bNames[i] {
+			return aNames[i] < bNames[i]
+		}
+	}
+	return len(aNames) < len(bNames)
+}
+
+// trackQuotaWalk records the progress of the walk in r, if it is not nil.
+func (f *Filer) trackQuotaWalk(r *quotaRecalculation, dir util.FullPath, lastFileName string, isDone bool) {
+	if r == nil {
+		return
+	}
+	f.quotaUsageLock.Lock()
+	defer f.quotaUsageLock.Unlock()
+	if isDone {
+		delete(r.listing, dir)
+		return
+	}
+	if _, found := r.listing[dir]; !found {
+		r.lastEntered = dir
+	}
+	r.listing[dir] = lastFileName
+}
+
+// treeUsage counts the entry and everything under it.
+func (f *Filer) treeUsage(ctx context.Context, entry *Entry) (usedBytes, usedEntries int64, err error) {
+	return f.walkTreeUsage(ctx, entry, nil)
+}
+
+func (f *Filer) walkTreeUsage(ctx context.Context, entry *Entry, r *quotaRecalculation) (usedBytes, usedEntries int64, err error) {
+	usedBytes, usedEntries = quotaSize(entry), 1
+	if !entry.IsDirectory() {
+		return
+	}
+	childBytes, childEntries, err := f.directoryUsage(ctx, entry.FullPath, r)
+	return usedBytes + childBytes, usedEntries + childEntries, err
+}
+
+// directoryUsage counts everything under the directory, not including the directory itself.
+// The walk is tracked in r, if it is not nil.
+func (f *Filer) directoryUsage(ctx context.Context, dir util.FullPath, r *quotaRecalculation) (usedBytes, usedEntries int64, err error) {
+	lastFileName := ""
+	f.trackQuotaWalk(r, dir, lastFileName, false)
+	defer f.trackQuotaWalk(r, dir, "", true)
+	for {
+		entries, hasMore, listErr := f.ListDirectoryEntries(ctx, dir, lastFileName, false, PaginationSize, "", "", "")
+		if listErr != nil {
+			return 0, 0, fmt.Errorf("list %s: %v", dir, listErr)
+		}
+		if len(entries) > 0 {
+			f.trackQuotaWalk(r, dir, entries[len(entries)-1].Name(), false)
+		}
+		for _, entry := range entries {
+			lastFileName = entry.Name()
+			entryBytes, entryCount, treeErr := f.walkTreeUsage(ctx, entry, r)
+			if treeErr != nil {
+				return 0, 0, treeErr
+			}
+			usedBytes += entryBytes
+			usedEntries += entryCount
+		}
+		if !hasMore {
+			return
+		}
+	}
+}
+
+func (f *Filer) loadQuotaDirectories(ctx context.Context) (dirs []util.FullPath, err error) {
+	value, err := f.Store.KvGet(ctx, []byte(quotaDirectoriesKey))
+	if err == ErrKvNotFound {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	for _, line := range strings.Split(string(value), "\n") {
+		if line != "" {
+			dirs = append(dirs, util.FullPath(line))
+		}
+	}
+	return
+}
+
+// updateQuotaDirectories adds the directory to the registry, or removes the directory and everything under it.
+func (f *Filer) updateQuotaDirectories(ctx context.Context, dir util.FullPath, add bool) error {
+	dirs, err := f.loadQuotaDirectories(ctx)
+	if err != nil {
+		return err
+	}
+	var updated []string
+	for _, d := range dirs {
+		if d == dir || d.IsUnder(dir) && !add {
+			if !add {
+				if err = f.Store.KvDelete(ctx, quotaUsageKey(d)); err != nil {
+					return err
+				}
+			}
+			continue
+		}
+		updated = append(updated, string(d))
+	}
+	if add {
+		updated = append(updated, string(dir))
+	}
+	if len(updated) == len(dirs) && !add {
+		return nil
+	}
+	sort.Strings(updated)
+	if err = f.Store.KvPut(ctx, []byte(quotaDirectoriesKey), []byte(strings.Join(updated, "\n"))); err != nil {
+		return err
+	}
+	f.LoadDirectoryQuotas()
+	return nil
+}
+
+// onQuotaEntryChange keeps the registry and the usage of the quotas after the entry is created or updated.
+func (f *Filer) onQuotaEntryChange(ctx context.Context, oldEntry, newEntry *Entry) {
+	f.accountQuota(ctx, newEntry.FullPath, quotaSize(newEntry)-quotaSize(oldEntry), 0)
+
+	oldQuota, hadQuota := quotaOf(oldEntry)
+	newQuota, hasQuota := quotaOf(newEntry)
+	var err error
+	switch {
+	case hasQuota && !hadQuota:
+		if err = f.updateQuotaDirectories(ctx, newEntry.FullPath, true); err == nil {
+			_, err = f.recalculateQuotaUsage(ctx, newEntry.FullPath, false)
+		}
+	case hadQuota && !hasQuota:
+		err = f.updateQuotaDirectories(ctx, newEntry.FullPath, false)
+	case hasQuota && newQuota != oldQuota:
+		f.LoadDirectoryQuotas()
+	}
+	if err != nil {
+		glog.Errorf("update quota of %s: %v", newEntry.FullPath, err)
+	}
+}
+
+// onQuotaEntryDelete releases the usage of the deleted tree, and removes the quotas in it.
+func (f *Filer) onQuotaEntryDelete(ctx context.Context, p util.FullPath, usedBytes, usedEntries int64) {
+	f.accountQuota(ctx, p, -usedBytes, -usedEntries)
+
+	f.quotaLock.RLock()
+	hasQuotaInside := false
+	for dir := range f.quotas {
+		if dir == p || dir.IsUnder(p) {
+			hasQuotaInside = true
+		}
+	}
+	f.quotaLock.RUnlock()
+	if hasQuotaInside {
+		if err := f.updateQuotaDirectories(ctx, p, false); err != nil {
+			glog.Errorf("remove quotas under %s: %v", p, err)
+		}
+	}
+}
+
+// recalculateQuotaUsage counts the usage of the quota directory again, while entries are changing,
+// on the filer owning the usage unless isMoved. The changes made during the walk to entries it has
+// listed already are added to the result.
+func (f *Filer) recalculateQuotaUsage(ctx context.Context, dir util.FullPath, isMoved bool) (*filer_pb.DirectoryQuota, error) {
+	if owner := f.quotaUsageOwner(dir); owner != f.Dlm.Host && !isMoved {
+		var quota *filer_pb.DirectoryQuota
+		err := pb.WithFilerClient(false, 0, owner, f.GrpcDialOption, func(client filer_pb.SeaweedFilerClient) error {
+			resp, err := client.RecalculateDirectoryQuotas(ctx, &filer_pb.RecalculateDirectoryQuotasRequest{
+				Directory: string(dir),
+				IsMoved:   true,
+			})
+			if err != nil {
+				return err
+			}
+			if len(resp.Quotas) != 1 {
+				return fmt.Errorf("%d quotas recalculated", len(resp.Quotas))
+			}
+			quota = resp.Quotas[0]
+			return nil
+		})
+		if err == nil {
+			return quota, nil
+		}
+		glog.Warningf("recalculate quota of %s on %s: %v", dir, owner, err)
+	}
+
+	r := &quotaRecalculation{
+		dir:     dir,
+		listing: make(map[util.FullPath]string),
+	}
+	f.quotaUsageLock.Lock()
+	f.quotaRecalculations = append(f.quotaRecalculations, r)
+	f.quotaUsageLock.Unlock()
+
+	usedBytes, usedEntries, err := f.directoryUsage(ctx, dir, r)
+
+	f.quotaUsageLock.Lock()
+	for i, recalculation := range f.quotaRecalculations {
+		if recalculation == r {
+			f.quotaRecalculations = append(f.quotaRecalculations[:i], f.quotaRecalculations[i+1:]...)
+			break
+		}
+	}
+	if err == nil {
+		err = f.putQuotaUsage(ctx, dir, max(usedBytes+r.deltaBytes, 0), max(usedEntries+r.deltaEntries, 0))
+	}
+	f.quotaUsageLock.Unlock()
+	if err != nil {
+		return nil, err
+	}
+	return f.toDirectoryQuota(ctx, dir)
+}
+
+func (f *Filer) toDirectoryQuota(ctx context.Context, dir util.FullPath) (*filer_pb.DirectoryQuota, error) {
+	f.quotaLock.RLock()
+	q := f.quotas[dir]
+	f.quotaLock.RUnlock()
+	usedBytes, usedEntries, err := f.getQuotaUsage(ctx, dir)
+	if err != nil {
+		return nil, err
+	}
+	return &filer_pb.DirectoryQuota{
+		Directory:   string(dir),
+		MaxBytes:    q.maxBytes,
+		MaxEntries:  q.maxEntries,
+		UsedBytes:   usedBytes,
+		UsedEntries: usedEntries,
+	}, nil
+}
+
+// SetDirectoryQuota sets the limits of the directory, or removes its quota if both are 0.
+func (f *Filer) SetDirectoryQuota(ctx context.Context, dir util.FullPath, maxBytes, maxEntries int64) (*filer_pb.DirectoryQuota, error) {
+	if maxBytes < 0 || maxEntries < 0 {
+		return nil, fmt.Errorf("invalid quota %d bytes %d entries", maxBytes, maxEntries)
+	}
+	if dir != "/" {
+		dir = util.FullPath(strings.TrimSuffix(string(dir), "/"))
+	}
+	if dir == "/" || IsSnapshotPath(dir) || IsTrashPath(dir) {
+		return nil, fmt.Errorf("can not set quota on %s", dir)
+	}
+	entry, err := f.FindEntry(ctx, dir)
+	if err != nil {
+		return nil, fmt.Errorf("find %s: %v", dir, err)
+	}
+	if !entry.IsDirectory() {
+		return nil, fmt.Errorf("%s is not a directory", dir)
+	}
+
+	newEntry := entry.ShallowClone()
+	newEntry.Extended = make(map[string][]byte)
+	for k, v := range entry.Extended {
+		newEntry.Extended[k] = v
+	}
+	delete(newEntry.Extended, quotaMaxBytesKey)
+	delete(newEntry.Extended, quotaMaxEntriesKey)
+	if maxBytes > 0 {
+		newEntry.Extended[quotaMaxBytesKey] = int64ToBytes(maxBytes)
+	}
+	if maxEntries > 0 {
+		newEntry.Extended[quotaMaxEntriesKey] = int64ToBytes(maxEntries)
+	}
+	if err = f.UpdateEntry(ctx, entry, newEntry); err != nil {
+		return nil, err
+	}
+	f.NotifyUpdateEvent(ctx, entry, newEntry, false, false, nil)
+
+	return f.toDirectoryQuota(ctx, dir)
+}
+
+// ListDirectoryQuotas lists the quotas with their usage.
+func (f *Filer) ListDirectoryQuotas(ctx context.Context) (quotas []*filer_pb.DirectoryQuota, err error) {
+	for _, dir := range f.sortedQuotaDirectories() {
+		q, err := f.toDirectoryQuota(ctx, dir)
+		if err != nil {
+			return nil, fmt.Errorf("quota of %s: %v", dir, err)
+		}
+		quotas = append(quotas, q)
+	}
+	return
+}
+
+// RecalculateDirectoryQuotas counts the usage of the quota directory again, or of all quotas if the directory is empty.
+// If isMoved, the usage is recalculated here even if another filer owns it.
+func (f *Filer) RecalculateDirectoryQuotas(ctx context.Context, dir util.FullPath, isMoved bool) (quotas []*filer_pb.DirectoryQuota, err error) {
+	dirs := f.sortedQuotaDirectories()
+	if dir != "" {
+		if dir != "/" {
+			dir = util.FullPath(strings.TrimSuffix(string(dir), "/"))
+		}
+		f.quotaLock.RLock()
+		_, found := f.quotas[dir]
+		f.quotaLock.RUnlock()
+		if !found {
+			return nil, fmt.Errorf("%s has no quota", dir)
+		}
+		dirs = []util.FullPath{dir}
+	}
+	for _, d := range dirs {
+		q, err := f.recalculateQuotaUsage(ctx, d, isMoved)
+		if err != nil {
+			return nil, fmt.Errorf("recalculate quota of %s: %v", d, err)
+		}
+		quotas = append(quotas, q)
+	}
+	return
+}
+
+func (f *Filer) sortedQuotaDirectories() (dirs []util.FullPath) {
+	f.quotaLock.RLock()
+	for dir := range f.quotas {
+		dirs = append(dirs, dir)
+	}
+	f.quotaLock.RUnlock()
+	sort.Slice(dirs, func(i, j int) bool {
+		return dirs[i] < dirs[j]
+	})
+	return
+}
+
+// LoadDirectoryQuotas caches the registered quota directories with their limits.
+func (f *Filer) LoadDirectoryQuotas() {
+	ctx := context.Background()
+	dirs, err := f.loadQuotaDirectories(ctx)
+	if err != nil {
+		glog.Errorf("load quota directories: %v", err)
+		return
+	}
+	quotas := make(map[util.FullPath]directoryQuota)
+	for _, dir := range dirs {
+		entry, err := f.FindEntry(ctx, dir)
+		if err != nil {
+			glog.Warningf("quota directory %s: %v", dir, err)
+			continue
+		}
+		if q, found := quotaOf(entry); found {
+			quotas[dir] = q
+		}
+	}
+	f.quotaLock.Lock()
+	f.quotas = quotas
+	f.quotaLock.Unlock()
+}
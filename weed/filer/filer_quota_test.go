@@ -0,0 +1,202 @@
+package filer
+
+import (
+	"context"
+	"os"
+	"strings"
+	"testing"
+
+	"github.com/gateway-dao/seaweedfs/weed/pb"
+	"github.com/gateway-dao/seaweedfs/weed/util"
+)
+
+func TestDirectoryQuota(t *testing.T) {
+	f := NewFiler(pb.ServerDiscovery{}, nil, "", "", "", "", "", 255, nil)
+	f.SetStore(newMemoryStore())
+	ctx := context.Background()
+
+	createFile := func(path string, size uint64) error {
+		return f.CreateEntry(ctx, &Entry{
+			FullPath: util.FullPath(path),
+			Attr:     Attr{Mode: 0644, FileSize: size},
+		}, false, false, nil, false, f.MaxFilenameLength)
+	}
+	checkUsage := func(dir string, usedBytes, usedEntries int64) {
+		t.Helper()
+		quotas, err := f.ListDirectoryQuotas(ctx)
+		if err != nil {
+			t.Fatalf("list quotas: %v", err)
+		}
+		for _, q := range quotas {
+			if q.Directory == dir {
+				if q.UsedBytes != usedBytes || q.UsedEntries != usedEntries {
+					t.Fatalf("%s uses %d bytes %d entries, expected %d bytes %d entries", dir, q.UsedBytes, q.UsedEntries, usedBytes, usedEntries)
+				}
+				return
+			}
+		}
+		t.Fatalf("%s has no quota: %+v", dir, quotas)
+	}
+	isQuotaExceeded := func(err error) bool {
+		return err != nil && strings.Contains(err.Error(), MsgQuotaExceeded)
+	}
+
+	if err := createFile("/home/user1/a.txt", 100); err != nil {
+		t.Fatalf("create: %v", err)
+	}
+	if _, err := f.SetDirectoryQuota(ctx, "/home/user1/", 1000, 4); err != nil {
+		t.Fatalf("set quota: %v", err)
+	}
+	checkUsage("/home/user1", 100, 1)
+
+	// new parent directories are counted
+	if err := createFile("/home/user1/sub/b.txt", 500); err != nil {
+		t.Fatalf("create: %v", err)
+	}
+	checkUsage("/home/user1", 600, 3)
+	if err := createFile("/home/user1/c.txt", 500); !isQuotaExceeded(err) {
+		t.Fatalf("create over the bytes quota: %v", err)
+	}
+
+	// growing a file is checked, shrinking is always allowed
+	if err := createFile("/home/user1/a.txt", 900); !isQuotaExceeded(err) {
+		t.Fatalf("update over the bytes quota: %v", err)
+	}
+	if err := createFile("/home/user1/a.txt", 10); err != nil {
+		t.Fatalf("shrink: %v", err)
+	}
+	checkUsage("/home/user1", 510, 3)
+
+	if err := createFile("/home/user1/c.txt", 10); err != nil {
+		t.Fatalf("create: %v", err)
+	}
+	if err := createFile("/home/user1/d.txt", 10); !isQuotaExceeded(err) {
+		t.Fatalf("create over the entries quota: %v", err)
+	}
+	// replicated changes are not rejected
+	if err := f.CreateEntry(ctx, &Entry{FullPath: "/home/user1/d.txt", Attr: Attr{Mode: 0644, FileSize: 10}}, false, true, nil, false, 255); err != nil {
+		t.Fatalf("create from other cluster: %v", err)
+	}
+	checkUsage("/home/user1", 530, 5)
+
+	if err := f.DeleteEntryMetaAndData(ctx, "/home/user1/sub", true, false, false, false, nil); err != nil {
+		t.Fatalf("delete: %v", err)
+	}
+	checkUsage("/home/user1", 30, 3)
+
+	// moving into the quota directory is checked for the whole tree
+	if err := createFile("/tmp/big/e.txt", 2000); err != nil {
+		t.Fatalf("create: %v", err)
+	}
+	if err := f.CheckMoveQuota(ctx, "/tmp/big", "/home/user1/big"); !isQuotaExceeded(err) {
+		t.Fatalf("move over the quota: %v", err)
+	}
+	if err := f.CheckMoveQuota(ctx, "/home/user1/c.txt", "/home/user1/e.txt"); err != nil {
+		t.Fatalf("move within the quota directory: %v", err)
+	}
+
+	// drifted usage is fixed by recalculation
+	if err := f.putQuotaUsage(ctx, "/home/user1", 12345, 67); err != nil {
+		t.Fatalf("put usage: %v", err)
+	}
+	if quotas, err := f.RecalculateDirectoryQuotas(ctx, "", false); err != nil || len(quotas) != 1 {
+		t.Fatalf("recalculate %+v: %v", quotas, err)
+	}
+	checkUsage("/home/user1", 30, 3)
+
+	// the quota is kept in the directory attributes
+	f.LoadDirectoryQuotas()
+	checkUsage("/home/user1", 30, 3)
+
+	// deleting the quota directory removes its quota
+	if err := f.DeleteEntryMetaAndData(ctx, "/home/user1", true, false, false, false, nil); err != nil {
+		t.Fatalf("delete: %v", err)
+	}
+	if quotas, err := f.ListDirectoryQuotas(ctx); err != nil || len(quotas) != 0 {
+		t.Fatalf("quotas after deleting the directory %+v: %v", quotas, err)
+	}
+	if err := f.CreateEntry(ctx, &Entry{FullPath: "/home/user1", Attr: Attr{Mode: os.ModeDir | 0755}}, false, false, nil, false, 255); err != nil {
+		t.Fatalf("mkdir: %v", err)
+	}
+	if err := createFile("/home/user1/f.txt", 5000); err != nil {
+		t.Fatalf("create without quota: %v", err)
+	}
+	if _, err := f.SetDirectoryQuota(ctx, "/home/user1/f.txt", 1000, 0); err == nil {
+		t.Fatalf("set quota on a file")
+	}
+	if _, err := f.SetDirectoryQuota(ctx, "/", 1000, 0); err == nil {
+		t.Fatalf("set quota on the root")
+	}
+}
+
+func TestQuotaRecalculationHasListed(t *testing.T) {
+	// walking /q, listed /q up to "c", and /q/b up to "m", with /q/a done
+	r := &quotaRecalculation{
+		dir: "/q",
+		listing: map[util.FullPath]string{
+			"/q":   "c",
+			"/q/b": "m",
+		},
+		lastEntered: "/q/b",
+	}
+	for p, expected := range map[util.FullPath]bool{
+		"/q/a":     true,  // listed in /q
+		"/q/c":     true,  // listed in /q, not entered yet
+		"/q/d":     false, // the next page of /q
+		"/q/a/x":   true,  // /q/a is done
+		"/q/a/x/y": true,  // and so is everything under it
+		"/q/b/k":   true,  // listed in /q/b
+		"/q/b/z":   false, // the next page of /q/b
+		"/q/c/x":   false, // /q/c is not entered yet
+		"/q/b/k/x": false, // neither is /q/b/k
+	} {
+		if r.hasListed(p) != expected {
+			t.Errorf("%s listed: %v, expected %v", p, !expected, expected)
+		}
+	}
+}
+
+func TestQuotaReservation(t *testing.T) {
+	f := NewFiler(pb.ServerDiscovery{}, nil, "", "", "", "", "", 255, nil)
+	f.SetStore(newMemoryStore())
+	ctx := context.Background()
+	if err := f.CreateEntry(ctx, &Entry{FullPath: "/q", Attr: Attr{Mode: os.ModeDir | 0755}}, false, false, nil, false, 255); err != nil {
+		t.Fatalf("mkdir: %v", err)
+	}
+	if _, err := f.SetDirectoryQuota(ctx, "/q", 100, 0); err != nil {
+		t.Fatalf("set quota: %v", err)
+	}
+
+	// reservations are counted before the writes, so the later ones see them
+	reserved, err := f.ReserveQuota(ctx, "/q/a.txt", 60, 1)
+	if err != nil {
+		t.Fatalf("reserve: %v", err)
+	}
+	if _, err = f.ReserveQuota(ctx, "/q/b.txt", 60, 1); err == nil || !strings.Contains(err.Error(), MsgQuotaExceeded) {
+		t.Fatalf("reserve over the quota: %v", err)
+	}
+	// the reserved entry is not counted again
+	f.accountQuota(reserved, "/q/a.txt", 60, 1)
+	if usedBytes, usedEntries, _ := f.getQuotaUsage(ctx, "/q"); usedBytes != 60 || usedEntries != 1 {
+		t.Fatalf("usage %d bytes %d entries, expected 60 bytes 1 entry", usedBytes, usedEntries)
+	}
+	f.ReleaseQuota(reserved)
+	if usedBytes, usedEntries, _ := f.getQuotaUsage(ctx, "/q"); usedBytes != 0 || usedEntries != 0 {
+		t.Fatalf("usage %d bytes %d entries after release", usedBytes, usedEntries)
+	}
+
+	// the usage is owned by the same filer for every filer
+	if owner := f.quotaUsageOwner("/q"); owner != f.Dlm.Host {
+		t.Fatalf("single filer does not own the usage: %s", owner)
+	}
+	servers := []pb.ServerAddress{"filer1:8888", "filer2:8888", "filer3:8888"}
+	owners := make(map[pb.ServerAddress]bool)
+	for _, server := range servers {
+		other := NewFiler(pb.ServerDiscovery{}, nil, server, "", "", "", "", 255, nil)
+		other.Dlm.LockRing.SetSnapshot(servers)
+		owners[other.quotaUsageOwner("/q")] = true
+	}
+	if len(owners) != 1 {
+		t.Fatalf("owners %v", owners)
+	}
+}
@@ -9,11 +9,18 @@ import (
 
 func (f *Filer) CanRename(source, target util.FullPath, oldName string) error {
 	sourcePath := source.Child(oldName)
+	if IsSnapshotPath(sourcePath) || IsSnapshotPath(target) {
+		return fmt.Errorf("mv: snapshots are read only")
+	}
 	if strings.HasPrefix(string(target), string(sourcePath)) {
 		return fmt.Errorf("mv: can not move directory to a subdirectory of itself")
 	}
 
 	sourceBucket := f.DetectBucket(source)
+	if original, found := TrashOriginalPath(sourcePath); found {
+		// restoring from the trash
+		sourceBucket = f.DetectBucket(original)
+	}
 	targetBucket := f.DetectBucket(target)
 	if sourceBucket != targetBucket {
 		return fmt.Errorf("can not move across collection %s => %s", sourceBucket, targetBucket)
@@ -0,0 +1,483 @@
+package filer
+
+import (
+	"context"
+	"fmt"
+	"math"
+	"strings"
+	"time"
+
+	"github.com/gateway-dao/seaweedfs/weed/glog"
+	"github.com/gateway-dao/seaweedfs/weed/pb/filer_pb"
+	"github.com/gateway-dao/seaweedfs/weed/util"
+)
+
+/*
+A directory snapshot is a metadata-only copy of a directory tree under /.snapshots/<name>/,
+sharing the immutable chunks with the live tree.
+
+Each chunk of a snapshot has a reference in the filer store kv, counting the snapshots holding it,
+and whether the live tree has released it. The filer does not delete chunks referenced by snapshots,
+and deletes the released chunks when their last snapshot is deleted.
+
+Deletions are held back on the filer creating a snapshot. Other filers sharing the store see a new
+snapshot after its metadata event, so their deletions in the meantime are not held back.
+*/
+
+const (
+	SnapshotsDir = "/.snapshots"
+
+	snapshotDirectoryKey   = "snapshot.directory"
+	snapshotCreatedKey     = "snapshot.created"
+	snapshotEntryCountKey  = "snapshot.entries"
+	snapshotChunkCountKey  = "snapshot.chunks"
+	snapshotChunkRefPrefix = "snapshot.ref."
+)
+
+// IsSnapshotPath is true for /.snapshots and the snapshots, which are read only.
+func IsSnapshotPath(p util.FullPath) bool {
+	return p == SnapshotsDir || p.IsUnder(SnapshotsDir)
+}
+
+// CheckSnapshotWrite rejects changes to the snapshots, except those replicated from other clusters.
+func CheckSnapshotWrite(p util.FullPath, isFromOtherCluster bool) error {
+	if !isFromOtherCluster && IsSnapshotPath(p) {
+		return fmt.Errorf("%s is a read only snapshot", p)
+	}
+	return nil
+}
+
+func checkSnapshotName(name string) error {
+	if name == "" || name == "." || name == ".." || strings.Contains(name, "/") {
+		return fmt.Errorf("invalid snapshot name %q", name)
+	}
+	return nil
+}
+
+func int64ToBytes(v int64) []byte {
+	b := make([]byte, 8)
+	util.Uint64toBytes(b, uint64(v))
+	return b
+}
+
+func bytesToInt64(b []byte) int64 {
+	if len(b) != 8 {
+		return 0
+	}
+	return int64(util.BytesToUint64(b))
+}
+
+// relativePath is the path of p under the directory, without the leading slash.
+func relativePath(dir, p util.FullPath) string {
+	return strings.TrimPrefix(strings.TrimPrefix(string(p), string(dir)), "/")
+}
+
+type snapshotChunkRef struct {
+	count    uint32
+	released bool // the live tree does not use the chunk any more
+}
+
+func snapshotChunkRefKey(fileId string) []byte {
+	return []byte(snapshotChunkRefPrefix + fileId)
+}
+
+func (f *Filer) getSnapshotChunkRef(ctx context.Context, fileId string) (ref snapshotChunkRef, found bool, err error) {
+	value, err := f.Store.KvGet(ctx, snapshotChunkRefKey(fileId))
+	if err == ErrKvNotFound {
+		return ref, false, nil
+	}
+	if err != nil {
+		return ref, false, err
+	}
+	if len(value) != 5 {
+		return ref, false, fmt.Errorf("invalid snapshot reference of %s: %x", fileId, value)
+	}
+	ref.count = util.BytesToUint32(value[:4])
+	ref.released = value[4] == 1
+	return ref, true, nil
+}
+
+func (f *Filer) putSnapshotChunkRef(ctx context.Context, fileId string, ref snapshotChunkRef) error {
+	if ref.count == 0 {
+		return f.Store.KvDelete(ctx, snapshotChunkRefKey(fileId))
+	}
+	value := make([]byte, 5)
+	util.Uint32toBytes(value[:4], ref.count)
+	if ref.released {
+		value[4] = 1
+	}
+	return f.Store.KvPut(ctx, snapshotChunkRefKey(fileId), value)
+}
+
+// resolveFileIds lists the file ids of the chunks, including the chunks in the chunk manifests,
+// since updating an entry deletes the replaced data chunks one by one.
+func (f *Filer) resolveFileIds(chunks []*filer_pb.FileChunk) (fileIds []string, err error) {
+	dataChunks, manifestChunks, err := ResolveChunkManifest(f.MasterClient.GetLookupFileIdFunction(), chunks, 0, math.MaxInt64)
+	if err != nil {
+		return nil, err
+	}
+	for _, chunk := range dataChunks {
+		fileIds = append(fileIds, chunk.GetFileIdString())
+	}
+	for _, chunk := range manifestChunks {
+		fileIds = append(fileIds, chunk.GetFileIdString())
+	}
+	return
+}
+
+// holdSnapshotChunks adds one snapshot reference to each chunk.
+func (f *Filer) holdSnapshotChunks(ctx context.Context, fileIds []string) error {
+	f.snapshotLock.Lock()
+	defer f.snapshotLock.Unlock()
+	for _, fileId := range fileIds {
+		ref, _, err := f.getSnapshotChunkRef(ctx, fileId)
+		if err != nil {
+			return err
+		}
+		ref.count++
+		if err = f.putSnapshotChunkRef(ctx, fileId, ref); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// releaseSnapshotChunks removes one snapshot reference from each chunk,
+// and returns the chunks released by the live tree and all snapshots.
+func (f *Filer) releaseSnapshotChunks(ctx context.Context, fileIds []string) (toDelete []string, err error) {
+	f.snapshotLock.Lock()
+	defer f.snapshotLock.Unlock()
+	for _, fileId := range fileIds {
+		ref, found, err := f.getSnapshotChunkRef(ctx, fileId)
+		if err != nil {
+			return toDelete, err
+		}
+		if !found {
+			continue
+		}
+		ref.count--
+		if ref.count == 0 && ref.released {
+			toDelete = append(toDelete, fileId)
+		}
+		if err = f.putSnapshotChunkRef(ctx, fileId, ref); err != nil {
+			return toDelete, err
+		}
+	}
+	return
+}
+
+// reclaimSnapshotChunks marks the chunks as used by the live tree again, when restoring a snapshot.
+func (f *Filer) reclaimSnapshotChunks(ctx context.Context, fileIds []string) error {
+	f.snapshotLock.Lock()
+	defer f.snapshotLock.Unlock()
+	for _, fileId := range fileIds {
+		ref, found, err := f.getSnapshotChunkRef(ctx, fileId)
+		if err != nil {
+			return err
+		}
+		if !found || !ref.released {
+			continue
+		}
+		ref.released = false
+		if err = f.putSnapshotChunkRef(ctx, fileId, ref); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// filterSnapshotFileIds returns the file ids not referenced by any snapshot,
+// and marks the referenced ones as released by the live tree.
+func (f *Filer) filterSnapshotFileIds(fileIds []string) (toDelete []string) {
+	if f.snapshotsCreating.Load() > 0 {
+		// retry after the snapshots are created
+		for _, fileId := range fileIds {
+			f.fileIdDeletionQueue.EnQueue(fileId)
+		}
+		return nil
+	}
+	if !f.hasSnapshots.Load() {
+		return fileIds
+	}
+
+	ctx := context.Background()
+	f.snapshotLock.Lock()
+	defer f.snapshotLock.Unlock()
+	for _, fileId := range fileIds {
+		ref, found, err := f.getSnapshotChunkRef(ctx, fileId)
+		if err != nil {
+			// keep the chunk when not sure
+			glog.Errorf("read snapshot reference of %s: %v", fileId, err)
+			continue
+		}
+		if !found {
+			toDelete = append(toDelete, fileId)
+			continue
+		}
+		if !ref.released {
+			ref.released = true
+			if err = f.putSnapshotChunkRef(ctx, fileId, ref); err != nil {
+				glog.Errorf("release snapshot chunk %s: %v", fileId, err)
+			}
+		}
+	}
+	return
+}
+
+// walkSnapshotTree visits the entries under the directory, parents before children.
+func (f *Filer) walkSnapshotTree(ctx context.Context, dir util.FullPath, fn func(entry *Entry) error) error {
+	lastFileName := ""
+	for {
+		entries, hasMore, err := f.ListDirectoryEntries(ctx, dir, lastFileName, false, PaginationSize, "", "", "")
+		if err != nil {
+			return fmt.Errorf("list %s: %v", dir, err)
+		}
+		for _, entry := range entries {
+			lastFileName = entry.Name()
+			if entry.FullPath == SnapshotsDir {
+				continue
+			}
+			if err = fn(entry); err != nil {
+				return err
+			}
+			if entry.IsDirectory() {
+				if err = f.walkSnapshotTree(ctx, entry.FullPath, fn); err != nil {
+					return err
+				}
+			}
+		}
+		if !hasMore {
+			return nil
+		}
+	}
+}
+
+func (f *Filer) insertSnapshotEntry(ctx context.Context, entry *Entry) error {
+	if err := f.Store.InsertEntry(ctx, entry); err != nil {
+		return fmt.Errorf("insert %s: %v", entry.FullPath, err)
+	}
+	f.NotifyUpdateEvent(ctx, nil, entry, false, false, nil)
+	return nil
+}
+
+// CreateSnapshot copies the metadata of the directory tree to /.snapshots/<name>.
+// Files with a ttl are skipped, since their volumes expire anyway.
+func (f *Filer) CreateSnapshot(ctx context.Context, name string, directory util.FullPath) (*filer_pb.Snapshot, error) {
+	if err := checkSnapshotName(name); err != nil {
+		return nil, err
+	}
+	if len(directory) > 1 {
+		directory = util.FullPath(strings.TrimSuffix(string(directory), "/"))
+	}
+	if IsSnapshotPath(directory) {
+		return nil, fmt.Errorf("can not snapshot the snapshots")
+	}
+	dirEntry, err := f.FindEntry(ctx, directory)
+	if err != nil {
+		return nil, fmt.Errorf("find %s: %v", directory, err)
+	}
+	if !dirEntry.IsDirectory() {
+		return nil, fmt.Errorf("%s is not a directory", directory)
+	}
+	snapshotPath := util.FullPath(SnapshotsDir).Child(name)
+	if _, err = f.FindEntry(ctx, snapshotPath); err == nil {
+		return nil, fmt.Errorf("snapshot %s already exists", name)
+	}
+
+	f.snapshotsCreating.Add(1)
+	defer f.snapshotsCreating.Add(-1)
+
+	now := time.Now()
+	root := dirEntry.ShallowClone()
+	root.FullPath = snapshotPath
+	root.Extended = make(map[string][]byte, len(dirEntry.Extended)+4)
+	for k, v := range dirEntry.Extended {
+		root.Extended[k] = v
+	}
+	root.Extended[snapshotDirectoryKey] = []byte(directory)
+	root.Extended[snapshotCreatedKey] = int64ToBytes(now.UnixNano())
+	dirParts := strings.Split(string(snapshotPath), "/")
+	if err = f.ensureParentDirectoryEntry(ctx, root, dirParts, len(dirParts)-1, false); err != nil {
+		return nil, err
+	}
+	if err = f.insertSnapshotEntry(ctx, root); err != nil {
+		return nil, err
+	}
+	f.hasSnapshots.Store(true)
+
+	snapshot := &filer_pb.Snapshot{
+		Name:        name,
+		Directory:   string(directory),
+		CreatedAtNs: now.UnixNano(),
+	}
+	err = f.walkSnapshotTree(ctx, directory, func(entry *Entry) error {
+		if !entry.IsDirectory() && entry.TtlSec > 0 {
+			return nil
+		}
+		copied := entry.ShallowClone()
+		copied.FullPath = snapshotPath.Child(relativePath(directory, entry.FullPath))
+		copied.HardLinkId, copied.HardLinkCounter = nil, 0
+		if !entry.IsDirectory() {
+			fileIds, err := f.resolveFileIds(entry.GetChunks())
+			if err != nil {
+				return fmt.Errorf("resolve chunks of %s: %v", entry.FullPath, err)
+			}
+			// hold the chunks before the snapshot entry exists
+			if err = f.holdSnapshotChunks(ctx, fileIds); err != nil {
+				return fmt.Errorf("hold chunks of %s: %v", entry.FullPath, err)
+			}
+			snapshot.ChunkCount += int64(len(fileIds))
+		}
+		snapshot.EntryCount++
+		return f.insertSnapshotEntry(ctx, copied)
+	})
+	if err != nil {
+		return nil, fmt.Errorf("snapshot %s to %s, delete the incomplete snapshot: %v", directory, name, err)
+	}
+
+	root.Extended[snapshotEntryCountKey] = int64ToBytes(snapshot.EntryCount)
+	root.Extended[snapshotChunkCountKey] = int64ToBytes(snapshot.ChunkCount)
+	if err = f.Store.UpdateEntry(ctx, root); err != nil {
+		return nil, fmt.Errorf("update %s: %v", snapshotPath, err)
+	}
+	glog.V(0).Infof("snapshot %s of %s: %d entries, %d chunks", name, directory, snapshot.EntryCount, snapshot.ChunkCount)
+	return snapshot, nil
+}
+
+func toSnapshot(entry *Entry) *filer_pb.Snapshot {
+	return &filer_pb.Snapshot{
+		Name:        entry.Name(),
+		Directory:   string(entry.Extended[snapshotDirectoryKey]),
+		CreatedAtNs: bytesToInt64(entry.Extended[snapshotCreatedKey]),
+		EntryCount:  bytesToInt64(entry.Extended[snapshotEntryCountKey]),
+		ChunkCount:  bytesToInt64(entry.Extended[snapshotChunkCountKey]),
+	}
+}
+
+func (f *Filer) ListSnapshots(ctx context.Context) (snapshots []*filer_pb.Snapshot, err error) {
+	lastFileName := ""
+	for {
+		entries, hasMore, err := f.ListDirectoryEntries(ctx, SnapshotsDir, lastFileName, false, PaginationSize, "", "", "")
+		if err != nil && err != filer_pb.ErrNotFound {
+			return nil, err
+		}
+		for _, entry := range entries {
+			lastFileName = entry.Name()
+			if entry.IsDirectory() {
+				snapshots = append(snapshots, toSnapshot(entry))
+			}
+		}
+		if !hasMore {
+			return snapshots, nil
+		}
+	}
+}
+
+func (f *Filer) findSnapshot(ctx context.Context, name string) (*Entry, error) {
+	if err := checkSnapshotName(name); err != nil {
+		return nil, err
+	}
+	entry, err := f.FindEntry(ctx, util.FullPath(SnapshotsDir).Child(name))
+	if err == filer_pb.ErrNotFound {
+		return nil, fmt.Errorf("snapshot %s not found", name)
+	}
+	return entry, err
+}
+
+// DeleteSnapshot deletes the snapshot, and the chunks not used by the live tree or other snapshots.
+func (f *Filer) DeleteSnapshot(ctx context.Context, name string) (freedChunkCount int64, err error) {
+	root, err := f.findSnapshot(ctx, name)
+	if err != nil {
+		return 0, err
+	}
+
+	var fileIds []string
+	err = f.walkSnapshotTree(ctx, root.FullPath, func(entry *Entry) error {
+		if entry.IsDirectory() {
+			return nil
+		}
+		ids, err := f.resolveFileIds(entry.GetChunks())
+		if err != nil {
+			return fmt.Errorf("resolve chunks of %s: %v", entry.FullPath, err)
+		}
+		fileIds = append(fileIds, ids...)
+		return nil
+	})
+	if err != nil {
+		return 0, err
+	}
+
+	// delete the entries before releasing the chunks, so a failed deletion can only leave extra references
+	err = f.doBatchDeleteFolderMetaAndData(ctx, root, true, false, false, false, false, nil, func(hardLinkIds []HardLinkId) error {
+		return nil
+	})
+	if err == nil {
+		err = f.doDeleteEntryMetaAndData(ctx, root, false, false, nil)
+	}
+	if err != nil {
+		return 0, fmt.Errorf("delete snapshot %s: %v", name, err)
+	}
+	f.LoadSnapshots()
+
+	toDelete, err := f.releaseSnapshotChunks(ctx, fileIds)
+	f.doDeleteFileIds(toDelete)
+	if err != nil {
+		return int64(len(toDelete)), fmt.Errorf("release chunks of snapshot %s: %v", name, err)
+	}
+	glog.V(0).Infof("deleted snapshot %s, freed %d chunks", name, len(toDelete))
+	return int64(len(toDelete)), nil
+}
+
+// RestoreSnapshot copies the snapshot back to the directory, or to the snapshot directory by default.
+// Existing files are overwritten, and files created after the snapshot are kept.
+func (f *Filer) RestoreSnapshot(ctx context.Context, name string, directory util.FullPath) (entryCount int64, err error) {
+	root, err := f.findSnapshot(ctx, name)
+	if err != nil {
+		return 0, err
+	}
+	if directory == "" {
+		directory = util.FullPath(root.Extended[snapshotDirectoryKey])
+	}
+	if len(directory) > 1 {
+		directory = util.FullPath(strings.TrimSuffix(string(directory), "/"))
+	}
+	if directory == "" || IsSnapshotPath(directory) {
+		return 0, fmt.Errorf("invalid restore directory %q", directory)
+	}
+
+	err = f.walkSnapshotTree(ctx, root.FullPath, func(entry *Entry) error {
+		restored := entry.ShallowClone()
+		restored.FullPath = directory.Child(relativePath(root.FullPath, entry.FullPath))
+		if !entry.IsDirectory() {
+			fileIds, err := f.resolveFileIds(entry.GetChunks())
+			if err != nil {
+				return fmt.Errorf("resolve chunks of %s: %v", entry.FullPath, err)
+			}
+			if err = f.reclaimSnapshotChunks(ctx, fileIds); err != nil {
+				return fmt.Errorf("reclaim chunks of %s: %v", entry.FullPath, err)
+			}
+		}
+		if err := f.CreateEntry(ctx, restored, false, false, nil, false, f.MaxFilenameLength); err != nil {
+			return err
+		}
+		entryCount++
+		return nil
+	})
+	if err != nil {
+		return entryCount, fmt.Errorf("restore snapshot %s to %s: %v", name, directory, err)
+	}
+	glog.V(0).Infof("restored snapshot %s to %s: %d entries", name, directory, entryCount)
+	return entryCount, nil
+}
+
+// LoadSnapshots checks whether any snapshot exists, to skip the snapshot references when deleting chunks otherwise.
+func (f *Filer) LoadSnapshots() {
+	entries, _, err := f.ListDirectoryEntries(context.Background(), SnapshotsDir, "", false, 1, "", "", "")
+	if err != nil && err != filer_pb.ErrNotFound {
+		glog.Errorf("list snapshots: %v", err)
+		// keep the snapshot chunks when not sure
+		f.hasSnapshots.Store(true)
+		return
+	}
+	f.hasSnapshots.Store(len(entries) > 0)
+}
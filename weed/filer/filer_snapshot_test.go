@@ -0,0 +1,174 @@
+package filer
+
+import (
+	"context"
+	"os"
+	"sort"
+	"strings"
+	"testing"
+
+	"github.com/gateway-dao/seaweedfs/weed/pb"
+	"github.com/gateway-dao/seaweedfs/weed/pb/filer_pb"
+	"github.com/gateway-dao/seaweedfs/weed/util"
+)
+
+// memoryStore is a filer store in memory for tests.
+type memoryStore struct {
+	entries map[util.FullPath]*Entry
+	kv      map[string][]byte
+}
+
+func newMemoryStore() *memoryStore {
+	return &memoryStore{
+		entries: make(map[util.FullPath]*Entry),
+		kv:      make(map[string][]byte),
+	}
+}
+
+func (s *memoryStore) GetName() string { return "memory" }
+func (s *memoryStore) Initialize(configuration util.Configuration, prefix string) error {
+	return nil
+}
+func (s *memoryStore) InsertEntry(ctx context.Context, entry *Entry) error {
+	s.entries[entry.FullPath] = entry.ShallowClone()
+	return nil
+}
+func (s *memoryStore) UpdateEntry(ctx context.Context, entry *Entry) error {
+	return s.InsertEntry(ctx, entry)
+}
+func (s *memoryStore) FindEntry(ctx context.Context, p util.FullPath) (*Entry, error) {
+	if entry, found := s.entries[p]; found {
+		return entry.ShallowClone(), nil
+	}
+	return nil, filer_pb.ErrNotFound
+}
+func (s *memoryStore) DeleteEntry(ctx context.Context, p util.FullPath) error {
+	delete(s.entries, p)
+	return nil
+}
+func (s *memoryStore) DeleteFolderChildren(ctx context.Context, p util.FullPath) error {
+	for path := range s.entries {
+		if path.IsUnder(p) {
+			delete(s.entries, path)
+		}
+	}
+	return nil
+}
+func (s *memoryStore) ListDirectoryEntries(ctx context.Context, dirPath util.FullPath, startFileName string, includeStartFile bool, limit int64, eachEntryFunc ListEachEntryFunc) (string, error) {
+	return s.ListDirectoryPrefixedEntries(ctx, dirPath, startFileName, includeStartFile, limit, "", eachEntryFunc)
+}
+func (s *memoryStore) ListDirectoryPrefixedEntries(ctx context.Context, dirPath util.FullPath, startFileName string, includeStartFile bool, limit int64, prefix string, eachEntryFunc ListEachEntryFunc) (lastFileName string, err error) {
+	var names []string
+	for path := range s.entries {
+		dir, name := path.DirAndName()
+		if util.FullPath(dir) == dirPath && strings.HasPrefix(name, prefix) &&
+			(name > startFileName || includeStartFile && name == startFileName) {
+			names = append(names, name)
+		}
+	}
+	sort.Strings(names)
+	for i, name := range names {
+		if int64(i) >= limit || !eachEntryFunc(s.entries[dirPath.Child(name)].ShallowClone()) {
+			break
+		}
+		lastFileName = name
+	}
+	return
+}
+func (s *memoryStore) BeginTransaction(ctx context.Context) (context.Context, error) {
+	return ctx, nil
+}
+func (s *memoryStore) CommitTransaction(ctx context.Context) error   { return nil }
+func (s *memoryStore) RollbackTransaction(ctx context.Context) error { return nil }
+func (s *memoryStore) KvPut(ctx context.Context, key []byte, value []byte) error {
+	s.kv[string(key)] = value
+	return nil
+}
+func (s *memoryStore) KvGet(ctx context.Context, key []byte) ([]byte, error) {
+	if value, found := s.kv[string(key)]; found {
+		return value, nil
+	}
+	return nil, ErrKvNotFound
+}
+func (s *memoryStore) KvDelete(ctx context.Context, key []byte) error {
+	delete(s.kv, string(key))
+	return nil
+}
+func (s *memoryStore) Shutdown() {}
+
+func TestDirectorySnapshot(t *testing.T) {
+	f := NewFiler(pb.ServerDiscovery{}, nil, "", "", "", "", "", 255, nil)
+	f.SetStore(newMemoryStore())
+	ctx := context.Background()
+
+	createFile := func(path string, fileId string, ttlSec int32) {
+		entry := &Entry{
+			FullPath: util.FullPath(path),
+			Attr:     Attr{Mode: 0644, TtlSec: ttlSec},
+			Chunks:   []*filer_pb.FileChunk{{FileId: fileId, Size: 10}},
+		}
+		if err := f.CreateEntry(ctx, entry, false, false, nil, false, f.MaxFilenameLength); err != nil {
+			t.Fatalf("create %s: %v", path, err)
+		}
+	}
+	createFile("/data/a.txt", "1,01", 0)
+	createFile("/data/sub/b.txt", "2,02", 0)
+	createFile("/data/expiring.txt", "3,03", 3600)
+
+	snapshot, err := f.CreateSnapshot(ctx, "s1", "/data/")
+	if err != nil {
+		t.Fatalf("create snapshot: %v", err)
+	}
+	if snapshot.Directory != "/data" || snapshot.EntryCount != 3 || snapshot.ChunkCount != 2 {
+		t.Fatalf("unexpected snapshot %+v", snapshot)
+	}
+	if entry, err := f.FindEntry(ctx, "/.snapshots/s1/sub/b.txt"); err != nil || entry.Chunks[0].FileId != "2,02" {
+		t.Fatalf("snapshot entry: %v", err)
+	}
+	if _, err = f.CreateSnapshot(ctx, "s1", "/data"); err == nil {
+		t.Errorf("duplicated snapshot is created")
+	}
+	if snapshots, err := f.ListSnapshots(ctx); err != nil || len(snapshots) != 1 || snapshots[0].ChunkCount != 2 {
+		t.Errorf("list snapshots %+v: %v", snapshots, err)
+	}
+
+	// snapshots are read only
+	if err = f.CreateEntry(ctx, &Entry{FullPath: "/.snapshots/s1/c.txt", Attr: Attr{Mode: os.ModeDir}}, false, false, nil, false, 255); err == nil {
+		t.Errorf("created an entry in a snapshot")
+	}
+	if err = f.DeleteEntryMetaAndData(ctx, "/.snapshots/s1", true, false, false, false, nil); err == nil {
+		t.Errorf("deleted a snapshot as a directory")
+	}
+	if err = f.CanRename("/data", "/.snapshots/s1", "a.txt"); err == nil {
+		t.Errorf("moved a file into a snapshot")
+	}
+
+	// chunks of the snapshot are kept
+	if toDelete := f.filterSnapshotFileIds([]string{"1,01", "2,02", "3,03"}); len(toDelete) != 1 || toDelete[0] != "3,03" {
+		t.Fatalf("deleting snapshot chunks: %v", toDelete)
+	}
+
+	restored, err := f.RestoreSnapshot(ctx, "s1", "/restored")
+	if err != nil || restored != 3 {
+		t.Fatalf("restore %d entries: %v", restored, err)
+	}
+	if _, err = f.FindEntry(ctx, "/restored/sub/b.txt"); err != nil {
+		t.Fatalf("restored entry: %v", err)
+	}
+
+	// only the chunks released again by the live tree are deleted with the snapshot
+	f.filterSnapshotFileIds([]string{"2,02"})
+	freed, err := f.DeleteSnapshot(ctx, "s1")
+	if err != nil || freed != 1 {
+		t.Fatalf("delete snapshot freed %d chunks: %v", freed, err)
+	}
+	if _, err = f.FindEntry(ctx, "/.snapshots/s1"); err != filer_pb.ErrNotFound {
+		t.Errorf("snapshot is not deleted: %v", err)
+	}
+	if _, found, _ := f.getSnapshotChunkRef(ctx, "1,01"); found {
+		t.Errorf("chunk is still referenced")
+	}
+	if toDelete := f.filterSnapshotFileIds([]string{"1,01"}); len(toDelete) != 1 {
+		t.Errorf("chunk is kept without snapshots")
+	}
+}
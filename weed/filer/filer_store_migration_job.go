@@ -0,0 +1,434 @@
+package filer
+
+import (
+	"context"
+	"fmt"
+	"math/rand"
+	"strings"
+	"time"
+
+	"github.com/gateway-dao/seaweedfs/weed/glog"
+	"github.com/gateway-dao/seaweedfs/weed/pb"
+	"github.com/gateway-dao/seaweedfs/weed/pb/filer_pb"
+	"github.com/gateway-dao/seaweedfs/weed/util"
+)
+
+const (
+	StoreMigrationRefreshInterval = 10 * time.Second
+	DefaultStoreMigrationSample   = 0.01
+
+	// the backfill or the verification is taken over by another filer after
+	// its owner has not saved the checkpoint for this long
+	storeMigrationOwnerTimeout = time.Minute
+	storeMigrationSaveInterval = 10 * time.Second
+	// a filer reports its store migration every StoreMigrationRefreshInterval
+	storeMigrationFilerTimeout = 3 * StoreMigrationRefreshInterval
+)
+
+// MigrateStore reports the store migration, or moves it forward with the action:
+//
+//	start:  copy the old store to the new store, and verify it, or resume them
+//	verify: verify the new store again
+//	switch: read from the new store
+//	finish: stop writing to the old store
+func (f *Filer) MigrateStore(action string, sampleRate float64, self pb.ServerAddress) (*filer_pb.StoreMigrationStatus, error) {
+	m := f.StoreMigration
+	if m == nil {
+		return nil, fmt.Errorf("no store migration is configured in filer.toml")
+	}
+	if err := m.RefreshStatus(); err != nil {
+		return nil, err
+	}
+	if err := m.SaveFilerStatus(self); err != nil {
+		return nil, err
+	}
+	filers, err := f.storeMigrationFilers(m, self)
+	if err != nil {
+		return nil, err
+	}
+
+	switch action {
+	case "":
+	case "start":
+		err = f.startStoreMigrationJob(m, self, func(status *filer_pb.StoreMigrationStatus) error {
+			switch status.Phase {
+			case StoreMigrationDualWrite:
+				status.Phase = StoreMigrationBackfill
+				status.Checkpoint = ""
+			case StoreMigrationBackfill, StoreMigrationVerify:
+				// resume from the checkpoint
+			default:
+				return fmt.Errorf("the store migration is in phase %s", status.Phase)
+			}
+			if sampleRate > 0 {
+				status.SampleRate = sampleRate
+			}
+			return nil
+		})
+	case "verify":
+		err = f.startStoreMigrationJob(m, self, func(status *filer_pb.StoreMigrationStatus) error {
+			if status.Phase != StoreMigrationVerified && status.Phase != StoreMigrationVerify {
+				return fmt.Errorf("the store migration is in phase %s", status.Phase)
+			}
+			if status.Phase == StoreMigrationVerified {
+				status.Phase = StoreMigrationVerify
+				status.Checkpoint = ""
+				status.VerifiedEntries = 0
+				status.MismatchedEntries = 0
+			}
+			if sampleRate > 0 {
+				status.SampleRate = sampleRate
+			}
+			return nil
+		})
+	case "switch":
+		err = m.updateStatus(func(status *filer_pb.StoreMigrationStatus) error {
+			if status.Phase != StoreMigrationVerified {
+				return fmt.Errorf("the store migration is in phase %s, not %s", status.Phase, StoreMigrationVerified)
+			}
+			if status.MismatchedEntries > 0 {
+				return fmt.Errorf("the verification repaired %d entries, verify again", status.MismatchedEntries)
+			}
+			if err := checkStoreMigrationFilers(filers, ""); err != nil {
+				return err
+			}
+			status.Phase = StoreMigrationSwitched
+			return nil
+		})
+	case "finish":
+		err = m.updateStatus(func(status *filer_pb.StoreMigrationStatus) error {
+			if status.Phase != StoreMigrationSwitched {
+				return fmt.Errorf("the store migration is in phase %s, not %s", status.Phase, StoreMigrationSwitched)
+			}
+			if err := checkStoreMigrationFilers(filers, StoreMigrationSwitched); err != nil {
+				return err
+			}
+			status.Phase = StoreMigrationDone
+			return nil
+		})
+	default:
+		err = fmt.Errorf("unknown store migration action %q", action)
+	}
+	if err != nil {
+		return nil, err
+	}
+	status := m.Status()
+	status.Filers = filers
+	return status, nil
+}
+
+// storeMigrationFilers loads the status reported by each live filer.
+// A filer which has not reported has an empty phase.
+func (f *Filer) storeMigrationFilers(m *StoreMigration, self pb.ServerAddress) (filers []*filer_pb.StoreMigrationFiler, err error) {
+	addresses := []pb.ServerAddress{self}
+	if f.Dlm != nil && f.Dlm.LockRing != nil {
+		for _, address := range f.Dlm.LockRing.GetSnapshot() {
+			if address != self {
+				addresses = append(addresses, address)
+			}
+		}
+	}
+	for _, address := range addresses {
+		filerStatus, err := m.LoadFilerStatus(address)
+		if err == ErrKvNotFound ||
+			err == nil && time.Since(time.Unix(0, filerStatus.UpdatedAtNs)) > storeMigrationFilerTimeout {
+			filerStatus, err = &filer_pb.StoreMigrationFiler{Address: string(address)}, nil
+		}
+		if err != nil {
+			return nil, err
+		}
+		filers = append(filers, filerStatus)
+	}
+	return filers, nil
+}
+
+// checkStoreMigrationFilers fails if a live filer has not reported recently, has failed writes,
+// or does not use the phase, if the phase is given.
+func checkStoreMigrationFilers(filers []*filer_pb.StoreMigrationFiler, phase string) error {
+	for _, filerStatus := range filers {
+		if filerStatus.Phase == "" {
+			return fmt.Errorf("filer %s has not reported the store migration, is it configured with the same [migration.*] store", filerStatus.Address)
+		}
+		if failed := len(filerStatus.FailedPaths) + len(filerStatus.FailedFolders) + len(filerStatus.FailedKeys); failed > 0 {
+			return fmt.Errorf("filer %s has %d writes not in both stores yet", filerStatus.Address, failed)
+		}
+		if phase != "" && filerStatus.Phase != phase {
+			return fmt.Errorf("filer %s is in phase %s, not %s", filerStatus.Address, filerStatus.Phase, phase)
+		}
+	}
+	return nil
+}
+
+func (f *Filer) startStoreMigrationJob(m *StoreMigration, self pb.ServerAddress, fn func(status *filer_pb.StoreMigrationStatus) error) error {
+	m.statusLock.Lock()
+	if m.isRunning {
+		m.statusLock.Unlock()
+		return fmt.Errorf("the store migration is running on this filer")
+	}
+	status := m.status
+	if isMigrationJob(status.Phase) && status.Owner != "" && status.Owner != string(self) &&
+		time.Since(time.Unix(0, status.UpdatedAtNs)) < storeMigrationOwnerTimeout {
+		m.statusLock.Unlock()
+		return fmt.Errorf("the store migration is running on %s", status.Owner)
+	}
+	if err := fn(status); err != nil {
+		m.statusLock.Unlock()
+		return err
+	}
+	if status.SampleRate <= 0 || status.SampleRate > 1 {
+		status.SampleRate = DefaultStoreMigrationSample
+	}
+	status.Owner = string(self)
+	status.Error = ""
+	m.isRunning = true
+	m.statusLock.Unlock()
+
+	if err := m.saveStatus(); err != nil {
+		m.statusLock.Lock()
+		m.isRunning = false
+		m.statusLock.Unlock()
+		return err
+	}
+	go f.runStoreMigrationJob(m)
+	return nil
+}
+
+func isMigrationJob(phase string) bool {
+	return phase == StoreMigrationBackfill || phase == StoreMigrationVerify
+}
+
+// ResumeStoreMigration resumes the backfill or the verification this filer was running.
+func (f *Filer) ResumeStoreMigration(self pb.ServerAddress) {
+	m := f.StoreMigration
+	if m == nil {
+		return
+	}
+	status := m.Status()
+	if !isMigrationJob(status.Phase) || status.Owner != string(self) {
+		return
+	}
+	glog.V(0).Infof("resume store migration %s from %s", status.Phase, status.Checkpoint)
+	if err := f.startStoreMigrationJob(m, self, func(status *filer_pb.StoreMigrationStatus) error {
+		return nil
+	}); err != nil {
+		glog.Errorf("resume store migration: %v", err)
+	}
+}
+
+func (f *Filer) runStoreMigrationJob(m *StoreMigration) {
+	err := f.doRunStoreMigrationJob(context.Background(), m)
+
+	m.statusLock.Lock()
+	if err != nil {
+		m.status.Error = err.Error()
+	} else {
+		m.status.Owner = ""
+	}
+	m.statusLock.Unlock()
+
+	if err != nil {
+		glog.Errorf("store migration: %v", err)
+	}
+	if saveErr := m.saveStatus(); saveErr != nil {
+		glog.Errorf("store migration: %v", saveErr)
+	}
+
+	m.statusLock.Lock()
+	m.isRunning = false
+	m.statusLock.Unlock()
+}
+
+func (f *Filer) doRunStoreMigrationJob(ctx context.Context, m *StoreMigration) error {
+	if m.Phase() == StoreMigrationBackfill {
+		if err := f.backfillStore(ctx, m); err != nil {
+			return fmt.Errorf("backfill: %v", err)
+		}
+		if err := m.updateStatus(func(status *filer_pb.StoreMigrationStatus) error {
+			status.Phase = StoreMigrationVerify
+			status.Checkpoint = ""
+			return nil
+		}); err != nil {
+			return err
+		}
+		glog.V(0).Infof("store migration backfilled %d entries", m.Status().CopiedEntries)
+	}
+	if m.Phase() == StoreMigrationVerify {
+		if err := f.verifyStore(ctx, m); err != nil {
+			return fmt.Errorf("verify: %v", err)
+		}
+		if err := m.updateStatus(func(status *filer_pb.StoreMigrationStatus) error {
+			status.Phase = StoreMigrationVerified
+			status.Checkpoint = ""
+			return nil
+		}); err != nil {
+			return err
+		}
+		status := m.Status()
+		glog.V(0).Infof("store migration verified %d entries, repaired %d", status.VerifiedEntries, status.MismatchedEntries)
+	}
+	return nil
+}
+
+func (f *Filer) backfillStore(ctx context.Context, m *StoreMigration) error {
+	// the key-values not found by traversing the entries
+	for _, key := range []string{FilerStoreId, quotaDirectoriesKey} {
+		if _, err := m.copyKv(ctx, []byte(key)); err != nil && err != ErrKvNotFound {
+			return fmt.Errorf("copy %s: %v", key, err)
+		}
+	}
+
+	return f.walkStoreForMigration(ctx, m, func(entry *Entry) error {
+		if err := m.copyEntry(ctx, entry.FullPath); err != nil {
+			return err
+		}
+		keys, err := f.storeMigrationKvKeys(entry)
+		if err != nil {
+			return err
+		}
+		for _, key := range keys {
+			if _, err := m.copyKv(ctx, key); err != nil && err != ErrKvNotFound {
+				return fmt.Errorf("copy key-value of %s: %v", entry.FullPath, err)
+			}
+		}
+		m.statusLock.Lock()
+		m.status.CopiedEntries++
+		m.statusLock.Unlock()
+		return nil
+	})
+}
+
+// storeMigrationKvKeys lists the key-values of the entry.
+func (f *Filer) storeMigrationKvKeys(entry *Entry) (keys [][]byte, err error) {
+	if len(entry.HardLinkId) > 0 {
+		keys = append(keys, entry.HardLinkId)
+	}
+	if entry.IsDirectory() {
+		if _, found := quotaOfExtended(entry.Extended); found {
+			keys = append(keys, quotaUsageKey(entry.FullPath))
+		}
+	} else if IsSnapshotPath(entry.FullPath) && len(entry.GetChunks()) > 0 {
+		fileIds, err := f.resolveFileIds(entry.GetChunks())
+		if err != nil {
+			return nil, fmt.Errorf("resolve chunks of %s: %v", entry.FullPath, err)
+		}
+		for _, fileId := range fileIds {
+			keys = append(keys, snapshotChunkRefKey(fileId))
+		}
+	}
+	return
+}
+
+func (f *Filer) verifyStore(ctx context.Context, m *StoreMigration) error {
+	sampleRate := m.Status().SampleRate
+	return f.walkStoreForMigration(ctx, m, func(entry *Entry) error {
+		if rand.Float64() >= sampleRate {
+			return nil
+		}
+		matched, err := m.verifyEntry(ctx, entry.FullPath)
+		if err != nil {
+			return err
+		}
+		m.statusLock.Lock()
+		m.status.VerifiedEntries++
+		if !matched {
+			m.status.MismatchedEntries++
+		}
+		m.statusLock.Unlock()
+		if !matched {
+			glog.V(0).Infof("store migration repaired %s", entry.FullPath)
+		}
+		return nil
+	})
+}
+
+// walkStoreForMigration traverses the old store from the checkpoint, and saves the checkpoint periodically.
+func (f *Filer) walkStoreForMigration(ctx context.Context, m *StoreMigration, fn func(entry *Entry) error) error {
+	var resumeFrom []string
+	if checkpoint := m.Status().Checkpoint; checkpoint != "" {
+		resumeFrom = strings.Split(strings.TrimPrefix(checkpoint, "/"), "/")
+	}
+	lastSaved := time.Now()
+	err := f.walkOldStore(ctx, m, "/", resumeFrom, func(entry *Entry) error {
+		if err := fn(entry); err != nil {
+			return err
+		}
+		m.statusLock.Lock()
+		m.status.Checkpoint = string(entry.FullPath)
+		m.statusLock.Unlock()
+		if time.Since(lastSaved) > storeMigrationSaveInterval {
+			lastSaved = time.Now()
+			return m.saveStatus()
+		}
+		return nil
+	})
+	if err != nil {
+		// keep the progress
+		if saveErr := m.saveStatus(); saveErr != nil {
+			glog.Errorf("store migration: %v", saveErr)
+		}
+	}
+	return err
+}
+
+// walkOldStore visits the entries of the old store depth first, in the order of the names.
+// The visit restarts at the path of resumeFrom, which is visited again.
+func (f *Filer) walkOldStore(ctx context.Context, m *StoreMigration, dir util.FullPath, resumeFrom []string, fn func(entry *Entry) error) error {
+	startFileName, includeStartFile := "", false
+	if len(resumeFrom) > 0 {
+		startFileName, includeStartFile = resumeFrom[0], true
+	}
+	for {
+		var entries []*Entry
+		lastFileName, err := m.oldStore.ListDirectoryEntries(ctx, dir, startFileName, includeStartFile, PaginationSize, func(entry *Entry) bool {
+			entries = append(entries, entry)
+			return true
+		})
+		if err != nil {
+			return fmt.Errorf("list %s: %v", dir, err)
+		}
+		for _, entry := range entries {
+			if err = fn(entry); err != nil {
+				return err
+			}
+			if !entry.IsDirectory() {
+				continue
+			}
+			var rest []string
+			if len(resumeFrom) > 0 && entry.Name() == resumeFrom[0] {
+				rest = resumeFrom[1:]
+			}
+			if err = f.walkOldStore(ctx, m, entry.FullPath, rest, fn); err != nil {
+				return err
+			}
+		}
+		resumeFrom = nil
+		if len(entries) < PaginationSize {
+			return nil
+		}
+		startFileName, includeStartFile = lastFileName, false
+	}
+}
+
+// LoopRefreshStoreMigration follows the store migration changed by other filers,
+// retries the failed writes, and reports the phase this filer uses.
+func (f *Filer) LoopRefreshStoreMigration(self pb.ServerAddress) {
+	m := f.StoreMigration
+	if m == nil {
+		return
+	}
+	for {
+		time.Sleep(StoreMigrationRefreshInterval)
+		phase := m.Phase()
+		if err := m.RefreshStatus(); err != nil {
+			glog.Errorf("refresh store migration: %v", err)
+			continue
+		}
+		if newPhase := m.Phase(); newPhase != phase {
+			glog.V(0).Infof("store migration phase %s => %s", phase, newPhase)
+		}
+		m.RetryFailedWrites(context.Background())
+		if err := m.SaveFilerStatus(self); err != nil {
+			glog.Errorf("report store migration: %v", err)
+		}
+	}
+}
@@ -0,0 +1,316 @@
+package filer
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/gateway-dao/seaweedfs/weed/pb"
+	"github.com/gateway-dao/seaweedfs/weed/pb/filer_pb"
+	"github.com/gateway-dao/seaweedfs/weed/util"
+)
+
+func TestStoreMigration(t *testing.T) {
+	f := NewFiler(pb.ServerDiscovery{}, nil, "", "", "", "", "", 255, nil)
+	oldStore, newStore := newMemoryStore(), newMemoryStore()
+	f.SetStore(oldStore)
+	ctx := context.Background()
+
+	create := func(path string, mode os.FileMode) {
+		if err := f.CreateEntry(ctx, &Entry{FullPath: util.FullPath(path), Attr: Attr{Mode: mode}}, false, false, nil, false, 255); err != nil {
+			t.Fatalf("create %s: %v", path, err)
+		}
+	}
+	waitForPhase := func(phase string) {
+		isDone := func() bool {
+			m := f.StoreMigration
+			m.statusLock.RLock()
+			defer m.statusLock.RUnlock()
+			return !m.isRunning && m.status.Phase == phase
+		}
+		for i := 0; !isDone(); i++ {
+			if i > 100 {
+				t.Fatalf("store migration status %+v", f.StoreMigration.Status())
+			}
+			time.Sleep(10 * time.Millisecond)
+		}
+	}
+	create("/a/b/c.txt", 0644)
+	create("/a/d.txt", 0644)
+	create("/e.txt", 0644)
+
+	m, err := f.Store.MigrateDefaultStore(newStore)
+	if err != nil {
+		t.Fatalf("migrate: %v", err)
+	}
+	f.StoreMigration = m
+
+	// dual write
+	create("/a/f.txt", 0644)
+	if err = f.DeleteEntryMetaAndData(ctx, "/e.txt", false, false, false, false, nil); err != nil {
+		t.Fatalf("delete: %v", err)
+	}
+	if _, found := newStore.entries["/a/f.txt"]; !found {
+		t.Fatalf("new entry is not written to the new store")
+	}
+	if _, err = f.MigrateStore("switch", 0, "filer:8888"); err == nil {
+		t.Fatalf("switched before verification")
+	}
+
+	if _, err = f.MigrateStore("start", 1, "filer:8888"); err != nil {
+		t.Fatalf("start: %v", err)
+	}
+	waitForPhase(StoreMigrationVerified)
+	status := m.Status()
+	if status.Error != "" || status.Owner != "" || status.MismatchedEntries != 0 || status.VerifiedEntries != 5 {
+		t.Fatalf("status after verification: %+v", status)
+	}
+	for path := range oldStore.entries {
+		if _, found := newStore.entries[path]; !found {
+			t.Errorf("%s is not copied", path)
+		}
+	}
+	if len(newStore.entries) != len(oldStore.entries) {
+		t.Errorf("new store has %d entries, old store %d", len(newStore.entries), len(oldStore.entries))
+	}
+	if _, found := newStore.kv[FilerStoreId]; !found {
+		t.Errorf("filer store id is not copied")
+	}
+
+	// the verification repairs the differences
+	delete(newStore.entries, "/a/d.txt")
+	if _, err = f.MigrateStore("verify", 1, "filer:8888"); err != nil {
+		t.Fatalf("verify: %v", err)
+	}
+	waitForPhase(StoreMigrationVerified)
+	if status = m.Status(); status.MismatchedEntries != 1 {
+		t.Fatalf("status after repairing: %+v", status)
+	}
+	if _, err = f.MigrateStore("switch", 0, "filer:8888"); err == nil {
+		t.Fatalf("switched with repaired entries")
+	}
+	if _, err = f.MigrateStore("verify", 1, "filer:8888"); err != nil {
+		t.Fatalf("verify: %v", err)
+	}
+	waitForPhase(StoreMigrationVerified)
+
+	// switch the reads, and then stop writing to the old store
+	if _, err = f.MigrateStore("switch", 0, "filer:8888"); err != nil {
+		t.Fatalf("switch: %v", err)
+	}
+	delete(oldStore.entries, "/a/d.txt")
+	if _, err = f.FindEntry(ctx, "/a/d.txt"); err != nil {
+		t.Fatalf("read from the new store: %v", err)
+	}
+	oldStore.kv["sync.offset"] = []byte("1")
+	if value, err := f.Store.KvGet(ctx, []byte("sync.offset")); err != nil || string(value) != "1" || string(newStore.kv["sync.offset"]) != "1" {
+		t.Fatalf("key-value from the old store: %s %v", value, err)
+	}
+	if _, err = f.MigrateStore("finish", 0, "filer:8888"); err != nil {
+		t.Fatalf("finish: %v", err)
+	}
+	create("/g.txt", 0644)
+	if _, found := oldStore.entries["/g.txt"]; found {
+		t.Fatalf("written to the old store after finishing")
+	}
+
+	// the migration status is kept in the new store
+	reloaded, err := NewStoreMigration(oldStore, newStore)
+	if err != nil || reloaded.Phase() != StoreMigrationDone {
+		t.Fatalf("reload store migration: %v", err)
+	}
+}
+
+func TestStoreMigrationResume(t *testing.T) {
+	oldStore := newMemoryStore()
+	ctx := context.Background()
+	for _, path := range []string{"/0", "/a", "/a/a0", "/a/b", "/a/b/b0", "/a/b/c", "/a/b/d", "/a/e", "/f"} {
+		oldStore.InsertEntry(ctx, &Entry{FullPath: util.FullPath(path), Attr: Attr{Mode: os.ModeDir | 0755}})
+	}
+	f := NewFiler(pb.ServerDiscovery{}, nil, "", "", "", "", "", 255, nil)
+	m := &StoreMigration{
+		oldStore: oldStore,
+		newStore: newMemoryStore(),
+		status:   &filer_pb.StoreMigrationStatus{Checkpoint: "/a/b/c"},
+	}
+
+	var visited []string
+	if err := f.walkStoreForMigration(ctx, m, func(entry *Entry) error {
+		visited = append(visited, string(entry.FullPath))
+		return nil
+	}); err != nil {
+		t.Fatalf("walk: %v", err)
+	}
+	// the checkpoint and its parents are visited again
+	expected := []string{"/a", "/a/b", "/a/b/c", "/a/b/d", "/a/e", "/f"}
+	if len(visited) != len(expected) {
+		t.Fatalf("visited %v, expected %v", visited, expected)
+	}
+	for i := range expected {
+		if visited[i] != expected[i] {
+			t.Fatalf("visited %v, expected %v", visited, expected)
+		}
+	}
+	if checkpoint := m.Status().Checkpoint; checkpoint != "/f" {
+		t.Fatalf("checkpoint %s", checkpoint)
+	}
+}
+
+type failingStore struct {
+	*memoryStore
+	isFailing bool
+}
+
+func (store *failingStore) InsertEntry(ctx context.Context, entry *Entry) error {
+	if store.isFailing {
+		return fmt.Errorf("unavailable")
+	}
+	return store.memoryStore.InsertEntry(ctx, entry)
+}
+
+type txMemoryStoreKey struct{}
+
+// txMemoryStore keeps the entries inserted in a transaction until it is committed.
+type txMemoryStore struct {
+	*memoryStore
+}
+
+func (store *txMemoryStore) BeginTransaction(ctx context.Context) (context.Context, error) {
+	return context.WithValue(ctx, txMemoryStoreKey{}, newMemoryStore()), nil
+}
+func (store *txMemoryStore) CommitTransaction(ctx context.Context) error {
+	if pending, ok := ctx.Value(txMemoryStoreKey{}).(*memoryStore); ok {
+		for _, entry := range pending.entries {
+			store.memoryStore.InsertEntry(ctx, entry)
+		}
+	}
+	return nil
+}
+func (store *txMemoryStore) InsertEntry(ctx context.Context, entry *Entry) error {
+	if pending, ok := ctx.Value(txMemoryStoreKey{}).(*memoryStore); ok {
+		return pending.InsertEntry(ctx, entry)
+	}
+	return store.memoryStore.InsertEntry(ctx, entry)
+}
+func (store *txMemoryStore) FindEntry(ctx context.Context, p util.FullPath) (*Entry, error) {
+	if pending, ok := ctx.Value(txMemoryStoreKey{}).(*memoryStore); ok {
+		if entry, err := pending.FindEntry(ctx, p); err == nil {
+			return entry, nil
+		}
+	}
+	return store.memoryStore.FindEntry(ctx, p)
+}
+
+func TestStoreMigrationTransaction(t *testing.T) {
+	oldStore, newStore := &txMemoryStore{newMemoryStore()}, newMemoryStore()
+	m, err := NewStoreMigration(oldStore, newStore)
+	if err != nil {
+		t.Fatalf("migrate: %v", err)
+	}
+	ctx := context.Background()
+
+	write := func(path util.FullPath, end func(ctx context.Context) error) {
+		txCtx, err := m.BeginTransaction(ctx)
+		if err != nil {
+			t.Fatalf("begin transaction: %v", err)
+		}
+		if err = m.InsertEntry(txCtx, &Entry{FullPath: path, Attr: Attr{Mode: 0644}}); err != nil {
+			t.Fatalf("insert %s: %v", path, err)
+		}
+		if _, found := newStore.entries[path]; found {
+			t.Fatalf("%s is copied before the transaction ends", path)
+		}
+		if err = end(txCtx); err != nil {
+			t.Fatalf("end transaction: %v", err)
+		}
+	}
+	write("/rolled_back.txt", m.RollbackTransaction)
+	if _, found := newStore.entries["/rolled_back.txt"]; found {
+		t.Errorf("rolled back entry is copied")
+	}
+	write("/committed.txt", m.CommitTransaction)
+	if _, found := newStore.entries["/committed.txt"]; !found {
+		t.Errorf("committed entry is not copied")
+	}
+	if errors := m.Status().DualWriteErrors; errors != 0 {
+		t.Errorf("%d dual write errors", errors)
+	}
+}
+
+func TestStoreMigrationFilers(t *testing.T) {
+	f := NewFiler(pb.ServerDiscovery{}, nil, "", "", "", "", "", 255, nil)
+	oldStore, newStore := newMemoryStore(), &failingStore{memoryStore: newMemoryStore()}
+	f.SetStore(oldStore)
+	ctx := context.Background()
+	m, err := f.Store.MigrateDefaultStore(newStore)
+	if err != nil {
+		t.Fatalf("migrate: %v", err)
+	}
+	f.StoreMigration = m
+	m.updateStatus(func(status *filer_pb.StoreMigrationStatus) error {
+		status.Phase = StoreMigrationVerified
+		return nil
+	})
+
+	// the failed write is reported, and blocks switching until it is copied again
+	newStore.isFailing = true
+	if err = f.CreateEntry(ctx, &Entry{FullPath: "/a.txt", Attr: Attr{Mode: 0644}}, false, false, nil, false, 255); err != nil {
+		t.Fatalf("create: %v", err)
+	}
+	status, err := f.MigrateStore("switch", 0, "filer:8888")
+	if err == nil || !strings.Contains(err.Error(), "not in both stores") {
+		t.Fatalf("switched with failed writes: %v", err)
+	}
+	filerStatus, err := m.LoadFilerStatus("filer:8888")
+	if err != nil || len(filerStatus.FailedPaths) != 1 || filerStatus.FailedPaths[0] != "/a.txt" {
+		t.Fatalf("filer status %+v: %v", filerStatus, err)
+	}
+	newStore.isFailing = false
+	m.RetryFailedWrites(ctx)
+	if _, found := newStore.entries["/a.txt"]; !found {
+		t.Fatalf("failed write is not copied again")
+	}
+	if status, err = f.MigrateStore("switch", 0, "filer:8888"); err != nil {
+		t.Fatalf("switch: %v", err)
+	}
+	if len(status.Filers) != 1 || status.Filers[0].Phase != StoreMigrationVerified {
+		t.Fatalf("filers %+v", status.Filers)
+	}
+
+	// finishing waits for every live filer to switch
+	f.Dlm.LockRing.SetSnapshot([]pb.ServerAddress{"filer:8888", "filer:8889"})
+	if _, err = f.MigrateStore("finish", 0, "filer:8888"); err == nil || !strings.Contains(err.Error(), "filer:8889 has not reported") {
+		t.Fatalf("finished without the other filer: %v", err)
+	}
+	other := &StoreMigration{oldStore: oldStore, newStore: newStore, status: &filer_pb.StoreMigrationStatus{Phase: StoreMigrationSwitched}}
+	if err = other.SaveFilerStatus("filer:8889"); err != nil {
+		t.Fatalf("save: %v", err)
+	}
+	if _, err = f.MigrateStore("finish", 0, "filer:8888"); err != nil {
+		t.Fatalf("finish: %v", err)
+	}
+}
+
+func TestCopyStoreEntry(t *testing.T) {
+	ctx := context.Background()
+	from, to := newMemoryStore(), newMemoryStore()
+	from.InsertEntry(ctx, &Entry{FullPath: "/a", Attr: Attr{Mode: os.ModeDir | 0755}})
+	to.InsertEntry(ctx, &Entry{FullPath: "/b", Attr: Attr{Mode: os.ModeDir | 0755}})
+	to.InsertEntry(ctx, &Entry{FullPath: "/b/c.txt", Attr: Attr{Mode: 0644}})
+
+	for _, fp := range []util.FullPath{"/a", "/b"} {
+		if err := copyStoreEntry(ctx, fp, from, to); err != nil {
+			t.Fatalf("copy %s: %v", fp, err)
+		}
+	}
+	if _, found := to.entries["/a"]; !found {
+		t.Errorf("/a is not copied")
+	}
+	// the deleted directory is deleted with its children
+	if len(to.entries) != 1 {
+		t.Errorf("entries %v", to.entries)
+	}
+}
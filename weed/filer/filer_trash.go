@@ -0,0 +1,182 @@
+package filer
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"strings"
+	"time"
+
+	"github.com/gateway-dao/seaweedfs/weed/glog"
+	"github.com/gateway-dao/seaweedfs/weed/util"
+)
+
+/*
+The trash is enabled by the "trash" option of the path configurations in filer.conf, see fs.configure.
+
+Deleting a file, or a non-empty directory recursively, under such a path moves it to
+/.trash/<deletion time>/<original path>, keeping its chunks. Empty directories, buckets,
+and deletions replicated from other clusters are deleted as usual.
+
+The entries are restored by moving them back, see fs.trash.restore. Each filer purges the
+deletions older than their retention, which is kept on the /.trash/<deletion time> directory.
+*/
+
+const (
+	TrashDir = "/.trash"
+
+	DefaultTrashRetentionDays = 7
+	TrashPurgeInterval        = time.Hour
+
+	trashTimeFormat   = "20060102-150405.000000000"
+	trashRetentionKey = "trash.retention"
+)
+
+// IsTrashPath is true for /.trash and everything in it.
+func IsTrashPath(p util.FullPath) bool {
+	return p == TrashDir || p.IsUnder(TrashDir)
+}
+
+// TrashOriginalPath returns where the entry in the trash was deleted from.
+func TrashOriginalPath(p util.FullPath) (original util.FullPath, found bool) {
+	if !p.IsUnder(TrashDir) {
+		return "", false
+	}
+	deletion := strings.TrimPrefix(string(p), TrashDir+"/")
+	t := strings.Index(deletion, "/")
+	if t < 0 {
+		return "", false
+	}
+	return util.FullPath(deletion[t:]), true
+}
+
+// trashRetention returns the retention of the deleted entry, if it goes to the trash.
+func (f *Filer) trashRetention(ctx context.Context, entry *Entry, isRecursive, shouldDeleteChunks, isFromOtherCluster bool) (time.Duration, bool) {
+	if !shouldDeleteChunks || isFromOtherCluster || IsTrashPath(entry.FullPath) || IsVersionPath(entry.FullPath) || f.isBucket(entry) {
+		return 0, false
+	}
+	rule := f.FilerConf.MatchStorageRule(string(entry.FullPath))
+	if !rule.Trash {
+		return 0, false
+	}
+	if entry.IsDirectory() {
+		if !isRecursive {
+			return 0, false
+		}
+		entries, _, err := f.ListDirectoryEntries(ctx, entry.FullPath, "", false, 1, "", "", "")
+		if err != nil || len(entries) == 0 {
+			return 0, false
+		}
+	}
+	retentionDays := rule.TrashRetentionDays
+	if retentionDays == 0 {
+		retentionDays = DefaultTrashRetentionDays
+	}
+	return time.Duration(retentionDays) * 24 * time.Hour, true
+}
+
+// moveToTrash copies the entry tree into the trash, and deletes it without deleting the chunks.
+func (f *Filer) moveToTrash(ctx context.Context, entry *Entry, retention time.Duration, signatures []int32) error {
+	now := time.Now()
+	trashRoot := util.FullPath(TrashDir).Child(now.UTC().Format(trashTimeFormat))
+	if err := f.CreateEntry(ctx, &Entry{
+		FullPath: trashRoot,
+		Attr: Attr{
+			Mtime:  now,
+			Crtime: now,
+			Mode:   os.ModeDir | 0700,
+			Uid:    OS_UID,
+			Gid:    OS_GID,
+		},
+		Extended: map[string][]byte{
+			trashRetentionKey: int64ToBytes(int64(retention / time.Second)),
+		},
+	}, false, false, nil, false, f.MaxFilenameLength); err != nil {
+		return fmt.Errorf("create %s: %v", trashRoot, err)
+	}
+
+	if err := f.copyToTrash(ctx, trashRoot, entry); err != nil {
+		return err
+	}
+
+	// the chunks and hard links are kept, as when renaming
+	ctx = context.WithValue(ctx, "OP", "MV")
+	if err := f.DeleteEntryMetaAndData(ctx, entry.FullPath, true, false, false, false, signatures); err != nil {
+		return err
+	}
+	glog.V(1).Infof("moved %s to %s", entry.FullPath, trashRoot)
+	return nil
+}
+
+func (f *Filer) copyToTrash(ctx context.Context, trashRoot util.FullPath, entry *Entry) error {
+	trashEntry := entry.ShallowClone()
+	trashEntry.FullPath = util.FullPath(string(trashRoot) + string(entry.FullPath))
+	if err := f.CreateEntry(ctx, trashEntry, false, false, nil, false, f.MaxFilenameLength); err != nil {
+		return fmt.Errorf("move %s to trash: %v", entry.FullPath, err)
+	}
+	if !entry.IsDirectory() {
+		return nil
+	}
+	lastFileName := ""
+	for {
+		entries, hasMore, err := f.ListDirectoryEntries(ctx, entry.FullPath, lastFileName, false, PaginationSize, "", "", "")
+		if err != nil {
+			return fmt.Errorf("list %s: %v", entry.FullPath, err)
+		}
+		for _, child := range entries {
+			lastFileName = child.Name()
+			if err = f.copyToTrash(ctx, trashRoot, child); err != nil {
+				return err
+			}
+		}
+		if !hasMore {
+			return nil
+		}
+	}
+}
+
+// PurgeTrash deletes the deletions older than their retention, with their chunks.
+func (f *Filer) PurgeTrash(ctx context.Context, now time.Time) (purged int, err error) {
+	lastFileName := ""
+	for {
+		entries, hasMore, listErr := f.ListDirectoryEntries(ctx, TrashDir, lastFileName, false, PaginationSize, "", "", "")
+		if listErr != nil {
+			return purged, fmt.Errorf("list %s: %v", TrashDir, listErr)
+		}
+		for _, entry := range entries {
+			lastFileName = entry.Name()
+			deletedAt, parseErr := time.Parse(trashTimeFormat, entry.Name())
+			if parseErr != nil {
+				continue
+			}
+			retention := time.Duration(bytesToInt64(entry.Extended[trashRetentionKey])) * time.Second
+			if retention <= 0 {
+				retention = DefaultTrashRetentionDays * 24 * time.Hour
+			}
+			if now.Before(deletedAt.Add(retention)) {
+				continue
+			}
+			if err = f.DeleteEntryMetaAndData(ctx, entry.FullPath, true, true, true, false, nil); err != nil {
+				return purged, fmt.Errorf("purge %s: %v", entry.FullPath, err)
+			}
+			purged++
+		}
+		if !hasMore {
+			return purged, nil
+		}
+	}
+}
+
+// LoopPurgeTrash purges the trash periodically.
+func (f *Filer) LoopPurgeTrash() {
+	for {
+		time.Sleep(TrashPurgeInterval)
+		purged, err := f.PurgeTrash(context.Background(), time.Now())
+		if err != nil {
+			glog.Errorf("purge trash: %v", err)
+		}
+		if purged > 0 {
+			glog.V(0).Infof("purged %d deletions from %s", purged, TrashDir)
+		}
+	}
+}
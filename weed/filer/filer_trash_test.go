@@ -0,0 +1,81 @@
+package filer
+
+import (
+	"context"
+	"os"
+	"testing"
+	"time"
+
+	"github.com/gateway-dao/seaweedfs/weed/pb"
+	"github.com/gateway-dao/seaweedfs/weed/pb/filer_pb"
+	"github.com/gateway-dao/seaweedfs/weed/util"
+)
+
+func TestTrash(t *testing.T) {
+	f := NewFiler(pb.ServerDiscovery{}, nil, "", "", "", "", "", 255, nil)
+	f.SetStore(newMemoryStore())
+	f.FilerConf.AddLocationConf(&filer_pb.FilerConf_PathConf{LocationPrefix: "/data/", Trash: true, TrashRetentionDays: 1})
+	ctx := context.Background()
+
+	create := func(path string, mode os.FileMode, chunks ...*filer_pb.FileChunk) {
+		if err := f.CreateEntry(ctx, &Entry{FullPath: util.FullPath(path), Attr: Attr{Mode: mode}, Chunks: chunks}, false, false, nil, false, 255); err != nil {
+			t.Fatalf("create %s: %v", path, err)
+		}
+	}
+	listTrash := func() (deletions []*Entry) {
+		deletions, _, err := f.ListDirectoryEntries(ctx, TrashDir, "", false, 100, "", "", "")
+		if err != nil {
+			t.Fatalf("list trash: %v", err)
+		}
+		return
+	}
+	create("/data/dir/a.txt", 0644, &filer_pb.FileChunk{FileId: "1,01", Size: 10})
+	create("/data/empty", os.ModeDir|0755)
+	create("/other/c.txt", 0644)
+
+	if err := f.DeleteEntryMetaAndData(ctx, "/data/dir", true, false, true, false, nil); err != nil {
+		t.Fatalf("delete: %v", err)
+	}
+	if _, err := f.FindEntry(ctx, "/data/dir/a.txt"); err != filer_pb.ErrNotFound {
+		t.Fatalf("deleted entry still exists: %v", err)
+	}
+	deletions := listTrash()
+	if len(deletions) != 1 {
+		t.Fatalf("deletions in trash: %+v", deletions)
+	}
+	trashedDir := deletions[0].FullPath.Child("data").Child("dir")
+	trashed := trashedDir.Child("a.txt")
+	if entry, err := f.FindEntry(ctx, trashed); err != nil || entry.Chunks[0].FileId != "1,01" {
+		t.Fatalf("entry in trash: %v", err)
+	}
+	if original, found := TrashOriginalPath(trashed); !found || original != "/data/dir/a.txt" {
+		t.Fatalf("original path of %s: %s", trashed, original)
+	}
+	if err := f.CanRename(trashedDir, "/data/dir", "a.txt"); err != nil {
+		t.Fatalf("restore: %v", err)
+	}
+
+	// not in the trash: empty directories, other paths, and entries in the trash
+	if err := f.DeleteEntryMetaAndData(ctx, "/data/empty", true, false, true, false, nil); err != nil {
+		t.Fatalf("delete: %v", err)
+	}
+	if err := f.DeleteEntryMetaAndData(ctx, "/other/c.txt", false, false, true, false, nil); err != nil {
+		t.Fatalf("delete: %v", err)
+	}
+	if err := f.DeleteEntryMetaAndData(ctx, trashedDir, true, false, true, false, nil); err != nil {
+		t.Fatalf("delete: %v", err)
+	}
+	if deletions = listTrash(); len(deletions) != 1 {
+		t.Fatalf("deletions in trash: %+v", deletions)
+	}
+
+	if purged, err := f.PurgeTrash(ctx, time.Now()); err != nil || purged != 0 {
+		t.Fatalf("purged %d within retention: %v", purged, err)
+	}
+	if purged, err := f.PurgeTrash(ctx, time.Now().Add(25*time.Hour)); err != nil || purged != 1 {
+		t.Fatalf("purged %d after retention: %v", purged, err)
+	}
+	if deletions = listTrash(); len(deletions) != 0 {
+		t.Fatalf("deletions in trash after purging: %+v", deletions)
+	}
+}
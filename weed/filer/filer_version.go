@@ -0,0 +1,371 @@
+package filer
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/gateway-dao/seaweedfs/weed/glog"
+	"github.com/gateway-dao/seaweedfs/weed/pb/filer_pb"
+	"github.com/gateway-dao/seaweedfs/weed/util"
+)
+
+/*
+File versioning is enabled by the "versioning" option of the path configurations in filer.conf, see fs.configure.
+
+Replacing the content of a file under such a path keeps the previous entry, with its chunks,
+as /.versions/<path>/<version id>. The version id is the replacement time in nanoseconds,
+so the versions are listed oldest first. Only the content changes are versioned, and not
+directories, hard links, or metadata updates.
+
+Deleting a file under such a path keeps its content as a version too, followed by a delete
+marker, a version without content. The versions of deleted files are kept until pruned.
+
+The versions are pruned over the max count when a new version is kept, and older than the
+retention days periodically. Their chunks are deleted unless the file or other versions still
+use them. Renaming a file moves its versions along, since they may share chunks with it.
+The versions of a file moved to the trash, or deleted without a delete marker, stay under
+the old path, and their chunks are not deleted when pruned while the file is missing.
+*/
+
+const (
+	VersionsDir = "/.versions"
+
+	DefaultMaxVersions   = 10
+	VersionPruneInterval = time.Hour
+
+	versionDeleteMarkerKey = "version.deleteMarker"
+)
+
+// IsVersionPath is true for /.versions and the versions in it.
+func IsVersionPath(p util.FullPath) bool {
+	return p == VersionsDir || p.IsUnder(VersionsDir)
+}
+
+func versionsDirOf(p util.FullPath) util.FullPath {
+	return util.FullPath(VersionsDir + string(p))
+}
+
+// VersionPath is where the version of the file is kept.
+func VersionPath(p util.FullPath, versionId string) util.FullPath {
+	return versionsDirOf(p).Child(versionId)
+}
+
+// CheckVersionId fails if the version id is not a version time in nanoseconds.
+func CheckVersionId(versionId string) error {
+	if _, err := strconv.ParseInt(versionId, 10, 64); err != nil {
+		return fmt.Errorf("invalid version id %q", versionId)
+	}
+	return nil
+}
+
+// IsDeleteMarker is true for the version recording that the file is deleted.
+func IsDeleteMarker(version *Entry) bool {
+	_, found := version.Extended[versionDeleteMarkerKey]
+	return found
+}
+
+func (f *Filer) isVersioned(p util.FullPath) bool {
+	if IsVersionPath(p) || IsTrashPath(p) || IsSnapshotPath(p) {
+		return false
+	}
+	return f.FilerConf.MatchStorageRule(string(p)).Versioning
+}
+
+func sameContent(a, b *Entry) bool {
+	if !bytes.Equal(a.Content, b.Content) || len(a.GetChunks()) != len(b.GetChunks()) {
+		return false
+	}
+	for i, chunk := range a.GetChunks() {
+		other := b.GetChunks()[i]
+		if chunk.GetFileIdString() != other.GetFileIdString() || chunk.Offset != other.Offset || chunk.Size != other.Size {
+			return false
+		}
+	}
+	return true
+}
+
+// KeepsVersion is true if updating the entry keeps the old entry as a version,
+// so the chunks replaced by the update should not be deleted.
+func (f *Filer) KeepsVersion(oldEntry, newEntry *Entry) bool {
+	if oldEntry == nil || newEntry == nil || oldEntry.FullPath != newEntry.FullPath {
+		return false
+	}
+	if oldEntry.IsDirectory() || len(oldEntry.HardLinkId) > 0 {
+		return false
+	}
+	if len(oldEntry.GetChunks()) == 0 && len(oldEntry.Content) == 0 {
+		return false
+	}
+	return !sameContent(oldEntry, newEntry) && f.isVersioned(oldEntry.FullPath)
+}
+
+// keepsDeletedVersion is true if deleting the file keeps it as a version, so its chunks should not be deleted.
+func (f *Filer) keepsDeletedVersion(ctx context.Context, entry *Entry, isFromOtherCluster bool) bool {
+	if isFromOtherCluster || isQuotaRename(ctx) || entry.IsDirectory() || len(entry.HardLinkId) > 0 {
+		return false
+	}
+	if len(entry.GetChunks()) == 0 && len(entry.Content) == 0 {
+		return false
+	}
+	return f.isVersioned(entry.FullPath)
+}
+
+// keepDeletedVersion keeps the deleted file as a version, followed by a delete marker.
+func (f *Filer) keepDeletedVersion(ctx context.Context, entry *Entry, now time.Time) {
+	if err := f.saveVersion(ctx, entry, now); err != nil {
+		glog.Errorf("delete %s: %v", entry.FullPath, err)
+		return
+	}
+	marker := &Entry{
+		FullPath: VersionPath(entry.FullPath, strconv.FormatInt(now.UnixNano()+1, 10)),
+		Attr: Attr{
+			Mtime:  now,
+			Crtime: now,
+			Mode:   entry.Mode,
+			Uid:    entry.Uid,
+			Gid:    entry.Gid,
+		},
+		Extended: map[string][]byte{versionDeleteMarkerKey: []byte("true")},
+	}
+	if err := f.CreateEntry(ctx, marker, true, false, nil, false, f.MaxFilenameLength); err != nil {
+		glog.Errorf("delete %s: keep delete marker: %v", entry.FullPath, err)
+	}
+	if _, err := f.pruneVersions(ctx, entry.FullPath, now); err != nil {
+		glog.Errorf("prune versions of %s: %v", entry.FullPath, err)
+	}
+}
+
+func (f *Filer) saveVersion(ctx context.Context, entry *Entry, now time.Time) error {
+	versionEntry := entry.ShallowClone()
+	versionEntry.FullPath = VersionPath(entry.FullPath, strconv.FormatInt(now.UnixNano(), 10))
+	if err := f.CreateEntry(ctx, versionEntry, true, false, nil, false, f.MaxFilenameLength); err != nil {
+		return fmt.Errorf("keep version of %s: %v", entry.FullPath, err)
+	}
+	return nil
+}
+
+// ListVersions lists the versions of the file, oldest first.
+func (f *Filer) ListVersions(ctx context.Context, p util.FullPath) (versions []*Entry, err error) {
+	dir := versionsDirOf(p)
+	lastFileName := ""
+	for {
+		entries, hasMore, listErr := f.ListDirectoryEntries(ctx, dir, lastFileName, false, PaginationSize, "", "", "")
+		if listErr != nil {
+			return nil, fmt.Errorf("list %s: %v", dir, listErr)
+		}
+		for _, entry := range entries {
+			lastFileName = entry.Name()
+			if !entry.IsDirectory() && CheckVersionId(entry.Name()) == nil {
+				versions = append(versions, entry)
+			}
+		}
+		if !hasMore {
+			return
+		}
+	}
+}
+
+// RestoreVersion replaces the file with the version, keeping the replaced content as a new version.
+func (f *Filer) RestoreVersion(ctx context.Context, p util.FullPath, versionId string) (*Entry, error) {
+	if err := CheckVersionId(versionId); err != nil {
+		return nil, err
+	}
+	version, err := f.FindEntry(ctx, VersionPath(p, versionId))
+	if err != nil {
+		return nil, fmt.Errorf("find version %s of %s: %v", versionId, p, err)
+	}
+	if IsDeleteMarker(version) {
+		return nil, fmt.Errorf("version %s of %s is a delete marker", versionId, p)
+	}
+	entry := version.ShallowClone()
+	entry.FullPath = p
+	entry.Mtime = time.Now()
+	if err = f.CreateEntry(ctx, entry, false, false, nil, false, f.MaxFilenameLength); err != nil {
+		return nil, err
+	}
+	return entry, nil
+}
+
+// DeleteVersion deletes one version of the file.
+func (f *Filer) DeleteVersion(ctx context.Context, p util.FullPath, versionId string) error {
+	if err := CheckVersionId(versionId); err != nil {
+		return err
+	}
+	versions, err := f.ListVersions(ctx, p)
+	if err != nil {
+		return err
+	}
+	var toDelete, remaining []*Entry
+	for _, version := range versions {
+		if version.Name() == versionId {
+			toDelete = append(toDelete, version)
+		} else {
+			remaining = append(remaining, version)
+		}
+	}
+	if len(toDelete) == 0 {
+		return fmt.Errorf("version %s of %s is not found", versionId, p)
+	}
+	return f.deleteVersions(ctx, p, toDelete, remaining)
+}
+
+// pruneVersions deletes the versions over the max count, or older than the retention days.
+func (f *Filer) pruneVersions(ctx context.Context, p util.FullPath, now time.Time) (pruned int, err error) {
+	versions, err := f.ListVersions(ctx, p)
+	if err != nil {
+		return 0, err
+	}
+	rule := f.FilerConf.MatchStorageRule(string(p))
+	maxVersions := int(rule.MaxVersions)
+	if maxVersions == 0 {
+		maxVersions = DefaultMaxVersions
+	}
+	var toDelete, remaining []*Entry
+	for i, version := range versions {
+		versionNs, _ := strconv.ParseInt(version.Name(), 10, 64)
+		expired := rule.VersionRetentionDays > 0 &&
+			now.Sub(time.Unix(0, versionNs)) > time.Duration(rule.VersionRetentionDays)*24*time.Hour
+		if i < len(versions)-maxVersions || expired {
+			toDelete = append(toDelete, version)
+		} else {
+			remaining = append(remaining, version)
+		}
+	}
+	if len(toDelete) == 0 {
+		return 0, nil
+	}
+	return len(toDelete), f.deleteVersions(ctx, p, toDelete, remaining)
+}
+
+// deleteVersions deletes the versions, and their chunks not used by the file or the remaining versions.
+func (f *Filer) deleteVersions(ctx context.Context, p util.FullPath, toDelete, remaining []*Entry) error {
+	current, findErr := f.FindEntry(ctx, p)
+	// a file missing after a delete marker is not renamed or in the trash
+	var latest *Entry
+	for _, version := range append(toDelete, remaining...) {
+		if latest == nil || version.Name() > latest.Name() {
+			latest = version
+		}
+	}
+	isDeleted := findErr == filer_pb.ErrNotFound && latest != nil && IsDeleteMarker(latest)
+	inUse := make(map[string]bool)
+	for _, entry := range append(remaining, current) {
+		if entry == nil {
+			continue
+		}
+		fileIds, err := f.resolveFileIds(entry.GetChunks())
+		if err != nil {
+			return fmt.Errorf("resolve chunks of %s: %v", entry.FullPath, err)
+		}
+		for _, fileId := range fileIds {
+			inUse[fileId] = true
+		}
+	}
+
+	for _, version := range toDelete {
+		fileIds, err := f.resolveFileIds(version.GetChunks())
+		if err != nil {
+			return fmt.Errorf("resolve chunks of %s: %v", version.FullPath, err)
+		}
+		if err = f.DeleteEntryMetaAndData(ctx, version.FullPath, false, false, false, false, nil); err != nil {
+			return fmt.Errorf("delete %s: %v", version.FullPath, err)
+		}
+		if findErr != nil && !isDeleted {
+			// the file may be renamed or in the trash
+			continue
+		}
+		for _, fileId := range fileIds {
+			if !inUse[fileId] {
+				f.fileIdDeletionQueue.EnQueue(fileId)
+			}
+		}
+	}
+
+	if len(remaining) == 0 {
+		if err := f.DeleteEntryMetaAndData(ctx, versionsDirOf(p), false, false, false, false, nil); err != nil && !strings.Contains(err.Error(), MsgFailDelNonEmptyFolder) {
+			glog.V(1).Infof("delete %s: %v", versionsDirOf(p), err)
+		}
+	}
+	return nil
+}
+
+// MoveVersions moves the versions of the renamed file to its new path.
+func (f *Filer) MoveVersions(ctx context.Context, oldPath, newPath util.FullPath) error {
+	if IsVersionPath(oldPath) || IsVersionPath(newPath) {
+		return nil
+	}
+	versions, err := f.ListVersions(ctx, oldPath)
+	if err != nil {
+		return err
+	}
+	for _, version := range versions {
+		movedVersion := version.ShallowClone()
+		movedVersion.FullPath = VersionPath(newPath, version.Name())
+		if err = f.CreateEntry(ctx, movedVersion, false, false, nil, false, f.MaxFilenameLength); err != nil {
+			return fmt.Errorf("move version %s of %s: %v", version.Name(), oldPath, err)
+		}
+		if err = f.DeleteEntryMetaAndData(ctx, version.FullPath, false, false, false, false, nil); err != nil {
+			return fmt.Errorf("move version %s of %s: %v", version.Name(), oldPath, err)
+		}
+	}
+	if len(versions) > 0 {
+		if err = f.DeleteEntryMetaAndData(ctx, versionsDirOf(oldPath), false, false, false, false, nil); err != nil && !strings.Contains(err.Error(), MsgFailDelNonEmptyFolder) {
+			glog.V(1).Infof("delete %s: %v", versionsDirOf(oldPath), err)
+		}
+	}
+	return nil
+}
+
+// PruneAllVersions prunes the versions of all files.
+func (f *Filer) PruneAllVersions(ctx context.Context, now time.Time) (pruned int, err error) {
+	err = f.pruneVersionsUnder(ctx, VersionsDir, now, &pruned)
+	return
+}
+
+func (f *Filer) pruneVersionsUnder(ctx context.Context, dir util.FullPath, now time.Time, pruned *int) error {
+	hasVersions := false
+	lastFileName := ""
+	for {
+		entries, hasMore, err := f.ListDirectoryEntries(ctx, dir, lastFileName, false, PaginationSize, "", "", "")
+		if err != nil {
+			return fmt.Errorf("list %s: %v", dir, err)
+		}
+		for _, entry := range entries {
+			lastFileName = entry.Name()
+			if !entry.IsDirectory() {
+				hasVersions = true
+				continue
+			}
+			if err = f.pruneVersionsUnder(ctx, entry.FullPath, now, pruned); err != nil {
+				return err
+			}
+		}
+		if !hasMore {
+			break
+		}
+	}
+	if !hasVersions {
+		return nil
+	}
+	count, err := f.pruneVersions(ctx, util.FullPath(strings.TrimPrefix(string(dir), VersionsDir)), now)
+	*pruned += count
+	return err
+}
+
+// LoopPruneVersions prunes the expired versions periodically.
+func (f *Filer) LoopPruneVersions() {
+	for {
+		time.Sleep(VersionPruneInterval)
+		pruned, err := f.PruneAllVersions(context.Background(), time.Now())
+		if err != nil {
+			glog.Errorf("prune versions: %v", err)
+		}
+		if pruned > 0 {
+			glog.V(0).Infof("pruned %d versions", pruned)
+		}
+	}
+}
@@ -0,0 +1,158 @@
+package filer
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/gateway-dao/seaweedfs/weed/pb"
+	"github.com/gateway-dao/seaweedfs/weed/pb/filer_pb"
+	"github.com/gateway-dao/seaweedfs/weed/util"
+)
+
+func TestFileVersions(t *testing.T) {
+	f := NewFiler(pb.ServerDiscovery{}, nil, "", "", "", "", "", 255, nil)
+	f.SetStore(newMemoryStore())
+	f.FilerConf.AddLocationConf(&filer_pb.FilerConf_PathConf{LocationPrefix: "/data/", Versioning: true, MaxVersions: 2})
+	ctx := context.Background()
+
+	write := func(path, content string) {
+		entry := &Entry{FullPath: util.FullPath(path), Attr: Attr{Mode: 0644}, Content: []byte(content)}
+		if err := f.CreateEntry(ctx, entry, false, false, nil, false, 255); err != nil {
+			t.Fatalf("write %s: %v", path, err)
+		}
+	}
+	read := func(path string) string {
+		entry, err := f.FindEntry(ctx, util.FullPath(path))
+		if err != nil {
+			t.Fatalf("read %s: %v", path, err)
+		}
+		return string(entry.Content)
+	}
+	versionContents := func(path string) (contents []string) {
+		versions, err := f.ListVersions(ctx, util.FullPath(path))
+		if err != nil {
+			t.Fatalf("list versions of %s: %v", path, err)
+		}
+		for _, version := range versions {
+			contents = append(contents, string(version.Content))
+		}
+		return
+	}
+
+	write("/data/a.txt", "v1")
+	write("/data/a.txt", "v1")
+	if contents := versionContents("/data/a.txt"); len(contents) != 0 {
+		t.Fatalf("versions of unchanged content: %v", contents)
+	}
+	write("/data/a.txt", "v2")
+	write("/data/a.txt", "v3")
+	write("/data/a.txt", "v4")
+	if contents := versionContents("/data/a.txt"); len(contents) != 2 || contents[0] != "v2" || contents[1] != "v3" {
+		t.Fatalf("versions over max count: %v", contents)
+	}
+	write("/other/b.txt", "v1")
+	write("/other/b.txt", "v2")
+	if contents := versionContents("/other/b.txt"); len(contents) != 0 {
+		t.Fatalf("versions of unversioned path: %v", contents)
+	}
+
+	versions, _ := f.ListVersions(ctx, "/data/a.txt")
+	if _, err := f.RestoreVersion(ctx, "/data/a.txt", versions[0].Name()); err != nil {
+		t.Fatalf("restore: %v", err)
+	}
+	if content := read("/data/a.txt"); content != "v2" {
+		t.Fatalf("restored content: %s", content)
+	}
+	if contents := versionContents("/data/a.txt"); len(contents) != 2 || contents[0] != "v3" || contents[1] != "v4" {
+		t.Fatalf("versions after restoring: %v", contents)
+	}
+
+	versions, _ = f.ListVersions(ctx, "/data/a.txt")
+	if err := f.DeleteVersion(ctx, "/data/a.txt", versions[0].Name()); err != nil {
+		t.Fatalf("delete version: %v", err)
+	}
+	if contents := versionContents("/data/a.txt"); len(contents) != 1 || contents[0] != "v4" {
+		t.Fatalf("versions after deleting: %v", contents)
+	}
+	if err := f.DeleteVersion(ctx, "/data/a.txt", versions[0].Name()); err == nil {
+		t.Fatalf("deleted a missing version")
+	}
+
+	// retention by age
+	f.FilerConf.AddLocationConf(&filer_pb.FilerConf_PathConf{LocationPrefix: "/data/", Versioning: true, MaxVersions: 2, VersionRetentionDays: 1})
+	if pruned, err := f.PruneAllVersions(ctx, time.Now()); err != nil || pruned != 0 {
+		t.Fatalf("pruned %d within retention: %v", pruned, err)
+	}
+	if pruned, err := f.PruneAllVersions(ctx, time.Now().Add(25*time.Hour)); err != nil || pruned != 1 {
+		t.Fatalf("pruned %d after retention: %v", pruned, err)
+	}
+	if _, err := f.FindEntry(ctx, versionsDirOf("/data/a.txt")); err != filer_pb.ErrNotFound {
+		t.Fatalf("empty versions directory still exists: %v", err)
+	}
+
+	// deleting the file keeps it as a version, behind a delete marker
+	write("/data/a.txt", "v5")
+	if err := f.DeleteEntryMetaAndData(ctx, "/data/a.txt", false, false, true, false, nil); err != nil {
+		t.Fatalf("delete: %v", err)
+	}
+	if _, err := f.FindEntry(ctx, "/data/a.txt"); err != filer_pb.ErrNotFound {
+		t.Fatalf("deleted file still exists: %v", err)
+	}
+	versions, _ = f.ListVersions(ctx, "/data/a.txt")
+	if len(versions) != 2 || string(versions[0].Content) != "v5" || IsDeleteMarker(versions[0]) || !IsDeleteMarker(versions[1]) {
+		t.Fatalf("versions of deleted file: %v", versionContents("/data/a.txt"))
+	}
+	if _, err := f.RestoreVersion(ctx, "/data/a.txt", versions[1].Name()); err == nil {
+		t.Fatalf("restored a delete marker")
+	}
+	if _, err := f.RestoreVersion(ctx, "/data/a.txt", versions[0].Name()); err != nil {
+		t.Fatalf("restore deleted file: %v", err)
+	}
+	if content := read("/data/a.txt"); content != "v5" {
+		t.Fatalf("restored content: %s", content)
+	}
+
+	// and so does deleting its directory
+	write("/data/dir/d.txt", "v1")
+	if err := f.DeleteEntryMetaAndData(ctx, "/data/dir", true, false, true, false, nil); err != nil {
+		t.Fatalf("delete directory: %v", err)
+	}
+	versions, _ = f.ListVersions(ctx, "/data/dir/d.txt")
+	if len(versions) != 2 || string(versions[0].Content) != "v1" || !IsDeleteMarker(versions[1]) {
+		t.Fatalf("versions of file in deleted directory: %v", versionContents("/data/dir/d.txt"))
+	}
+	// until pruned by the retention
+	if _, err := f.PruneAllVersions(ctx, time.Now().Add(25*time.Hour)); err != nil {
+		t.Fatalf("prune: %v", err)
+	}
+	if _, err := f.FindEntry(ctx, versionsDirOf("/data/dir/d.txt")); err != filer_pb.ErrNotFound {
+		t.Fatalf("expired versions of deleted file still exist: %v", err)
+	}
+
+	// renaming the file moves its versions, even to an unversioned path
+	write("/data/c.txt", "v1")
+	write("/data/c.txt", "v2")
+	renamed := &Entry{FullPath: "/other/c.txt", Attr: Attr{Mode: 0644}, Content: []byte("v2")}
+	if err := f.CreateEntry(ctx, renamed, false, false, nil, false, 255); err != nil {
+		t.Fatalf("rename: %v", err)
+	}
+	if err := f.MoveVersions(ctx, "/data/c.txt", "/other/c.txt"); err != nil {
+		t.Fatalf("move versions: %v", err)
+	}
+	if err := f.DeleteEntryMetaAndData(context.WithValue(ctx, "OP", "MV"), "/data/c.txt", false, false, false, false, nil); err != nil {
+		t.Fatalf("rename: %v", err)
+	}
+	if contents := versionContents("/other/c.txt"); len(contents) != 1 || contents[0] != "v1" {
+		t.Fatalf("versions of renamed file: %v", contents)
+	}
+	if _, err := f.FindEntry(ctx, versionsDirOf("/data/c.txt")); err != filer_pb.ErrNotFound {
+		t.Fatalf("versions still under the old path: %v", err)
+	}
+	if err := f.DeleteEntryMetaAndData(ctx, "/other/c.txt", false, false, true, false, nil); err != nil {
+		t.Fatalf("delete renamed: %v", err)
+	}
+	if contents := versionContents("/other/c.txt"); len(contents) != 1 || contents[0] != "v1" {
+		t.Fatalf("versions of deleted renamed file: %v", contents)
+	}
+}
@@ -0,0 +1,754 @@
+package filer
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"hash/fnv"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"google.golang.org/protobuf/proto"
+
+	"github.com/gateway-dao/seaweedfs/weed/glog"
+	"github.com/gateway-dao/seaweedfs/weed/pb"
+	"github.com/gateway-dao/seaweedfs/weed/pb/filer_pb"
+	"github.com/gateway-dao/seaweedfs/weed/util"
+)
+
+/*
+A store migration moves the default filer store to a new store without downtime.
+The new store is configured as [migration.<store name>] in filer.toml on every filer.
+
+	dual_write: writes go to both stores, reads come from the old store
+	backfill:   one filer copies the old store into the new store, with checkpoints
+	verify:     the filer compares sampled entries of both stores, and repairs the differences
+	verified:   ready to switch
+	switched:   reads come from the new store, writes still go to both stores
+	done:       reads and writes only use the new store
+
+Each write goes to the store read from first, the primary store. The entry is then
+copied from the primary store to the other store, and read again from the primary store,
+until it has not changed while being copied. Since every filer writes the primary store
+first, a copy can not overwrite a newer write of another filer, which copies its write
+again. The backfill and the verification copy the entries the same way. The key-values
+are dual-written too. The ones not copied by the backfill are copied from the old store
+when first read after switching.
+
+A filer keeps the entries and key-values it failed to write to the other store, retries
+them, and reports them with the phase it uses in its own key-value of the new store.
+Switching waits until no live filer has failed writes, and finishing also until every
+live filer has switched.
+
+The writes in a transaction of the primary store are copied to the other store once the
+transaction is committed, one by one, and are not copied if it is rolled back.
+The migration status is kept in the new store.
+*/
+
+const (
+	StoreMigrationDualWrite = "dual_write"
+	StoreMigrationBackfill  = "backfill"
+	StoreMigrationVerify    = "verify"
+	StoreMigrationVerified  = "verified"
+	StoreMigrationSwitched  = "switched"
+	StoreMigrationDone      = "done"
+
+	storeMigrationKey          = "filer.store.migration"
+	storeMigrationFilerPrefix  = "filer.store.migration.filer."
+	storeMigrationLockCount    = 1024
+	storeMigrationCopyAttempts = 10
+)
+
+var (
+	_ = FilerStore(&StoreMigration{})
+	_ = BucketAware(&StoreMigration{})
+)
+
+type StoreMigration struct {
+	oldStore FilerStore
+	newStore FilerStore
+
+	folderLock sync.RWMutex // held exclusively to delete folder children
+	entryLocks [storeMigrationLockCount]sync.Mutex
+
+	statusLock sync.RWMutex
+	status     *filer_pb.StoreMigrationStatus
+	isRunning  bool // the backfill or the verification runs on this filer
+
+	dualWriteErrors atomic.Int64
+	failedLock      sync.Mutex
+	failedPaths     map[util.FullPath]bool
+	failedFolders   map[util.FullPath]bool
+	failedKeys      map[string]bool
+}
+
+func NewStoreMigration(oldStore, newStore FilerStore) (*StoreMigration, error) {
+	m := &StoreMigration{
+		oldStore:      oldStore,
+		newStore:      newStore,
+		failedPaths:   make(map[util.FullPath]bool),
+		failedFolders: make(map[util.FullPath]bool),
+		failedKeys:    make(map[string]bool),
+	}
+	found, err := m.loadStatus()
+	if err != nil {
+		return nil, err
+	}
+	if found {
+		return m, nil
+	}
+
+	// a new migration needs an empty store
+	hasEntries := false
+	if _, err = newStore.ListDirectoryEntries(context.Background(), "/", "", false, 1, func(entry *Entry) bool {
+		hasEntries = true
+		return false
+	}); err != nil {
+		return nil, fmt.Errorf("list new store %s: %v", newStore.GetName(), err)
+	}
+	if hasEntries {
+		return nil, fmt.Errorf("new store %s is not empty", newStore.GetName())
+	}
+	m.status = &filer_pb.StoreMigrationStatus{
+		Phase:       StoreMigrationDualWrite,
+		OldStore:    oldStore.GetName(),
+		NewStore:    newStore.GetName(),
+		StartedAtNs: time.Now().UnixNano(),
+	}
+	return m, m.saveStatus()
+}
+
+func (m *StoreMigration) loadStatus() (found bool, err error) {
+	value, err := m.newStore.KvGet(context.Background(), []byte(storeMigrationKey))
+	if err == ErrKvNotFound {
+		return false, nil
+	}
+	if err != nil {
+		return false, fmt.Errorf("read store migration: %v", err)
+	}
+	status := &filer_pb.StoreMigrationStatus{}
+	if err = proto.Unmarshal(value, status); err != nil {
+		return false, fmt.Errorf("decode store migration: %v", err)
+	}
+	m.statusLock.Lock()
+	m.status = status
+	m.statusLock.Unlock()
+	return true, nil
+}
+
+func (m *StoreMigration) saveStatus() error {
+	m.statusLock.Lock()
+	m.status.UpdatedAtNs = time.Now().UnixNano()
+	value, err := proto.Marshal(m.status)
+	m.statusLock.Unlock()
+	if err != nil {
+		return err
+	}
+	if err = m.newStore.KvPut(context.Background(), []byte(storeMigrationKey), value); err != nil {
+		return fmt.Errorf("save store migration: %v", err)
+	}
+	return nil
+}
+
+// updateStatus changes the status, and saves it.
+func (m *StoreMigration) updateStatus(fn func(status *filer_pb.StoreMigrationStatus) error) error {
+	m.statusLock.Lock()
+	if err := fn(m.status); err != nil {
+		m.statusLock.Unlock()
+		return err
+	}
+	m.statusLock.Unlock()
+	return m.saveStatus()
+}
+
+// Status returns a copy of the migration status.
+func (m *StoreMigration) Status() *filer_pb.StoreMigrationStatus {
+	m.statusLock.RLock()
+	status := proto.Clone(m.status).(*filer_pb.StoreMigrationStatus)
+	m.statusLock.RUnlock()
+	status.DualWriteErrors = m.dualWriteErrors.Load()
+	return status
+}
+
+func (m *StoreMigration) Phase() string {
+	m.statusLock.RLock()
+	defer m.statusLock.RUnlock()
+	return m.status.Phase
+}
+
+// RefreshStatus reloads the status changed by other filers.
+func (m *StoreMigration) RefreshStatus() error {
+	m.statusLock.RLock()
+	isRunning := m.isRunning
+	m.statusLock.RUnlock()
+	if isRunning {
+		return nil
+	}
+	_, err := m.loadStatus()
+	return err
+}
+
+// stores returns the store to read from and write first, and the other store to write to.
+func (m *StoreMigration) stores() (primary, secondary FilerStore) {
+	switch m.Phase() {
+	case StoreMigrationSwitched:
+		return m.newStore, m.oldStore
+	case StoreMigrationDone:
+		return m.newStore, nil
+	default:
+		return m.oldStore, m.newStore
+	}
+}
+
+type storeMigrationTxKey struct{}
+
+// storeMigrationTx keeps the copies to the secondary store of the writes in a transaction
+// of the primary store, until the transaction ends.
+type storeMigrationTx struct {
+	primary   FilerStore
+	secondary FilerStore
+
+	copiesLock sync.Mutex
+	copies     []func()
+}
+
+func (tx *storeMigrationTx) addCopy(fn func()) {
+	tx.copiesLock.Lock()
+	tx.copies = append(tx.copies, fn)
+	tx.copiesLock.Unlock()
+}
+
+func (tx *storeMigrationTx) takeCopies() []func() {
+	tx.copiesLock.Lock()
+	defer tx.copiesLock.Unlock()
+	copies := tx.copies
+	tx.copies = nil
+	return copies
+}
+
+// storesOf returns the stores of the transaction in the context, or the current stores.
+func (m *StoreMigration) storesOf(ctx context.Context) (primary, secondary FilerStore, tx *storeMigrationTx) {
+	if tx, ok := ctx.Value(storeMigrationTxKey{}).(*storeMigrationTx); ok {
+		return tx.primary, tx.secondary, tx
+	}
+	primary, secondary = m.stores()
+	return primary, secondary, nil
+}
+
+func (m *StoreMigration) lockOf(key []byte) *sync.Mutex {
+	h := fnv.New32a()
+	h.Write(key)
+	return &m.entryLocks[h.Sum32()%storeMigrationLockCount]
+}
+
+func (m *StoreMigration) writeEntry(ctx context.Context, fp util.FullPath, op string, fn func(ctx context.Context, store FilerStore) error) error {
+	m.folderLock.RLock()
+	defer m.folderLock.RUnlock()
+	lock := m.lockOf([]byte(fp))
+	lock.Lock()
+	defer lock.Unlock()
+
+	primary, secondary, tx := m.storesOf(ctx)
+	if err := fn(ctx, primary); err != nil {
+		return err
+	}
+	if secondary == nil {
+		return nil
+	}
+	if tx != nil {
+		// the write can only be copied once committed
+		tx.addCopy(func() {
+			m.folderLock.RLock()
+			defer m.folderLock.RUnlock()
+			lock := m.lockOf([]byte(fp))
+			lock.Lock()
+			defer lock.Unlock()
+			m.copyWrittenEntry(context.Background(), fp, op, primary, secondary)
+		})
+		return nil
+	}
+	m.copyWrittenEntry(ctx, fp, op, primary, secondary)
+	return nil
+}
+
+// copyWrittenEntry copies the entry written to the primary store, or keeps it to retry.
+func (m *StoreMigration) copyWrittenEntry(ctx context.Context, fp util.FullPath, op string, primary, secondary FilerStore) {
+	if err := copyStoreEntry(ctx, fp, primary, secondary); err != nil {
+		glog.Errorf("store migration: %s %s in %s: %v", op, fp, secondary.GetName(), err)
+		m.addFailure(func() { m.failedPaths[fp] = true })
+	}
+}
+
+func (m *StoreMigration) writeKv(ctx context.Context, key []byte, op string, fn func(ctx context.Context, store FilerStore) error) error {
+	lock := m.lockOf(key)
+	lock.Lock()
+	defer lock.Unlock()
+
+	primary, secondary, tx := m.storesOf(ctx)
+	if err := fn(ctx, primary); err != nil {
+		return err
+	}
+	if secondary == nil {
+		return nil
+	}
+	if tx != nil {
+		tx.addCopy(func() {
+			lock := m.lockOf(key)
+			lock.Lock()
+			defer lock.Unlock()
+			m.copyWrittenKv(context.Background(), key, op, primary, secondary)
+		})
+		return nil
+	}
+	m.copyWrittenKv(ctx, key, op, primary, secondary)
+	return nil
+}
+
+// copyWrittenKv copies the key-value written to the primary store, or keeps it to retry.
+func (m *StoreMigration) copyWrittenKv(ctx context.Context, key []byte, op string, primary, secondary FilerStore) {
+	if err := copyStoreKv(ctx, key, primary, secondary); err != nil {
+		glog.Errorf("store migration: %s %s in %s: %v", op, key, secondary.GetName(), err)
+		m.addFailure(func() { m.failedKeys[string(key)] = true })
+	}
+}
+
+func findStoreEntry(ctx context.Context, store FilerStore, fp util.FullPath) (*Entry, error) {
+	entry, err := store.FindEntry(ctx, fp)
+	if err == filer_pb.ErrNotFound {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("find %s in %s: %v", fp, store.GetName(), err)
+	}
+	return entry, nil
+}
+
+func sameStoreEntry(a, b *Entry) bool {
+	if a == nil || b == nil {
+		return a == b
+	}
+	return proto.Equal(a.ToProtoEntry(), b.ToProtoEntry())
+}
+
+// copyStoreEntry makes the entry in the store "to" the same as in the store "from", which is written first.
+// The entry is copied again if it has changed in "from" while being copied, so a concurrent write
+// from another filer is either copied here, or copied by that filer after this copy.
+func copyStoreEntry(ctx context.Context, fp util.FullPath, from, to FilerStore) error {
+	toCtx := context.Background()
+	entry, err := findStoreEntry(ctx, from, fp)
+	if err != nil {
+		return err
+	}
+	for i := 0; i < storeMigrationCopyAttempts; i++ {
+		if entry != nil {
+			err = to.InsertEntry(toCtx, entry)
+		} else {
+			err = deleteStoreEntry(toCtx, fp, to)
+		}
+		if err != nil {
+			return err
+		}
+		current, err := findStoreEntry(ctx, from, fp)
+		if err != nil {
+			return err
+		}
+		if sameStoreEntry(entry, current) {
+			return nil
+		}
+		entry = current
+	}
+	return fmt.Errorf("%s keeps changing in %s", fp, from.GetName())
+}
+
+// deleteStoreEntry deletes the entry, and its children if it is a directory.
+func deleteStoreEntry(ctx context.Context, fp util.FullPath, store FilerStore) error {
+	entry, err := findStoreEntry(ctx, store, fp)
+	if err != nil || entry == nil {
+		return err
+	}
+	if entry.IsDirectory() {
+		if err = store.DeleteFolderChildren(ctx, fp); err != nil {
+			return err
+		}
+	}
+	return store.DeleteEntry(ctx, fp)
+}
+
+// copyStoreKv makes the key-value in the store "to" the same as in the store "from", like copyStoreEntry.
+func copyStoreKv(ctx context.Context, key []byte, from, to FilerStore) error {
+	toCtx := context.Background()
+	value, getErr := from.KvGet(ctx, key)
+	for i := 0; i < storeMigrationCopyAttempts; i++ {
+		var err error
+		switch getErr {
+		case nil:
+			err = to.KvPut(toCtx, key, value)
+		case ErrKvNotFound:
+			err = to.KvDelete(toCtx, key)
+		default:
+			err = getErr
+		}
+		if err != nil {
+			return err
+		}
+		current, currentErr := from.KvGet(ctx, key)
+		if currentErr == getErr && bytes.Equal(current, value) {
+			return nil
+		}
+		value, getErr = current, currentErr
+	}
+	return fmt.Errorf("%s keeps changing in %s", key, from.GetName())
+}
+
+// copyEntry copies the entry from the old store to the new store, or deletes it from the new store if it is gone.
+func (m *StoreMigration) copyEntry(ctx context.Context, fp util.FullPath) error {
+	m.folderLock.RLock()
+	defer m.folderLock.RUnlock()
+	lock := m.lockOf([]byte(fp))
+	lock.Lock()
+	defer lock.Unlock()
+
+	if err := copyStoreEntry(ctx, fp, m.oldStore, m.newStore); err != nil {
+		return fmt.Errorf("copy %s: %v", fp, err)
+	}
+	return nil
+}
+
+// verifyEntry compares the entry in both stores, and repairs the new store if they differ.
+func (m *StoreMigration) verifyEntry(ctx context.Context, fp util.FullPath) (matched bool, err error) {
+	m.folderLock.RLock()
+	defer m.folderLock.RUnlock()
+	lock := m.lockOf([]byte(fp))
+	lock.Lock()
+	defer lock.Unlock()
+
+	oldEntry, err := findStoreEntry(ctx, m.oldStore, fp)
+	if err != nil {
+		return false, err
+	}
+	newEntry, err := findStoreEntry(ctx, m.newStore, fp)
+	if err != nil {
+		return false, err
+	}
+	if sameStoreEntry(oldEntry, newEntry) {
+		return true, nil
+	}
+	// the entry may be changing
+	return false, copyStoreEntry(ctx, fp, m.oldStore, m.newStore)
+}
+
+// copyKv copies the key-value from the old store to the new store, unless it is in the new store already.
+func (m *StoreMigration) copyKv(ctx context.Context, key []byte) (value []byte, err error) {
+	lock := m.lockOf(key)
+	lock.Lock()
+	defer lock.Unlock()
+
+	if value, err = m.newStore.KvGet(ctx, key); err != ErrKvNotFound {
+		return value, err
+	}
+	if err = copyStoreKv(ctx, key, m.oldStore, m.newStore); err != nil {
+		return nil, err
+	}
+	return m.newStore.KvGet(ctx, key)
+}
+
+func (m *StoreMigration) addFailure(fn func()) {
+	m.dualWriteErrors.Add(1)
+	m.failedLock.Lock()
+	fn()
+	m.failedLock.Unlock()
+}
+
+// RetryFailedWrites writes the entries and key-values failed to write to the other store again.
+func (m *StoreMigration) RetryFailedWrites(ctx context.Context) {
+	m.failedLock.Lock()
+	var paths, folders []util.FullPath
+	var keys []string
+	for fp := range m.failedPaths {
+		paths = append(paths, fp)
+	}
+	for fp := range m.failedFolders {
+		folders = append(folders, fp)
+	}
+	for key := range m.failedKeys {
+		keys = append(keys, key)
+	}
+	m.failedLock.Unlock()
+
+	primary, secondary := m.stores()
+	retry := func(failed map[util.FullPath]bool, fp util.FullPath, fn func() error) {
+		if secondary != nil {
+			if err := fn(); err != nil {
+				glog.V(1).Infof("store migration: retry %s in %s: %v", fp, secondary.GetName(), err)
+				return
+			}
+		}
+		m.failedLock.Lock()
+		delete(failed, fp)
+		m.failedLock.Unlock()
+	}
+	for _, fp := range folders {
+		retry(m.failedFolders, fp, func() error {
+			return m.copyFolderChildren(ctx, fp, primary, secondary)
+		})
+	}
+	for _, fp := range paths {
+		retry(m.failedPaths, fp, func() error {
+			m.folderLock.RLock()
+			defer m.folderLock.RUnlock()
+			lock := m.lockOf([]byte(fp))
+			lock.Lock()
+			defer lock.Unlock()
+			return copyStoreEntry(ctx, fp, primary, secondary)
+		})
+	}
+	for _, key := range keys {
+		if secondary != nil {
+			lock := m.lockOf([]byte(key))
+			lock.Lock()
+			err := copyStoreKv(ctx, []byte(key), primary, secondary)
+			lock.Unlock()
+			if err != nil {
+				glog.V(1).Infof("store migration: retry %s in %s: %v", key, secondary.GetName(), err)
+				continue
+			}
+		}
+		m.failedLock.Lock()
+		delete(m.failedKeys, key)
+		m.failedLock.Unlock()
+	}
+}
+
+// copyFolderChildren copies the children of the folder in the store "to" from the store "from",
+// which deletes the ones gone from "from".
+func (m *StoreMigration) copyFolderChildren(ctx context.Context, dir util.FullPath, from, to FilerStore) error {
+	lastFileName := ""
+	for {
+		var children []util.FullPath
+		if _, err := to.ListDirectoryEntries(ctx, dir, lastFileName, false, PaginationSize, func(entry *Entry) bool {
+			children = append(children, entry.FullPath)
+			return true
+		}); err != nil {
+			return fmt.Errorf("list %s in %s: %v", dir, to.GetName(), err)
+		}
+		for _, child := range children {
+			lastFileName = child.Name()
+			lock := m.lockOf([]byte(child))
+			lock.Lock()
+			err := copyStoreEntry(ctx, child, from, to)
+			lock.Unlock()
+			if err != nil {
+				return err
+			}
+		}
+		if len(children) < PaginationSize {
+			return nil
+		}
+	}
+}
+
+func storeMigrationFilerKey(address pb.ServerAddress) []byte {
+	return []byte(storeMigrationFilerPrefix + string(address))
+}
+
+// SaveFilerStatus reports the phase this filer uses, and its failed writes.
+func (m *StoreMigration) SaveFilerStatus(address pb.ServerAddress) error {
+	filerStatus := &filer_pb.StoreMigrationFiler{
+		Address:         string(address),
+		Phase:           m.Phase(),
+		DualWriteErrors: m.dualWriteErrors.Load(),
+		UpdatedAtNs:     time.Now().UnixNano(),
+	}
+	m.failedLock.Lock()
+	for fp := range m.failedPaths {
+		filerStatus.FailedPaths = append(filerStatus.FailedPaths, string(fp))
+	}
+	for fp := range m.failedFolders {
+		filerStatus.FailedFolders = append(filerStatus.FailedFolders, string(fp))
+	}
+	for key := range m.failedKeys {
+		filerStatus.FailedKeys = append(filerStatus.FailedKeys, []byte(key))
+	}
+	m.failedLock.Unlock()
+
+	value, err := proto.Marshal(filerStatus)
+	if err != nil {
+		return err
+	}
+	if err = m.newStore.KvPut(context.Background(), storeMigrationFilerKey(address), value); err != nil {
+		return fmt.Errorf("save store migration of %s: %v", address, err)
+	}
+	return nil
+}
+
+// LoadFilerStatus reads the status reported by the filer, or ErrKvNotFound.
+func (m *StoreMigration) LoadFilerStatus(address pb.ServerAddress) (*filer_pb.StoreMigrationFiler, error) {
+	value, err := m.newStore.KvGet(context.Background(), storeMigrationFilerKey(address))
+	if err != nil {
+		return nil, err
+	}
+	filerStatus := &filer_pb.StoreMigrationFiler{}
+	if err = proto.Unmarshal(value, filerStatus); err != nil {
+		return nil, fmt.Errorf("decode store migration of %s: %v", address, err)
+	}
+	return filerStatus, nil
+}
+
+func (m *StoreMigration) GetName() string {
+	primary, _ := m.stores()
+	return primary.GetName()
+}
+
+func (m *StoreMigration) Initialize(configuration util.Configuration, prefix string) error {
+	return nil
+}
+
+func (m *StoreMigration) InsertEntry(ctx context.Context, entry *Entry) error {
+	return m.writeEntry(ctx, entry.FullPath, "insert", func(ctx context.Context, store FilerStore) error {
+		return store.InsertEntry(ctx, entry)
+	})
+}
+
+func (m *StoreMigration) UpdateEntry(ctx context.Context, entry *Entry) error {
+	return m.writeEntry(ctx, entry.FullPath, "update", func(ctx context.Context, store FilerStore) error {
+		return store.UpdateEntry(ctx, entry)
+	})
+}
+
+func (m *StoreMigration) FindEntry(ctx context.Context, fp util.FullPath) (*Entry, error) {
+	primary, _, _ := m.storesOf(ctx)
+	return primary.FindEntry(ctx, fp)
+}
+
+func (m *StoreMigration) DeleteEntry(ctx context.Context, fp util.FullPath) error {
+	return m.writeEntry(ctx, fp, "delete", func(ctx context.Context, store FilerStore) error {
+		return store.DeleteEntry(ctx, fp)
+	})
+}
+
+func (m *StoreMigration) DeleteFolderChildren(ctx context.Context, fp util.FullPath) error {
+	m.folderLock.Lock()
+	defer m.folderLock.Unlock()
+
+	primary, secondary, tx := m.storesOf(ctx)
+	if err := primary.DeleteFolderChildren(ctx, fp); err != nil {
+		return err
+	}
+	if secondary == nil {
+		return nil
+	}
+	if tx != nil {
+		tx.addCopy(func() {
+			m.folderLock.Lock()
+			defer m.folderLock.Unlock()
+			m.deleteWrittenFolderChildren(fp, secondary)
+		})
+		return nil
+	}
+	m.deleteWrittenFolderChildren(fp, secondary)
+	return nil
+}
+
+// deleteWrittenFolderChildren deletes the children deleted in the primary store, or keeps the folder to retry.
+func (m *StoreMigration) deleteWrittenFolderChildren(fp util.FullPath, secondary FilerStore) {
+	if err := secondary.DeleteFolderChildren(context.Background(), fp); err != nil {
+		glog.Errorf("store migration: delete children %s in %s: %v", fp, secondary.GetName(), err)
+		m.addFailure(func() { m.failedFolders[fp] = true })
+	}
+}
+
+func (m *StoreMigration) ListDirectoryEntries(ctx context.Context, dirPath util.FullPath, startFileName string, includeStartFile bool, limit int64, eachEntryFunc ListEachEntryFunc) (string, error) {
+	primary, _, _ := m.storesOf(ctx)
+	return primary.ListDirectoryEntries(ctx, dirPath, startFileName, includeStartFile, limit, eachEntryFunc)
+}
+
+func (m *StoreMigration) ListDirectoryPrefixedEntries(ctx context.Context, dirPath util.FullPath, startFileName string, includeStartFile bool, limit int64, prefix string, eachEntryFunc ListEachEntryFunc) (string, error) {
+	primary, _, _ := m.storesOf(ctx)
+	return primary.ListDirectoryPrefixedEntries(ctx, dirPath, startFileName, includeStartFile, limit, prefix, eachEntryFunc)
+}
+
+// BeginTransaction begins a transaction of the primary store. The writes in it are copied
+// to the secondary store when it is committed.
+func (m *StoreMigration) BeginTransaction(ctx context.Context) (context.Context, error) {
+	tx := &storeMigrationTx{}
+	tx.primary, tx.secondary = m.stores()
+	ctx, err := tx.primary.BeginTransaction(ctx)
+	if err != nil {
+		return ctx, err
+	}
+	return context.WithValue(ctx, storeMigrationTxKey{}, tx), nil
+}
+
+func (m *StoreMigration) CommitTransaction(ctx context.Context) error {
+	primary, _, tx := m.storesOf(ctx)
+	err := primary.CommitTransaction(ctx)
+	if tx != nil {
+		// the copies read the committed entries, so they are also right if the commit failed
+		for _, copyFn := range tx.takeCopies() {
+			copyFn()
+		}
+	}
+	return err
+}
+
+func (m *StoreMigration) RollbackTransaction(ctx context.Context) error {
+	primary, _, tx := m.storesOf(ctx)
+	if tx != nil {
+		tx.takeCopies()
+	}
+	return primary.RollbackTransaction(ctx)
+}
+
+func (m *StoreMigration) KvPut(ctx context.Context, key []byte, value []byte) error {
+	return m.writeKv(ctx, key, "kv put", func(ctx context.Context, store FilerStore) error {
+		return store.KvPut(ctx, key, value)
+	})
+}
+
+func (m *StoreMigration) KvGet(ctx context.Context, key []byte) ([]byte, error) {
+	primary, _, _ := m.storesOf(ctx)
+	value, err := primary.KvGet(ctx, key)
+	if err != ErrKvNotFound || primary != m.newStore {
+		return value, err
+	}
+	// not copied by the backfill
+	return m.copyKv(ctx, key)
+}
+
+func (m *StoreMigration) KvDelete(ctx context.Context, key []byte) error {
+	return m.writeKv(ctx, key, "kv delete", func(ctx context.Context, store FilerStore) error {
+		return store.KvDelete(ctx, key)
+	})
+}
+
+func (m *StoreMigration) OnBucketCreation(bucket string) {
+	for _, store := range []FilerStore{m.oldStore, m.newStore} {
+		if ba, ok := store.(BucketAware); ok {
+			ba.OnBucketCreation(bucket)
+		}
+	}
+}
+
+func (m *StoreMigration) OnBucketDeletion(bucket string) {
+	for _, store := range []FilerStore{m.oldStore, m.newStore} {
+		if ba, ok := store.(BucketAware); ok {
+			ba.OnBucketDeletion(bucket)
+		}
+	}
+}
+
+// CanDropWholeBucket is true only if both stores can drop the bucket.
+func (m *StoreMigration) CanDropWholeBucket() bool {
+	for _, store := range []FilerStore{m.oldStore, m.newStore} {
+		if ba, ok := store.(BucketAware); !ok || !ba.CanDropWholeBucket() {
+			return false
+		}
+	}
+	return true
+}
+
+func (m *StoreMigration) Shutdown() {
+	m.oldStore.Shutdown()
+	m.newStore.Shutdown()
+}
@@ -25,6 +25,7 @@ type VirtualFilerStore interface {
 	DeleteHardLink(ctx context.Context, hardLinkId HardLinkId) error
 	DeleteOneEntry(ctx context.Context, entry *Entry) error
 	AddPathSpecificStore(path string, storeId string, store FilerStore)
+	MigrateDefaultStore(newStore FilerStore) (*StoreMigration, error)
 	OnBucketCreation(bucket string)
 	OnBucketDeletion(bucket string)
 	CanDropWholeBucket() bool
@@ -83,6 +84,16 @@ func (fsw *FilerStoreWrapper) AddPathSpecificStore(path string, storeId string,
 	}
 }
 
+// MigrateDefaultStore writes to both the default store and the new store, see StoreMigration.
+func (fsw *FilerStoreWrapper) MigrateDefaultStore(newStore FilerStore) (*StoreMigration, error) {
+	m, err := NewStoreMigration(fsw.defaultStore, newStore)
+	if err != nil {
+		return nil, err
+	}
+	fsw.defaultStore = m
+	return m, nil
+}
+
 func (fsw *FilerStoreWrapper) getActualStore(path util.FullPath) (store FilerStore) {
 	store = fsw.defaultStore
 	if path == "/" || path == "//" {
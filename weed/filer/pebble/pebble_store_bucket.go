@@ -0,0 +1,24 @@
+package pebble
+
+import (
+	"os"
+
+	"github.com/gateway-dao/seaweedfs/weed/filer"
+)
+
+var _ filer.BucketAware = (*PebbleStore)(nil)
+
+func (store *PebbleStore) OnBucketCreation(bucket string) {
+	store.createDB(bucket)
+}
+
+func (store *PebbleStore) OnBucketDeletion(bucket string) {
+	store.closeDB(bucket)
+	if bucket != "" { // just to make sure
+		os.RemoveAll(store.dir + "/" + bucket)
+	}
+}
+
+func (store *PebbleStore) CanDropWholeBucket() bool {
+	return true
+}
@@ -0,0 +1,51 @@
+package pebble
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/cockroachdb/pebble"
+
+	"github.com/gateway-dao/seaweedfs/weed/filer"
+)
+
+func (store *PebbleStore) KvPut(ctx context.Context, key []byte, value []byte) (err error) {
+
+	err = store.dbs[DEFAULT].Set(key, value, pebble.NoSync)
+
+	if err != nil {
+		return fmt.Errorf("kv put: %v", err)
+	}
+
+	return nil
+}
+
+func (store *PebbleStore) KvGet(ctx context.Context, key []byte) (value []byte, err error) {
+
+	data, closer, err := store.dbs[DEFAULT].Get(key)
+
+	if err == pebble.ErrNotFound {
+		return nil, filer.ErrKvNotFound
+	}
+
+	if err != nil {
+		return nil, fmt.Errorf("kv get: %v", err)
+	}
+	defer closer.Close()
+
+	// the data is only valid until the closer is closed
+	value = append([]byte{}, data...)
+
+	return
+}
+
+func (store *PebbleStore) KvDelete(ctx context.Context, key []byte) (err error) {
+
+	err = store.dbs[DEFAULT].Delete(key, pebble.NoSync)
+
+	if err != nil {
+		return fmt.Errorf("kv delete: %v", err)
+	}
+
+	return nil
+}
@@ -0,0 +1,133 @@
+package pebble
+
+import (
+	"context"
+	"testing"
+
+	"github.com/gateway-dao/seaweedfs/weed/filer"
+	"github.com/gateway-dao/seaweedfs/weed/filer/store_test"
+	"github.com/gateway-dao/seaweedfs/weed/pb"
+	"github.com/gateway-dao/seaweedfs/weed/util"
+)
+
+func TestCreateAndFind(t *testing.T) {
+	testFiler := filer.NewFiler(pb.ServerDiscovery{}, nil, "", "", "", "", "", 255, nil)
+	dir := t.TempDir()
+	store := &PebbleStore{}
+	store.initialize(dir)
+	testFiler.SetStore(store)
+	defer store.Shutdown()
+
+	fullpath := util.FullPath("/home/chris/this/is/one/file1.jpg")
+
+	ctx := context.Background()
+
+	entry1 := &filer.Entry{
+		FullPath: fullpath,
+		Attr: filer.Attr{
+			Mode: 0440,
+			Uid:  1234,
+			Gid:  5678,
+		},
+	}
+
+	if err := testFiler.CreateEntry(ctx, entry1, false, false, nil, false, testFiler.MaxFilenameLength); err != nil {
+		t.Errorf("create entry %v: %v", entry1.FullPath, err)
+		return
+	}
+
+	entry, err := testFiler.FindEntry(ctx, fullpath)
+
+	if err != nil {
+		t.Errorf("find entry: %v", err)
+		return
+	}
+
+	if entry.FullPath != entry1.FullPath {
+		t.Errorf("find wrong entry: %v", entry.FullPath)
+		return
+	}
+
+	// checking one upper directory
+	entries, _, _ := testFiler.ListDirectoryEntries(ctx, util.FullPath("/home/chris/this/is/one"), "", false, 100, "", "", "")
+	if len(entries) != 1 {
+		t.Errorf("list entries count: %v", len(entries))
+		return
+	}
+
+	// checking one upper directory
+	entries, _, _ = testFiler.ListDirectoryEntries(ctx, util.FullPath("/"), "", false, 100, "", "", "")
+	if len(entries) != 1 {
+		t.Errorf("list entries count: %v", len(entries))
+		return
+	}
+
+}
+
+func TestEmptyRoot(t *testing.T) {
+	testFiler := filer.NewFiler(pb.ServerDiscovery{}, nil, "", "", "", "", "", 255, nil)
+	dir := t.TempDir()
+	store := &PebbleStore{}
+	store.initialize(dir)
+	testFiler.SetStore(store)
+	defer store.Shutdown()
+
+	ctx := context.Background()
+
+	// checking one upper directory
+	entries, _, err := testFiler.ListDirectoryEntries(ctx, util.FullPath("/"), "", false, 100, "", "", "")
+	if err != nil {
+		t.Errorf("list entries: %v", err)
+		return
+	}
+	if len(entries) != 0 {
+		t.Errorf("list entries count: %v", len(entries))
+		return
+	}
+
+}
+
+func TestStore(t *testing.T) {
+	store := &PebbleStore{}
+	store.initialize(t.TempDir())
+	defer store.Shutdown()
+	store_test.TestFilerStore(t, store)
+}
+
+func TestDeleteFolderChildren(t *testing.T) {
+	store := &PebbleStore{}
+	store.initialize(t.TempDir())
+	defer store.Shutdown()
+
+	ctx := context.Background()
+	for _, dir := range []string{"/a/b", "/buckets/bucket1/b"} {
+		for _, path := range []string{dir, dir + "/c", dir + "/d", dir + "/c/e"} {
+			if err := store.InsertEntry(ctx, &filer.Entry{FullPath: util.FullPath(path)}); err != nil {
+				t.Fatalf("insert %s: %v", path, err)
+			}
+		}
+		if err := store.DeleteFolderChildren(ctx, util.FullPath(dir)); err != nil {
+			t.Fatalf("delete children of %s: %v", dir, err)
+		}
+		var names []string
+		if _, err := store.ListDirectoryEntries(ctx, util.FullPath(dir), "", false, 100, func(entry *filer.Entry) bool {
+			names = append(names, entry.Name())
+			return true
+		}); err != nil || len(names) != 0 {
+			t.Fatalf("children of %s after deletion: %v %v", dir, names, err)
+		}
+		for _, path := range []string{dir, dir + "/c/e"} {
+			if _, err := store.FindEntry(ctx, util.FullPath(path)); err != nil {
+				t.Errorf("%s is deleted: %v", path, err)
+			}
+		}
+	}
+
+	// the whole bucket is dropped
+	if err := store.DeleteFolderChildren(ctx, "/buckets/bucket1"); err != nil {
+		t.Fatalf("drop bucket: %v", err)
+	}
+	if _, err := store.FindEntry(ctx, "/buckets/bucket1/b"); err == nil {
+		t.Errorf("entry in dropped bucket still exists")
+	}
+}
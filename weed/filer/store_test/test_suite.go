@@ -29,14 +29,14 @@ func TestFilerStore(t *testing.T, store filer.FilerStore) {
 		})
 		assert.Nil(t, err, "list directory")
 		assert.Equal(t, 3, counter, "directory list counter")
-		assert.Equal(t, "f00003", lastFileName, "directory list last file")
+		assert.Equal(t, "f00002", lastFileName, "directory list last file")
 		lastFileName, err = store.ListDirectoryEntries(ctx, util.FullPath("/a/b/c"), lastFileName, false, 1024, func(entry *filer.Entry) bool {
 			counter++
 			return true
 		})
 		assert.Nil(t, err, "list directory")
 		assert.Equal(t, 1027, counter, "directory list counter")
-		assert.Equal(t, "f01027", lastFileName, "directory list last file")
+		assert.Equal(t, "f01026", lastFileName, "directory list last file")
 	}
 
 }
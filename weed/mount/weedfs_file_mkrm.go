@@ -98,7 +98,7 @@ func (wfs *WFS) Mknod(cancel <-chan struct{}, in *fuse.MknodIn, name string, out
 	glog.V(3).Infof("mknod %s: %v", entryFullPath, err)
 
 	if err != nil {
-		return fuse.EIO
+		return filerErrorStatus(err)
 	}
 
 	// this is to increase nlookup counter
@@ -3,11 +3,23 @@ package mount
 import (
 	"context"
 	"fmt"
+	"github.com/gateway-dao/seaweedfs/weed/filer"
 	"github.com/gateway-dao/seaweedfs/weed/glog"
 	"github.com/gateway-dao/seaweedfs/weed/pb/filer_pb"
+	"github.com/hanwen/go-fuse/v2/fuse"
+	"strings"
+	"syscall"
 	"time"
 )
 
+// filerErrorStatus is EDQUOT for the directory quotas exceeded on the filer, and EIO otherwise.
+func filerErrorStatus(err error) fuse.Status {
+	if strings.Contains(err.Error(), filer.MsgQuotaExceeded) {
+		return fuse.Status(syscall.EDQUOT)
+	}
+	return fuse.EIO
+}
+
 func (wfs *WFS) loopCheckQuota() {
 
 	for {
@@ -191,6 +191,8 @@ func (wfs *WFS) Rename(cancel <-chan struct{}, in *fuse.RenameIn, oldName string
 						code = fuse.Status(syscall.ENOTEMPTY)
 					} else if strings.Contains(recvErr.Error(), "not directory") {
 						code = fuse.ENOTDIR
+					} else if strings.Contains(recvErr.Error(), filer.MsgQuotaExceeded) {
+						code = fuse.Status(syscall.EDQUOT)
 					}
 					return fmt.Errorf("dir Rename %s => %s receive: %v", oldPath, newPath, recvErr)
 				}
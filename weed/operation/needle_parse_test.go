@@ -18,7 +18,7 @@ type MockClient struct {
 }
 
 func (m *MockClient) Do(req *http.Request) (*http.Response, error) {
-	n, originalSize, _, _, err := needle.CreateNeedleFromRequest(req, false, 1024*1024, &bytes.Buffer{})
+	n, originalSize, _, _, err := needle.CreateNeedleFromRequest(req, false, 1024*1024, &bytes.Buffer{}, nil)
 	if m.needleHandling != nil {
 		m.needleHandling(n, originalSize, err)
 	}
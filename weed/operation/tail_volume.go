@@ -28,8 +28,16 @@ func TailVolume(masterFn GetMasterFn, grpcDialOption grpc.DialOption, vid needle
 }
 
 func TailVolumeFromSource(volumeServer pb.ServerAddress, grpcDialOption grpc.DialOption, vid needle.VolumeId, sinceNs uint64, idleTimeoutSeconds int, fn func(n *needle.Needle) error) error {
+	return TailVolumeNeedles(context.Background(), volumeServer, grpcDialOption, vid, sinceNs, idleTimeoutSeconds, func(n *needle.Needle, isDeleted bool) error {
+		return fn(n)
+	}, nil)
+}
+
+// TailVolumeNeedles follows the needles appended to the volume since sinceNs, until ctx is done, or the source is idle for idleTimeoutSeconds.
+// caughtUpFn, if not nil, is called whenever all needles appended so far are received.
+func TailVolumeNeedles(ctx context.Context, volumeServer pb.ServerAddress, grpcDialOption grpc.DialOption, vid needle.VolumeId, sinceNs uint64, idleTimeoutSeconds int, fn func(n *needle.Needle, isDeleted bool) error, caughtUpFn func()) error {
 	return WithVolumeServerClient(true, volumeServer, grpcDialOption, func(client volume_server_pb.VolumeServerClient) error {
-		ctx, cancel := context.WithCancel(context.Background())
+		ctx, cancel := context.WithCancel(ctx)
 		defer cancel()
 
 		stream, err := client.VolumeTailSender(ctx, &volume_server_pb.VolumeTailSenderRequest{
@@ -53,8 +61,12 @@ func TailVolumeFromSource(volumeServer pb.ServerAddress, grpcDialOption grpc.Dia
 
 			needleHeader := resp.NeedleHeader
 			needleBody := resp.NeedleBody
+			isDeleted := resp.IsDeleted
 
 			if len(needleHeader) == 0 {
+				if caughtUpFn != nil {
+					caughtUpFn()
+				}
 				continue
 			}
 
@@ -77,7 +89,7 @@ func TailVolumeFromSource(volumeServer pb.ServerAddress, grpcDialOption grpc.Dia
 				return err
 			}
 
-			err = fn(n)
+			err = fn(n, isDeleted)
 
 			if err != nil {
 				return err
@@ -40,6 +40,18 @@ const (
 	SeaweedFiler_KvGet_FullMethodName                           = "/filer_pb.SeaweedFiler/KvGet"
 	SeaweedFiler_KvPut_FullMethodName                           = "/filer_pb.SeaweedFiler/KvPut"
 	SeaweedFiler_CacheRemoteObjectToLocalCluster_FullMethodName = "/filer_pb.SeaweedFiler/CacheRemoteObjectToLocalCluster"
+	SeaweedFiler_CreateSnapshot_FullMethodName                  = "/filer_pb.SeaweedFiler/CreateSnapshot"
+	SeaweedFiler_ListSnapshots_FullMethodName                   = "/filer_pb.SeaweedFiler/ListSnapshots"
+	SeaweedFiler_DeleteSnapshot_FullMethodName                  = "/filer_pb.SeaweedFiler/DeleteSnapshot"
+	SeaweedFiler_RestoreSnapshot_FullMethodName                 = "/filer_pb.SeaweedFiler/RestoreSnapshot"
+	SeaweedFiler_SetDirectoryQuota_FullMethodName               = "/filer_pb.SeaweedFiler/SetDirectoryQuota"
+	SeaweedFiler_ListDirectoryQuotas_FullMethodName             = "/filer_pb.SeaweedFiler/ListDirectoryQuotas"
+	SeaweedFiler_RecalculateDirectoryQuotas_FullMethodName      = "/filer_pb.SeaweedFiler/RecalculateDirectoryQuotas"
+	SeaweedFiler_UpdateDirectoryQuotaUsage_FullMethodName       = "/filer_pb.SeaweedFiler/UpdateDirectoryQuotaUsage"
+	SeaweedFiler_ListEntryVersions_FullMethodName               = "/filer_pb.SeaweedFiler/ListEntryVersions"
+	SeaweedFiler_RestoreEntryVersion_FullMethodName             = "/filer_pb.SeaweedFiler/RestoreEntryVersion"
+	SeaweedFiler_DeleteEntryVersion_FullMethodName              = "/filer_pb.SeaweedFiler/DeleteEntryVersion"
+	SeaweedFiler_MigrateStore_FullMethodName                    = "/filer_pb.SeaweedFiler/MigrateStore"
 	SeaweedFiler_DistributedLock_FullMethodName                 = "/filer_pb.SeaweedFiler/DistributedLock"
 	SeaweedFiler_DistributedUnlock_FullMethodName               = "/filer_pb.SeaweedFiler/DistributedUnlock"
 	SeaweedFiler_FindLockOwner_FullMethodName                   = "/filer_pb.SeaweedFiler/FindLockOwner"
@@ -71,6 +83,18 @@ type SeaweedFilerClient interface {
 	KvGet(ctx context.Context, in *KvGetRequest, opts ...grpc.CallOption) (*KvGetResponse, error)
 	KvPut(ctx context.Context, in *KvPutRequest, opts ...grpc.CallOption) (*KvPutResponse, error)
 	CacheRemoteObjectToLocalCluster(ctx context.Context, in *CacheRemoteObjectToLocalClusterRequest, opts ...grpc.CallOption) (*CacheRemoteObjectToLocalClusterResponse, error)
+	CreateSnapshot(ctx context.Context, in *CreateSnapshotRequest, opts ...grpc.CallOption) (*CreateSnapshotResponse, error)
+	ListSnapshots(ctx context.Context, in *ListSnapshotsRequest, opts ...grpc.CallOption) (*ListSnapshotsResponse, error)
+	DeleteSnapshot(ctx context.Context, in *DeleteSnapshotRequest, opts ...grpc.CallOption) (*DeleteSnapshotResponse, error)
+	RestoreSnapshot(ctx context.Context, in *RestoreSnapshotRequest, opts ...grpc.CallOption) (*RestoreSnapshotResponse, error)
+	SetDirectoryQuota(ctx context.Context, in *SetDirectoryQuotaRequest, opts ...grpc.CallOption) (*SetDirectoryQuotaResponse, error)
+	ListDirectoryQuotas(ctx context.Context, in *ListDirectoryQuotasRequest, opts ...grpc.CallOption) (*ListDirectoryQuotasResponse, error)
+	RecalculateDirectoryQuotas(ctx context.Context, in *RecalculateDirectoryQuotasRequest, opts ...grpc.CallOption) (*RecalculateDirectoryQuotasResponse, error)
+	UpdateDirectoryQuotaUsage(ctx context.Context, in *UpdateDirectoryQuotaUsageRequest, opts ...grpc.CallOption) (*UpdateDirectoryQuotaUsageResponse, error)
+	ListEntryVersions(ctx context.Context, in *ListEntryVersionsRequest, opts ...grpc.CallOption) (*ListEntryVersionsResponse, error)
+	RestoreEntryVersion(ctx context.Context, in *RestoreEntryVersionRequest, opts ...grpc.CallOption) (*RestoreEntryVersionResponse, error)
+	DeleteEntryVersion(ctx context.Context, in *DeleteEntryVersionRequest, opts ...grpc.CallOption) (*DeleteEntryVersionResponse, error)
+	MigrateStore(ctx context.Context, in *MigrateStoreRequest, opts ...grpc.CallOption) (*MigrateStoreResponse, error)
 	DistributedLock(ctx context.Context, in *LockRequest, opts ...grpc.CallOption) (*LockResponse, error)
 	DistributedUnlock(ctx context.Context, in *UnlockRequest, opts ...grpc.CallOption) (*UnlockResponse, error)
 	FindLockOwner(ctx context.Context, in *FindLockOwnerRequest, opts ...grpc.CallOption) (*FindLockOwnerResponse, error)
@@ -390,6 +414,114 @@ func (c *seaweedFilerClient) CacheRemoteObjectToLocalCluster(ctx context.Context
 	return out, nil
 }
 
+func (c *seaweedFilerClient) CreateSnapshot(ctx context.Context, in *CreateSnapshotRequest, opts ...grpc.CallOption) (*CreateSnapshotResponse, error) {
+	out := new(CreateSnapshotResponse)
+	err := c.cc.Invoke(ctx, SeaweedFiler_CreateSnapshot_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *seaweedFilerClient) ListSnapshots(ctx context.Context, in *ListSnapshotsRequest, opts ...grpc.CallOption) (*ListSnapshotsResponse, error) {
+	out := new(ListSnapshotsResponse)
+	err := c.cc.Invoke(ctx, SeaweedFiler_ListSnapshots_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *seaweedFilerClient) DeleteSnapshot(ctx context.Context, in *DeleteSnapshotRequest, opts ...grpc.CallOption) (*DeleteSnapshotResponse, error) {
+	out := new(DeleteSnapshotResponse)
+	err := c.cc.Invoke(ctx, SeaweedFiler_DeleteSnapshot_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *seaweedFilerClient) RestoreSnapshot(ctx context.Context, in *RestoreSnapshotRequest, opts ...grpc.CallOption) (*RestoreSnapshotResponse, error) {
+	out := new(RestoreSnapshotResponse)
+	err := c.cc.Invoke(ctx, SeaweedFiler_RestoreSnapshot_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *seaweedFilerClient) SetDirectoryQuota(ctx context.Context, in *SetDirectoryQuotaRequest, opts ...grpc.CallOption) (*SetDirectoryQuotaResponse, error) {
+	out := new(SetDirectoryQuotaResponse)
+	err := c.cc.Invoke(ctx, SeaweedFiler_SetDirectoryQuota_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *seaweedFilerClient) ListDirectoryQuotas(ctx context.Context, in *ListDirectoryQuotasRequest, opts ...grpc.CallOption) (*ListDirectoryQuotasResponse, error) {
+	out := new(ListDirectoryQuotasResponse)
+	err := c.cc.Invoke(ctx, SeaweedFiler_ListDirectoryQuotas_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *seaweedFilerClient) RecalculateDirectoryQuotas(ctx context.Context, in *RecalculateDirectoryQuotasRequest, opts ...grpc.CallOption) (*RecalculateDirectoryQuotasResponse, error) {
+	out := new(RecalculateDirectoryQuotasResponse)
+	err := c.cc.Invoke(ctx, SeaweedFiler_RecalculateDirectoryQuotas_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *seaweedFilerClient) UpdateDirectoryQuotaUsage(ctx context.Context, in *UpdateDirectoryQuotaUsageRequest, opts ...grpc.CallOption) (*UpdateDirectoryQuotaUsageResponse, error) {
+	out := new(UpdateDirectoryQuotaUsageResponse)
+	err := c.cc.Invoke(ctx, SeaweedFiler_UpdateDirectoryQuotaUsage_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *seaweedFilerClient) ListEntryVersions(ctx context.Context, in *ListEntryVersionsRequest, opts ...grpc.CallOption) (*ListEntryVersionsResponse, error) {
+	out := new(ListEntryVersionsResponse)
+	err := c.cc.Invoke(ctx, SeaweedFiler_ListEntryVersions_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *seaweedFilerClient) RestoreEntryVersion(ctx context.Context, in *RestoreEntryVersionRequest, opts ...grpc.CallOption) (*RestoreEntryVersionResponse, error) {
+	out := new(RestoreEntryVersionResponse)
+	err := c.cc.Invoke(ctx, SeaweedFiler_RestoreEntryVersion_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *seaweedFilerClient) DeleteEntryVersion(ctx context.Context, in *DeleteEntryVersionRequest, opts ...grpc.CallOption) (*DeleteEntryVersionResponse, error) {
+	out := new(DeleteEntryVersionResponse)
+	err := c.cc.Invoke(ctx, SeaweedFiler_DeleteEntryVersion_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *seaweedFilerClient) MigrateStore(ctx context.Context, in *MigrateStoreRequest, opts ...grpc.CallOption) (*MigrateStoreResponse, error) {
+	out := new(MigrateStoreResponse)
+	err := c.cc.Invoke(ctx, SeaweedFiler_MigrateStore_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *seaweedFilerClient) DistributedLock(ctx context.Context, in *LockRequest, opts ...grpc.CallOption) (*LockResponse, error) {
 	out := new(LockResponse)
 	err := c.cc.Invoke(ctx, SeaweedFiler_DistributedLock_FullMethodName, in, out, opts...)
@@ -451,6 +583,18 @@ type SeaweedFilerServer interface {
 	KvGet(context.Context, *KvGetRequest) (*KvGetResponse, error)
 	KvPut(context.Context, *KvPutRequest) (*KvPutResponse, error)
 	CacheRemoteObjectToLocalCluster(context.Context, *CacheRemoteObjectToLocalClusterRequest) (*CacheRemoteObjectToLocalClusterResponse, error)
+	CreateSnapshot(context.Context, *CreateSnapshotRequest) (*CreateSnapshotResponse, error)
+	ListSnapshots(context.Context, *ListSnapshotsRequest) (*ListSnapshotsResponse, error)
+	DeleteSnapshot(context.Context, *DeleteSnapshotRequest) (*DeleteSnapshotResponse, error)
+	RestoreSnapshot(context.Context, *RestoreSnapshotRequest) (*RestoreSnapshotResponse, error)
+	SetDirectoryQuota(context.Context, *SetDirectoryQuotaRequest) (*SetDirectoryQuotaResponse, error)
+	ListDirectoryQuotas(context.Context, *ListDirectoryQuotasRequest) (*ListDirectoryQuotasResponse, error)
+	RecalculateDirectoryQuotas(context.Context, *RecalculateDirectoryQuotasRequest) (*RecalculateDirectoryQuotasResponse, error)
+	UpdateDirectoryQuotaUsage(context.Context, *UpdateDirectoryQuotaUsageRequest) (*UpdateDirectoryQuotaUsageResponse, error)
+	ListEntryVersions(context.Context, *ListEntryVersionsRequest) (*ListEntryVersionsResponse, error)
+	RestoreEntryVersion(context.Context, *RestoreEntryVersionRequest) (*RestoreEntryVersionResponse, error)
+	DeleteEntryVersion(context.Context, *DeleteEntryVersionRequest) (*DeleteEntryVersionResponse, error)
+	MigrateStore(context.Context, *MigrateStoreRequest) (*MigrateStoreResponse, error)
 	DistributedLock(context.Context, *LockRequest) (*LockResponse, error)
 	DistributedUnlock(context.Context, *UnlockRequest) (*UnlockResponse, error)
 	FindLockOwner(context.Context, *FindLockOwnerRequest) (*FindLockOwnerResponse, error)
@@ -526,6 +670,42 @@ func (UnimplementedSeaweedFilerServer) KvPut(context.Context, *KvPutRequest) (*K
 func (UnimplementedSeaweedFilerServer) CacheRemoteObjectToLocalCluster(context.Context, *CacheRemoteObjectToLocalClusterRequest) (*CacheRemoteObjectToLocalClusterResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method CacheRemoteObjectToLocalCluster not implemented")
 }
+func (UnimplementedSeaweedFilerServer) CreateSnapshot(context.Context, *CreateSnapshotRequest) (*CreateSnapshotResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateSnapshot not implemented")
+}
+func (UnimplementedSeaweedFilerServer) ListSnapshots(context.Context, *ListSnapshotsRequest) (*ListSnapshotsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListSnapshots not implemented")
+}
+func (UnimplementedSeaweedFilerServer) DeleteSnapshot(context.Context, *DeleteSnapshotRequest) (*DeleteSnapshotResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteSnapshot not implemented")
+}
+func (UnimplementedSeaweedFilerServer) RestoreSnapshot(context.Context, *RestoreSnapshotRequest) (*RestoreSnapshotResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RestoreSnapshot not implemented")
+}
+func (UnimplementedSeaweedFilerServer) SetDirectoryQuota(context.Context, *SetDirectoryQuotaRequest) (*SetDirectoryQuotaResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetDirectoryQuota not implemented")
+}
+func (UnimplementedSeaweedFilerServer) ListDirectoryQuotas(context.Context, *ListDirectoryQuotasRequest) (*ListDirectoryQuotasResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListDirectoryQuotas not implemented")
+}
+func (UnimplementedSeaweedFilerServer) RecalculateDirectoryQuotas(context.Context, *RecalculateDirectoryQuotasRequest) (*RecalculateDirectoryQuotasResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RecalculateDirectoryQuotas not implemented")
+}
+func (UnimplementedSeaweedFilerServer) UpdateDirectoryQuotaUsage(context.Context, *UpdateDirectoryQuotaUsageRequest) (*UpdateDirectoryQuotaUsageResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateDirectoryQuotaUsage not implemented")
+}
+func (UnimplementedSeaweedFilerServer) ListEntryVersions(context.Context, *ListEntryVersionsRequest) (*ListEntryVersionsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListEntryVersions not implemented")
+}
+func (UnimplementedSeaweedFilerServer) RestoreEntryVersion(context.Context, *RestoreEntryVersionRequest) (*RestoreEntryVersionResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RestoreEntryVersion not implemented")
+}
+func (UnimplementedSeaweedFilerServer) DeleteEntryVersion(context.Context, *DeleteEntryVersionRequest) (*DeleteEntryVersionResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteEntryVersion not implemented")
+}
+func (UnimplementedSeaweedFilerServer) MigrateStore(context.Context, *MigrateStoreRequest) (*MigrateStoreResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method MigrateStore not implemented")
+}
 func (UnimplementedSeaweedFilerServer) DistributedLock(context.Context, *LockRequest) (*LockResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method DistributedLock not implemented")
 }
@@ -944,6 +1124,222 @@ func _SeaweedFiler_CacheRemoteObjectToLocalCluster_Handler(srv interface{}, ctx
 	return interceptor(ctx, in, info, handler)
 }
 
+func _SeaweedFiler_CreateSnapshot_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateSnapshotRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SeaweedFilerServer).CreateSnapshot(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: SeaweedFiler_CreateSnapshot_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SeaweedFilerServer).CreateSnapshot(ctx, req.(*CreateSnapshotRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _SeaweedFiler_ListSnapshots_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListSnapshotsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SeaweedFilerServer).ListSnapshots(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: SeaweedFiler_ListSnapshots_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SeaweedFilerServer).ListSnapshots(ctx, req.(*ListSnapshotsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _SeaweedFiler_DeleteSnapshot_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteSnapshotRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SeaweedFilerServer).DeleteSnapshot(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: SeaweedFiler_DeleteSnapshot_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SeaweedFilerServer).DeleteSnapshot(ctx, req.(*DeleteSnapshotRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _SeaweedFiler_RestoreSnapshot_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RestoreSnapshotRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SeaweedFilerServer).RestoreSnapshot(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: SeaweedFiler_RestoreSnapshot_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SeaweedFilerServer).RestoreSnapshot(ctx, req.(*RestoreSnapshotRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _SeaweedFiler_SetDirectoryQuota_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetDirectoryQuotaRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SeaweedFilerServer).SetDirectoryQuota(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: SeaweedFiler_SetDirectoryQuota_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SeaweedFilerServer).SetDirectoryQuota(ctx, req.(*SetDirectoryQuotaRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _SeaweedFiler_ListDirectoryQuotas_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListDirectoryQuotasRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SeaweedFilerServer).ListDirectoryQuotas(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: SeaweedFiler_ListDirectoryQuotas_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SeaweedFilerServer).ListDirectoryQuotas(ctx, req.(*ListDirectoryQuotasRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _SeaweedFiler_RecalculateDirectoryQuotas_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RecalculateDirectoryQuotasRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SeaweedFilerServer).RecalculateDirectoryQuotas(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: SeaweedFiler_RecalculateDirectoryQuotas_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SeaweedFilerServer).RecalculateDirectoryQuotas(ctx, req.(*RecalculateDirectoryQuotasRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _SeaweedFiler_UpdateDirectoryQuotaUsage_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateDirectoryQuotaUsageRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SeaweedFilerServer).UpdateDirectoryQuotaUsage(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: SeaweedFiler_UpdateDirectoryQuotaUsage_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SeaweedFilerServer).UpdateDirectoryQuotaUsage(ctx, req.(*UpdateDirectoryQuotaUsageRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _SeaweedFiler_ListEntryVersions_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListEntryVersionsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SeaweedFilerServer).ListEntryVersions(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: SeaweedFiler_ListEntryVersions_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SeaweedFilerServer).ListEntryVersions(ctx, req.(*ListEntryVersionsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _SeaweedFiler_RestoreEntryVersion_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RestoreEntryVersionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SeaweedFilerServer).RestoreEntryVersion(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: SeaweedFiler_RestoreEntryVersion_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SeaweedFilerServer).RestoreEntryVersion(ctx, req.(*RestoreEntryVersionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _SeaweedFiler_DeleteEntryVersion_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteEntryVersionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SeaweedFilerServer).DeleteEntryVersion(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: SeaweedFiler_DeleteEntryVersion_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SeaweedFilerServer).DeleteEntryVersion(ctx, req.(*DeleteEntryVersionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _SeaweedFiler_MigrateStore_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MigrateStoreRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SeaweedFilerServer).MigrateStore(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: SeaweedFiler_MigrateStore_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SeaweedFilerServer).MigrateStore(ctx, req.(*MigrateStoreRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _SeaweedFiler_DistributedLock_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(LockRequest)
 	if err := dec(in); err != nil {
@@ -1087,6 +1483,54 @@ var SeaweedFiler_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "CacheRemoteObjectToLocalCluster",
 			Handler:    _SeaweedFiler_CacheRemoteObjectToLocalCluster_Handler,
 		},
+		{
+			MethodName: "CreateSnapshot",
+			Handler:    _SeaweedFiler_CreateSnapshot_Handler,
+		},
+		{
+			MethodName: "ListSnapshots",
+			Handler:    _SeaweedFiler_ListSnapshots_Handler,
+		},
+		{
+			MethodName: "DeleteSnapshot",
+			Handler:    _SeaweedFiler_DeleteSnapshot_Handler,
+		},
+		{
+			MethodName: "RestoreSnapshot",
+			Handler:    _SeaweedFiler_RestoreSnapshot_Handler,
+		},
+		{
+			MethodName: "SetDirectoryQuota",
+			Handler:    _SeaweedFiler_SetDirectoryQuota_Handler,
+		},
+		{
+			MethodName: "ListDirectoryQuotas",
+			Handler:    _SeaweedFiler_ListDirectoryQuotas_Handler,
+		},
+		{
+			MethodName: "RecalculateDirectoryQuotas",
+			Handler:    _SeaweedFiler_RecalculateDirectoryQuotas_Handler,
+		},
+		{
+			MethodName: "UpdateDirectoryQuotaUsage",
+			Handler:    _SeaweedFiler_UpdateDirectoryQuotaUsage_Handler,
+		},
+		{
+			MethodName: "ListEntryVersions",
+			Handler:    _SeaweedFiler_ListEntryVersions_Handler,
+		},
+		{
+			MethodName: "RestoreEntryVersion",
+			Handler:    _SeaweedFiler_RestoreEntryVersion_Handler,
+		},
+		{
+			MethodName: "DeleteEntryVersion",
+			Handler:    _SeaweedFiler_DeleteEntryVersion_Handler,
+		},
+		{
+			MethodName: "MigrateStore",
+			Handler:    _SeaweedFiler_MigrateStore_Handler,
+		},
 		{
 			MethodName: "DistributedLock",
 			Handler:    _SeaweedFiler_DistributedLock_Handler,
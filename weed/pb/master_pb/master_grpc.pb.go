@@ -41,6 +41,10 @@ const (
 	Seaweed_RaftListClusterServers_FullMethodName = "/master_pb.Seaweed/RaftListClusterServers"
 	Seaweed_RaftAddServer_FullMethodName          = "/master_pb.Seaweed/RaftAddServer"
 	Seaweed_RaftRemoveServer_FullMethodName       = "/master_pb.Seaweed/RaftRemoveServer"
+	Seaweed_SetVolumeServerState_FullMethodName   = "/master_pb.Seaweed/SetVolumeServerState"
+	Seaweed_ListVolumeServerStates_FullMethodName = "/master_pb.Seaweed/ListVolumeServerStates"
+	Seaweed_SetCollectionEcScheme_FullMethodName  = "/master_pb.Seaweed/SetCollectionEcScheme"
+	Seaweed_GetCollectionEcScheme_FullMethodName  = "/master_pb.Seaweed/GetCollectionEcScheme"
 )
 
 // SeaweedClient is the client API for Seaweed service.
@@ -69,6 +73,10 @@ type SeaweedClient interface {
 	RaftListClusterServers(ctx context.Context, in *RaftListClusterServersRequest, opts ...grpc.CallOption) (*RaftListClusterServersResponse, error)
 	RaftAddServer(ctx context.Context, in *RaftAddServerRequest, opts ...grpc.CallOption) (*RaftAddServerResponse, error)
 	RaftRemoveServer(ctx context.Context, in *RaftRemoveServerRequest, opts ...grpc.CallOption) (*RaftRemoveServerResponse, error)
+	SetVolumeServerState(ctx context.Context, in *SetVolumeServerStateRequest, opts ...grpc.CallOption) (*SetVolumeServerStateResponse, error)
+	ListVolumeServerStates(ctx context.Context, in *ListVolumeServerStatesRequest, opts ...grpc.CallOption) (*ListVolumeServerStatesResponse, error)
+	SetCollectionEcScheme(ctx context.Context, in *SetCollectionEcSchemeRequest, opts ...grpc.CallOption) (*SetCollectionEcSchemeResponse, error)
+	GetCollectionEcScheme(ctx context.Context, in *GetCollectionEcSchemeRequest, opts ...grpc.CallOption) (*GetCollectionEcSchemeResponse, error)
 }
 
 type seaweedClient struct {
@@ -343,6 +351,42 @@ func (c *seaweedClient) RaftRemoveServer(ctx context.Context, in *RaftRemoveServ
 	return out, nil
 }
 
+func (c *seaweedClient) SetVolumeServerState(ctx context.Context, in *SetVolumeServerStateRequest, opts ...grpc.CallOption) (*SetVolumeServerStateResponse, error) {
+	out := new(SetVolumeServerStateResponse)
+	err := c.cc.Invoke(ctx, Seaweed_SetVolumeServerState_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *seaweedClient) ListVolumeServerStates(ctx context.Context, in *ListVolumeServerStatesRequest, opts ...grpc.CallOption) (*ListVolumeServerStatesResponse, error) {
+	out := new(ListVolumeServerStatesResponse)
+	err := c.cc.Invoke(ctx, Seaweed_ListVolumeServerStates_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *seaweedClient) SetCollectionEcScheme(ctx context.Context, in *SetCollectionEcSchemeRequest, opts ...grpc.CallOption) (*SetCollectionEcSchemeResponse, error) {
+	out := new(SetCollectionEcSchemeResponse)
+	err := c.cc.Invoke(ctx, Seaweed_SetCollectionEcScheme_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *seaweedClient) GetCollectionEcScheme(ctx context.Context, in *GetCollectionEcSchemeRequest, opts ...grpc.CallOption) (*GetCollectionEcSchemeResponse, error) {
+	out := new(GetCollectionEcSchemeResponse)
+	err := c.cc.Invoke(ctx, Seaweed_GetCollectionEcScheme_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // SeaweedServer is the server API for Seaweed service.
 // All implementations must embed UnimplementedSeaweedServer
 // for forward compatibility
@@ -369,6 +413,10 @@ type SeaweedServer interface {
 	RaftListClusterServers(context.Context, *RaftListClusterServersRequest) (*RaftListClusterServersResponse, error)
 	RaftAddServer(context.Context, *RaftAddServerRequest) (*RaftAddServerResponse, error)
 	RaftRemoveServer(context.Context, *RaftRemoveServerRequest) (*RaftRemoveServerResponse, error)
+	SetVolumeServerState(context.Context, *SetVolumeServerStateRequest) (*SetVolumeServerStateResponse, error)
+	ListVolumeServerStates(context.Context, *ListVolumeServerStatesRequest) (*ListVolumeServerStatesResponse, error)
+	SetCollectionEcScheme(context.Context, *SetCollectionEcSchemeRequest) (*SetCollectionEcSchemeResponse, error)
+	GetCollectionEcScheme(context.Context, *GetCollectionEcSchemeRequest) (*GetCollectionEcSchemeResponse, error)
 	mustEmbedUnimplementedSeaweedServer()
 }
 
@@ -442,6 +490,18 @@ func (UnimplementedSeaweedServer) RaftAddServer(context.Context, *RaftAddServerR
 func (UnimplementedSeaweedServer) RaftRemoveServer(context.Context, *RaftRemoveServerRequest) (*RaftRemoveServerResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method RaftRemoveServer not implemented")
 }
+func (UnimplementedSeaweedServer) SetVolumeServerState(context.Context, *SetVolumeServerStateRequest) (*SetVolumeServerStateResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetVolumeServerState not implemented")
+}
+func (UnimplementedSeaweedServer) ListVolumeServerStates(context.Context, *ListVolumeServerStatesRequest) (*ListVolumeServerStatesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListVolumeServerStates not implemented")
+}
+func (UnimplementedSeaweedServer) SetCollectionEcScheme(context.Context, *SetCollectionEcSchemeRequest) (*SetCollectionEcSchemeResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetCollectionEcScheme not implemented")
+}
+func (UnimplementedSeaweedServer) GetCollectionEcScheme(context.Context, *GetCollectionEcSchemeRequest) (*GetCollectionEcSchemeResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetCollectionEcScheme not implemented")
+}
 func (UnimplementedSeaweedServer) mustEmbedUnimplementedSeaweedServer() {}
 
 // UnsafeSeaweedServer may be embedded to opt out of forward compatibility for this service.
@@ -875,6 +935,78 @@ func _Seaweed_RaftRemoveServer_Handler(srv interface{}, ctx context.Context, dec
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Seaweed_SetVolumeServerState_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetVolumeServerStateRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SeaweedServer).SetVolumeServerState(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Seaweed_SetVolumeServerState_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SeaweedServer).SetVolumeServerState(ctx, req.(*SetVolumeServerStateRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Seaweed_ListVolumeServerStates_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListVolumeServerStatesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SeaweedServer).ListVolumeServerStates(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Seaweed_ListVolumeServerStates_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SeaweedServer).ListVolumeServerStates(ctx, req.(*ListVolumeServerStatesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Seaweed_SetCollectionEcScheme_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetCollectionEcSchemeRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SeaweedServer).SetCollectionEcScheme(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Seaweed_SetCollectionEcScheme_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SeaweedServer).SetCollectionEcScheme(ctx, req.(*SetCollectionEcSchemeRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Seaweed_GetCollectionEcScheme_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetCollectionEcSchemeRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SeaweedServer).GetCollectionEcScheme(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Seaweed_GetCollectionEcScheme_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SeaweedServer).GetCollectionEcScheme(ctx, req.(*GetCollectionEcSchemeRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // Seaweed_ServiceDesc is the grpc.ServiceDesc for Seaweed service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -958,6 +1090,22 @@ var Seaweed_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "RaftRemoveServer",
 			Handler:    _Seaweed_RaftRemoveServer_Handler,
 		},
+		{
+			MethodName: "SetVolumeServerState",
+			Handler:    _Seaweed_SetVolumeServerState_Handler,
+		},
+		{
+			MethodName: "ListVolumeServerStates",
+			Handler:    _Seaweed_ListVolumeServerStates_Handler,
+		},
+		{
+			MethodName: "SetCollectionEcScheme",
+			Handler:    _Seaweed_SetCollectionEcScheme_Handler,
+		},
+		{
+			MethodName: "GetCollectionEcScheme",
+			Handler:    _Seaweed_GetCollectionEcScheme_Handler,
+		},
 	},
 	Streams: []grpc.StreamDesc{
 		{
@@ -19,49 +19,57 @@ import (
 const _ = grpc.SupportPackageIsVersion7
 
 const (
-	VolumeServer_BatchDelete_FullMethodName                 = "/volume_server_pb.VolumeServer/BatchDelete"
-	VolumeServer_VacuumVolumeCheck_FullMethodName           = "/volume_server_pb.VolumeServer/VacuumVolumeCheck"
-	VolumeServer_VacuumVolumeCompact_FullMethodName         = "/volume_server_pb.VolumeServer/VacuumVolumeCompact"
-	VolumeServer_VacuumVolumeCommit_FullMethodName          = "/volume_server_pb.VolumeServer/VacuumVolumeCommit"
-	VolumeServer_VacuumVolumeCleanup_FullMethodName         = "/volume_server_pb.VolumeServer/VacuumVolumeCleanup"
-	VolumeServer_DeleteCollection_FullMethodName            = "/volume_server_pb.VolumeServer/DeleteCollection"
-	VolumeServer_AllocateVolume_FullMethodName              = "/volume_server_pb.VolumeServer/AllocateVolume"
-	VolumeServer_VolumeSyncStatus_FullMethodName            = "/volume_server_pb.VolumeServer/VolumeSyncStatus"
-	VolumeServer_VolumeIncrementalCopy_FullMethodName       = "/volume_server_pb.VolumeServer/VolumeIncrementalCopy"
-	VolumeServer_VolumeMount_FullMethodName                 = "/volume_server_pb.VolumeServer/VolumeMount"
-	VolumeServer_VolumeUnmount_FullMethodName               = "/volume_server_pb.VolumeServer/VolumeUnmount"
-	VolumeServer_VolumeDelete_FullMethodName                = "/volume_server_pb.VolumeServer/VolumeDelete"
-	VolumeServer_VolumeMarkReadonly_FullMethodName          = "/volume_server_pb.VolumeServer/VolumeMarkReadonly"
-	VolumeServer_VolumeMarkWritable_FullMethodName          = "/volume_server_pb.VolumeServer/VolumeMarkWritable"
-	VolumeServer_VolumeConfigure_FullMethodName             = "/volume_server_pb.VolumeServer/VolumeConfigure"
-	VolumeServer_VolumeStatus_FullMethodName                = "/volume_server_pb.VolumeServer/VolumeStatus"
-	VolumeServer_VolumeCopy_FullMethodName                  = "/volume_server_pb.VolumeServer/VolumeCopy"
-	VolumeServer_ReadVolumeFileStatus_FullMethodName        = "/volume_server_pb.VolumeServer/ReadVolumeFileStatus"
-	VolumeServer_CopyFile_FullMethodName                    = "/volume_server_pb.VolumeServer/CopyFile"
-	VolumeServer_ReadNeedleBlob_FullMethodName              = "/volume_server_pb.VolumeServer/ReadNeedleBlob"
-	VolumeServer_ReadNeedleMeta_FullMethodName              = "/volume_server_pb.VolumeServer/ReadNeedleMeta"
-	VolumeServer_WriteNeedleBlob_FullMethodName             = "/volume_server_pb.VolumeServer/WriteNeedleBlob"
-	VolumeServer_ReadAllNeedles_FullMethodName              = "/volume_server_pb.VolumeServer/ReadAllNeedles"
-	VolumeServer_VolumeTailSender_FullMethodName            = "/volume_server_pb.VolumeServer/VolumeTailSender"
-	VolumeServer_VolumeTailReceiver_FullMethodName          = "/volume_server_pb.VolumeServer/VolumeTailReceiver"
-	VolumeServer_VolumeEcShardsGenerate_FullMethodName      = "/volume_server_pb.VolumeServer/VolumeEcShardsGenerate"
-	VolumeServer_VolumeEcShardsRebuild_FullMethodName       = "/volume_server_pb.VolumeServer/VolumeEcShardsRebuild"
-	VolumeServer_VolumeEcShardsCopy_FullMethodName          = "/volume_server_pb.VolumeServer/VolumeEcShardsCopy"
-	VolumeServer_VolumeEcShardsDelete_FullMethodName        = "/volume_server_pb.VolumeServer/VolumeEcShardsDelete"
-	VolumeServer_VolumeEcShardsMount_FullMethodName         = "/volume_server_pb.VolumeServer/VolumeEcShardsMount"
-	VolumeServer_VolumeEcShardsUnmount_FullMethodName       = "/volume_server_pb.VolumeServer/VolumeEcShardsUnmount"
-	VolumeServer_VolumeEcShardRead_FullMethodName           = "/volume_server_pb.VolumeServer/VolumeEcShardRead"
-	VolumeServer_VolumeEcBlobDelete_FullMethodName          = "/volume_server_pb.VolumeServer/VolumeEcBlobDelete"
-	VolumeServer_VolumeEcShardsToVolume_FullMethodName      = "/volume_server_pb.VolumeServer/VolumeEcShardsToVolume"
-	VolumeServer_VolumeTierMoveDatToRemote_FullMethodName   = "/volume_server_pb.VolumeServer/VolumeTierMoveDatToRemote"
-	VolumeServer_VolumeTierMoveDatFromRemote_FullMethodName = "/volume_server_pb.VolumeServer/VolumeTierMoveDatFromRemote"
-	VolumeServer_VolumeServerStatus_FullMethodName          = "/volume_server_pb.VolumeServer/VolumeServerStatus"
-	VolumeServer_VolumeServerEvents_FullMethodName          = "/volume_server_pb.VolumeServer/VolumeServerEvents"
-	VolumeServer_VolumeServerLeave_FullMethodName           = "/volume_server_pb.VolumeServer/VolumeServerLeave"
-	VolumeServer_FetchAndWriteNeedle_FullMethodName         = "/volume_server_pb.VolumeServer/FetchAndWriteNeedle"
-	VolumeServer_Query_FullMethodName                       = "/volume_server_pb.VolumeServer/Query"
-	VolumeServer_VolumeNeedleStatus_FullMethodName          = "/volume_server_pb.VolumeServer/VolumeNeedleStatus"
-	VolumeServer_Ping_FullMethodName                        = "/volume_server_pb.VolumeServer/Ping"
+	VolumeServer_BatchDelete_FullMethodName                    = "/volume_server_pb.VolumeServer/BatchDelete"
+	VolumeServer_VacuumVolumeCheck_FullMethodName              = "/volume_server_pb.VolumeServer/VacuumVolumeCheck"
+	VolumeServer_VacuumVolumeCompact_FullMethodName            = "/volume_server_pb.VolumeServer/VacuumVolumeCompact"
+	VolumeServer_VacuumVolumeCommit_FullMethodName             = "/volume_server_pb.VolumeServer/VacuumVolumeCommit"
+	VolumeServer_VacuumVolumeCleanup_FullMethodName            = "/volume_server_pb.VolumeServer/VacuumVolumeCleanup"
+	VolumeServer_DeleteCollection_FullMethodName               = "/volume_server_pb.VolumeServer/DeleteCollection"
+	VolumeServer_AllocateVolume_FullMethodName                 = "/volume_server_pb.VolumeServer/AllocateVolume"
+	VolumeServer_VolumeSyncStatus_FullMethodName               = "/volume_server_pb.VolumeServer/VolumeSyncStatus"
+	VolumeServer_VolumeIncrementalCopy_FullMethodName          = "/volume_server_pb.VolumeServer/VolumeIncrementalCopy"
+	VolumeServer_VolumeMount_FullMethodName                    = "/volume_server_pb.VolumeServer/VolumeMount"
+	VolumeServer_VolumeUnmount_FullMethodName                  = "/volume_server_pb.VolumeServer/VolumeUnmount"
+	VolumeServer_VolumeDelete_FullMethodName                   = "/volume_server_pb.VolumeServer/VolumeDelete"
+	VolumeServer_VolumeMarkReadonly_FullMethodName             = "/volume_server_pb.VolumeServer/VolumeMarkReadonly"
+	VolumeServer_VolumeMarkWritable_FullMethodName             = "/volume_server_pb.VolumeServer/VolumeMarkWritable"
+	VolumeServer_VolumeConfigure_FullMethodName                = "/volume_server_pb.VolumeServer/VolumeConfigure"
+	VolumeServer_VolumeStatus_FullMethodName                   = "/volume_server_pb.VolumeServer/VolumeStatus"
+	VolumeServer_VolumeCopy_FullMethodName                     = "/volume_server_pb.VolumeServer/VolumeCopy"
+	VolumeServer_ReadVolumeFileStatus_FullMethodName           = "/volume_server_pb.VolumeServer/ReadVolumeFileStatus"
+	VolumeServer_CopyFile_FullMethodName                       = "/volume_server_pb.VolumeServer/CopyFile"
+	VolumeServer_ReadNeedleBlob_FullMethodName                 = "/volume_server_pb.VolumeServer/ReadNeedleBlob"
+	VolumeServer_ReadNeedleMeta_FullMethodName                 = "/volume_server_pb.VolumeServer/ReadNeedleMeta"
+	VolumeServer_WriteNeedleBlob_FullMethodName                = "/volume_server_pb.VolumeServer/WriteNeedleBlob"
+	VolumeServer_ReadAllNeedles_FullMethodName                 = "/volume_server_pb.VolumeServer/ReadAllNeedles"
+	VolumeServer_VolumeTailSender_FullMethodName               = "/volume_server_pb.VolumeServer/VolumeTailSender"
+	VolumeServer_VolumeTailReceiver_FullMethodName             = "/volume_server_pb.VolumeServer/VolumeTailReceiver"
+	VolumeServer_VolumeEcShardsGenerate_FullMethodName         = "/volume_server_pb.VolumeServer/VolumeEcShardsGenerate"
+	VolumeServer_VolumeEcShardsRebuild_FullMethodName          = "/volume_server_pb.VolumeServer/VolumeEcShardsRebuild"
+	VolumeServer_VolumeEcShardsCopy_FullMethodName             = "/volume_server_pb.VolumeServer/VolumeEcShardsCopy"
+	VolumeServer_VolumeEcShardsDelete_FullMethodName           = "/volume_server_pb.VolumeServer/VolumeEcShardsDelete"
+	VolumeServer_VolumeEcShardsMount_FullMethodName            = "/volume_server_pb.VolumeServer/VolumeEcShardsMount"
+	VolumeServer_VolumeEcShardsUnmount_FullMethodName          = "/volume_server_pb.VolumeServer/VolumeEcShardsUnmount"
+	VolumeServer_VolumeEcShardRead_FullMethodName              = "/volume_server_pb.VolumeServer/VolumeEcShardRead"
+	VolumeServer_VolumeEcBlobDelete_FullMethodName             = "/volume_server_pb.VolumeServer/VolumeEcBlobDelete"
+	VolumeServer_VolumeEcShardsToVolume_FullMethodName         = "/volume_server_pb.VolumeServer/VolumeEcShardsToVolume"
+	VolumeServer_VolumeTierMoveDatToRemote_FullMethodName      = "/volume_server_pb.VolumeServer/VolumeTierMoveDatToRemote"
+	VolumeServer_VolumeTierMoveDatFromRemote_FullMethodName    = "/volume_server_pb.VolumeServer/VolumeTierMoveDatFromRemote"
+	VolumeServer_VolumeServerStatus_FullMethodName             = "/volume_server_pb.VolumeServer/VolumeServerStatus"
+	VolumeServer_VolumeServerEvents_FullMethodName             = "/volume_server_pb.VolumeServer/VolumeServerEvents"
+	VolumeServer_VolumeServerLeave_FullMethodName              = "/volume_server_pb.VolumeServer/VolumeServerLeave"
+	VolumeServer_FetchAndWriteNeedle_FullMethodName            = "/volume_server_pb.VolumeServer/FetchAndWriteNeedle"
+	VolumeServer_Query_FullMethodName                          = "/volume_server_pb.VolumeServer/Query"
+	VolumeServer_VolumeNeedleStatus_FullMethodName             = "/volume_server_pb.VolumeServer/VolumeNeedleStatus"
+	VolumeServer_VolumeScrubStatus_FullMethodName              = "/volume_server_pb.VolumeServer/VolumeScrubStatus"
+	VolumeServer_VolumeRotateEncryptionKeys_FullMethodName     = "/volume_server_pb.VolumeServer/VolumeRotateEncryptionKeys"
+	VolumeServer_VolumeAddCompressionDictionary_FullMethodName = "/volume_server_pb.VolumeServer/VolumeAddCompressionDictionary"
+	VolumeServer_VolumeCompressionDictionaries_FullMethodName  = "/volume_server_pb.VolumeServer/VolumeCompressionDictionaries"
+	VolumeServer_VolumeSnapshot_FullMethodName                 = "/volume_server_pb.VolumeServer/VolumeSnapshot"
+	VolumeServer_VolumeSnapshotList_FullMethodName             = "/volume_server_pb.VolumeServer/VolumeSnapshotList"
+	VolumeServer_VolumeSnapshotRestore_FullMethodName          = "/volume_server_pb.VolumeServer/VolumeSnapshotRestore"
+	VolumeServer_VolumeSnapshotDelete_FullMethodName           = "/volume_server_pb.VolumeServer/VolumeSnapshotDelete"
+	VolumeServer_Ping_FullMethodName                           = "/volume_server_pb.VolumeServer/Ping"
 )
 
 // VolumeServerClient is the client API for VolumeServer service.
@@ -116,6 +124,19 @@ type VolumeServerClient interface {
 	// <experimental> query
 	Query(ctx context.Context, in *QueryRequest, opts ...grpc.CallOption) (VolumeServer_QueryClient, error)
 	VolumeNeedleStatus(ctx context.Context, in *VolumeNeedleStatusRequest, opts ...grpc.CallOption) (*VolumeNeedleStatusResponse, error)
+	VolumeScrubStatus(ctx context.Context, in *VolumeScrubStatusRequest, opts ...grpc.CallOption) (*VolumeScrubStatusResponse, error)
+	// re-wrap volume data keys with the current master key of the keyring
+	VolumeRotateEncryptionKeys(ctx context.Context, in *VolumeRotateEncryptionKeysRequest, opts ...grpc.CallOption) (*VolumeRotateEncryptionKeysResponse, error)
+	// zstd dictionary to compress new needles of a collection
+	VolumeAddCompressionDictionary(ctx context.Context, in *VolumeAddCompressionDictionaryRequest, opts ...grpc.CallOption) (*VolumeAddCompressionDictionaryResponse, error)
+	// all zstd dictionaries of a collection, copied along with its volumes and ec shards
+	VolumeCompressionDictionaries(ctx context.Context, in *VolumeCompressionDictionariesRequest, opts ...grpc.CallOption) (*VolumeCompressionDictionariesResponse, error)
+	// point in time copy of a volume, taken while writes continue
+	VolumeSnapshot(ctx context.Context, in *VolumeSnapshotRequest, opts ...grpc.CallOption) (*VolumeSnapshotResponse, error)
+	VolumeSnapshotList(ctx context.Context, in *VolumeSnapshotListRequest, opts ...grpc.CallOption) (*VolumeSnapshotListResponse, error)
+	// mount a snapshot as a new volume
+	VolumeSnapshotRestore(ctx context.Context, in *VolumeSnapshotRestoreRequest, opts ...grpc.CallOption) (*VolumeSnapshotRestoreResponse, error)
+	VolumeSnapshotDelete(ctx context.Context, in *VolumeSnapshotDeleteRequest, opts ...grpc.CallOption) (*VolumeSnapshotDeleteResponse, error)
 	Ping(ctx context.Context, in *PingRequest, opts ...grpc.CallOption) (*PingResponse, error)
 }
 
@@ -758,6 +779,78 @@ func (c *volumeServerClient) VolumeNeedleStatus(ctx context.Context, in *VolumeN
 	return out, nil
 }
 
+func (c *volumeServerClient) VolumeScrubStatus(ctx context.Context, in *VolumeScrubStatusRequest, opts ...grpc.CallOption) (*VolumeScrubStatusResponse, error) {
+	out := new(VolumeScrubStatusResponse)
+	err := c.cc.Invoke(ctx, VolumeServer_VolumeScrubStatus_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *volumeServerClient) VolumeRotateEncryptionKeys(ctx context.Context, in *VolumeRotateEncryptionKeysRequest, opts ...grpc.CallOption) (*VolumeRotateEncryptionKeysResponse, error) {
+	out := new(VolumeRotateEncryptionKeysResponse)
+	err := c.cc.Invoke(ctx, VolumeServer_VolumeRotateEncryptionKeys_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *volumeServerClient) VolumeAddCompressionDictionary(ctx context.Context, in *VolumeAddCompressionDictionaryRequest, opts ...grpc.CallOption) (*VolumeAddCompressionDictionaryResponse, error) {
+	out := new(VolumeAddCompressionDictionaryResponse)
+	err := c.cc.Invoke(ctx, VolumeServer_VolumeAddCompressionDictionary_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *volumeServerClient) VolumeCompressionDictionaries(ctx context.Context, in *VolumeCompressionDictionariesRequest, opts ...grpc.CallOption) (*VolumeCompressionDictionariesResponse, error) {
+	out := new(VolumeCompressionDictionariesResponse)
+	err := c.cc.Invoke(ctx, VolumeServer_VolumeCompressionDictionaries_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *volumeServerClient) VolumeSnapshot(ctx context.Context, in *VolumeSnapshotRequest, opts ...grpc.CallOption) (*VolumeSnapshotResponse, error) {
+	out := new(VolumeSnapshotResponse)
+	err := c.cc.Invoke(ctx, VolumeServer_VolumeSnapshot_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *volumeServerClient) VolumeSnapshotList(ctx context.Context, in *VolumeSnapshotListRequest, opts ...grpc.CallOption) (*VolumeSnapshotListResponse, error) {
+	out := new(VolumeSnapshotListResponse)
+	err := c.cc.Invoke(ctx, VolumeServer_VolumeSnapshotList_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *volumeServerClient) VolumeSnapshotRestore(ctx context.Context, in *VolumeSnapshotRestoreRequest, opts ...grpc.CallOption) (*VolumeSnapshotRestoreResponse, error) {
+	out := new(VolumeSnapshotRestoreResponse)
+	err := c.cc.Invoke(ctx, VolumeServer_VolumeSnapshotRestore_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *volumeServerClient) VolumeSnapshotDelete(ctx context.Context, in *VolumeSnapshotDeleteRequest, opts ...grpc.CallOption) (*VolumeSnapshotDeleteResponse, error) {
+	out := new(VolumeSnapshotDeleteResponse)
+	err := c.cc.Invoke(ctx, VolumeServer_VolumeSnapshotDelete_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *volumeServerClient) Ping(ctx context.Context, in *PingRequest, opts ...grpc.CallOption) (*PingResponse, error) {
 	out := new(PingResponse)
 	err := c.cc.Invoke(ctx, VolumeServer_Ping_FullMethodName, in, out, opts...)
@@ -819,6 +912,19 @@ type VolumeServerServer interface {
 	// <experimental> query
 	Query(*QueryRequest, VolumeServer_QueryServer) error
 	VolumeNeedleStatus(context.Context, *VolumeNeedleStatusRequest) (*VolumeNeedleStatusResponse, error)
+	VolumeScrubStatus(context.Context, *VolumeScrubStatusRequest) (*VolumeScrubStatusResponse, error)
+	// re-wrap volume data keys with the current master key of the keyring
+	VolumeRotateEncryptionKeys(context.Context, *VolumeRotateEncryptionKeysRequest) (*VolumeRotateEncryptionKeysResponse, error)
+	// zstd dictionary to compress new needles of a collection
+	VolumeAddCompressionDictionary(context.Context, *VolumeAddCompressionDictionaryRequest) (*VolumeAddCompressionDictionaryResponse, error)
+	// all zstd dictionaries of a collection, copied along with its volumes and ec shards
+	VolumeCompressionDictionaries(context.Context, *VolumeCompressionDictionariesRequest) (*VolumeCompressionDictionariesResponse, error)
+	// point in time copy of a volume, taken while writes continue
+	VolumeSnapshot(context.Context, *VolumeSnapshotRequest) (*VolumeSnapshotResponse, error)
+	VolumeSnapshotList(context.Context, *VolumeSnapshotListRequest) (*VolumeSnapshotListResponse, error)
+	// mount a snapshot as a new volume
+	VolumeSnapshotRestore(context.Context, *VolumeSnapshotRestoreRequest) (*VolumeSnapshotRestoreResponse, error)
+	VolumeSnapshotDelete(context.Context, *VolumeSnapshotDeleteRequest) (*VolumeSnapshotDeleteResponse, error)
 	Ping(context.Context, *PingRequest) (*PingResponse, error)
 	mustEmbedUnimplementedVolumeServerServer()
 }
@@ -953,6 +1059,30 @@ func (UnimplementedVolumeServerServer) Query(*QueryRequest, VolumeServer_QuerySe
 func (UnimplementedVolumeServerServer) VolumeNeedleStatus(context.Context, *VolumeNeedleStatusRequest) (*VolumeNeedleStatusResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method VolumeNeedleStatus not implemented")
 }
+func (UnimplementedVolumeServerServer) VolumeScrubStatus(context.Context, *VolumeScrubStatusRequest) (*VolumeScrubStatusResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method VolumeScrubStatus not implemented")
+}
+func (UnimplementedVolumeServerServer) VolumeRotateEncryptionKeys(context.Context, *VolumeRotateEncryptionKeysRequest) (*VolumeRotateEncryptionKeysResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method VolumeRotateEncryptionKeys not implemented")
+}
+func (UnimplementedVolumeServerServer) VolumeAddCompressionDictionary(context.Context, *VolumeAddCompressionDictionaryRequest) (*VolumeAddCompressionDictionaryResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method VolumeAddCompressionDictionary not implemented")
+}
+func (UnimplementedVolumeServerServer) VolumeCompressionDictionaries(context.Context, *VolumeCompressionDictionariesRequest) (*VolumeCompressionDictionariesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method VolumeCompressionDictionaries not implemented")
+}
+func (UnimplementedVolumeServerServer) VolumeSnapshot(context.Context, *VolumeSnapshotRequest) (*VolumeSnapshotResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method VolumeSnapshot not implemented")
+}
+func (UnimplementedVolumeServerServer) VolumeSnapshotList(context.Context, *VolumeSnapshotListRequest) (*VolumeSnapshotListResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method VolumeSnapshotList not implemented")
+}
+func (UnimplementedVolumeServerServer) VolumeSnapshotRestore(context.Context, *VolumeSnapshotRestoreRequest) (*VolumeSnapshotRestoreResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method VolumeSnapshotRestore not implemented")
+}
+func (UnimplementedVolumeServerServer) VolumeSnapshotDelete(context.Context, *VolumeSnapshotDeleteRequest) (*VolumeSnapshotDeleteResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method VolumeSnapshotDelete not implemented")
+}
 func (UnimplementedVolumeServerServer) Ping(context.Context, *PingRequest) (*PingResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method Ping not implemented")
 }
@@ -1758,6 +1888,150 @@ func _VolumeServer_VolumeNeedleStatus_Handler(srv interface{}, ctx context.Conte
 	return interceptor(ctx, in, info, handler)
 }
 
+func _VolumeServer_VolumeScrubStatus_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(VolumeScrubStatusRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(VolumeServerServer).VolumeScrubStatus(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: VolumeServer_VolumeScrubStatus_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(VolumeServerServer).VolumeScrubStatus(ctx, req.(*VolumeScrubStatusRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _VolumeServer_VolumeRotateEncryptionKeys_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(VolumeRotateEncryptionKeysRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(VolumeServerServer).VolumeRotateEncryptionKeys(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: VolumeServer_VolumeRotateEncryptionKeys_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(VolumeServerServer).VolumeRotateEncryptionKeys(ctx, req.(*VolumeRotateEncryptionKeysRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _VolumeServer_VolumeAddCompressionDictionary_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(VolumeAddCompressionDictionaryRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(VolumeServerServer).VolumeAddCompressionDictionary(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: VolumeServer_VolumeAddCompressionDictionary_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(VolumeServerServer).VolumeAddCompressionDictionary(ctx, req.(*VolumeAddCompressionDictionaryRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _VolumeServer_VolumeCompressionDictionaries_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(VolumeCompressionDictionariesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(VolumeServerServer).VolumeCompressionDictionaries(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: VolumeServer_VolumeCompressionDictionaries_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(VolumeServerServer).VolumeCompressionDictionaries(ctx, req.(*VolumeCompressionDictionariesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _VolumeServer_VolumeSnapshot_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(VolumeSnapshotRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(VolumeServerServer).VolumeSnapshot(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: VolumeServer_VolumeSnapshot_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(VolumeServerServer).VolumeSnapshot(ctx, req.(*VolumeSnapshotRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _VolumeServer_VolumeSnapshotList_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(VolumeSnapshotListRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(VolumeServerServer).VolumeSnapshotList(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: VolumeServer_VolumeSnapshotList_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(VolumeServerServer).VolumeSnapshotList(ctx, req.(*VolumeSnapshotListRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _VolumeServer_VolumeSnapshotRestore_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(VolumeSnapshotRestoreRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(VolumeServerServer).VolumeSnapshotRestore(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: VolumeServer_VolumeSnapshotRestore_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(VolumeServerServer).VolumeSnapshotRestore(ctx, req.(*VolumeSnapshotRestoreRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _VolumeServer_VolumeSnapshotDelete_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(VolumeSnapshotDeleteRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(VolumeServerServer).VolumeSnapshotDelete(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: VolumeServer_VolumeSnapshotDelete_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(VolumeServerServer).VolumeSnapshotDelete(ctx, req.(*VolumeSnapshotDeleteRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _VolumeServer_Ping_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(PingRequest)
 	if err := dec(in); err != nil {
@@ -1907,6 +2181,38 @@ var VolumeServer_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "VolumeNeedleStatus",
 			Handler:    _VolumeServer_VolumeNeedleStatus_Handler,
 		},
+		{
+			MethodName: "VolumeScrubStatus",
+			Handler:    _VolumeServer_VolumeScrubStatus_Handler,
+		},
+		{
+			MethodName: "VolumeRotateEncryptionKeys",
+			Handler:    _VolumeServer_VolumeRotateEncryptionKeys_Handler,
+		},
+		{
+			MethodName: "VolumeAddCompressionDictionary",
+			Handler:    _VolumeServer_VolumeAddCompressionDictionary_Handler,
+		},
+		{
+			MethodName: "VolumeCompressionDictionaries",
+			Handler:    _VolumeServer_VolumeCompressionDictionaries_Handler,
+		},
+		{
+			MethodName: "VolumeSnapshot",
+			Handler:    _VolumeServer_VolumeSnapshot_Handler,
+		},
+		{
+			MethodName: "VolumeSnapshotList",
+			Handler:    _VolumeServer_VolumeSnapshotList_Handler,
+		},
+		{
+			MethodName: "VolumeSnapshotRestore",
+			Handler:    _VolumeServer_VolumeSnapshotRestore_Handler,
+		},
+		{
+			MethodName: "VolumeSnapshotDelete",
+			Handler:    _VolumeServer_VolumeSnapshotDelete_Handler,
+		},
 		{
 			MethodName: "Ping",
 			Handler:    _VolumeServer_Ping_Handler,
@@ -14,6 +14,7 @@ import (
 	"github.com/gateway-dao/seaweedfs/weed/s3api/s3err"
 	"github.com/gateway-dao/seaweedfs/weed/security"
 
+	"github.com/gateway-dao/seaweedfs/weed/filer"
 	"github.com/gateway-dao/seaweedfs/weed/glog"
 	"github.com/gateway-dao/seaweedfs/weed/pb/filer_pb"
 	weed_server "github.com/gateway-dao/seaweedfs/weed/server"
@@ -181,6 +182,8 @@ func filerErrorToS3Error(errString string) s3err.ErrorCode {
 		return s3err.ErrExistingObjectIsDirectory
 	case strings.HasSuffix(errString, "is a file"):
 		return s3err.ErrExistingObjectIsFile
+	case strings.Contains(errString, filer.MsgQuotaExceeded):
+		return s3err.ErrQuotaExceeded
 	default:
 		return s3err.ErrInternalError
 	}
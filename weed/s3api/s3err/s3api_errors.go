@@ -104,6 +104,7 @@ const (
 
 	ErrExistingObjectIsDirectory
 	ErrExistingObjectIsFile
+	ErrQuotaExceeded
 
 	ErrTooManyRequest
 	ErrRequestBytesExceed
@@ -406,6 +407,11 @@ var errorCodeResponse = map[ErrorCode]APIError{
 		Description:    "Existing Object is a file.",
 		HTTPStatusCode: http.StatusConflict,
 	},
+	ErrQuotaExceeded: {
+		Code:           "QuotaExceeded",
+		Description:    "The directory quota is exceeded.",
+		HTTPStatusCode: http.StatusForbidden,
+	},
 	ErrTooManyRequest: {
 		Code:           "ErrTooManyRequest",
 		Description:    "Too many simultaneous request count",
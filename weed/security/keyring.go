@@ -0,0 +1,157 @@
+package security
+
+import (
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"os"
+	"strings"
+	"sync"
+
+	"github.com/gateway-dao/seaweedfs/weed/util"
+)
+
+/*
+KeyWrapper protects the per volume data keys used to encrypt needles at rest.
+
+A data key is never written to disk in plain text. It is wrapped by a master
+key, and the wrapped bytes plus the id of the master key are kept in the
+volume's .vif file. Rotating the master key only re-wraps the data keys;
+the needle data itself is not rewritten.
+
+The master keys come either from a local keyring file, or from a KMS
+registered with RegisterKms and addressed as "<scheme>://...".
+
+The keyring file is a json document:
+
+	{
+	  "primary": "k2",
+	  "keys": {
+	    "k1": "<base64 encoded 32 byte key>",
+	    "k2": "<base64 encoded 32 byte key>"
+	  }
+	}
+
+New data keys are wrapped with the primary key. Older keys are kept so that
+data keys wrapped before a rotation can still be unwrapped.
+*/
+type KeyWrapper interface {
+	// WrapKey encrypts the data key with the current master key.
+	WrapKey(dataKey []byte) (keyId string, wrapped []byte, err error)
+	// UnwrapKey decrypts a data key wrapped by the master key keyId.
+	UnwrapKey(keyId string, wrapped []byte) (dataKey []byte, err error)
+	// CurrentKeyId is the id of the master key used by WrapKey.
+	CurrentKeyId() string
+	// Reload picks up new master keys, e.g. after a rotation.
+	Reload() error
+}
+
+type KmsFactory func(location string) (KeyWrapper, error)
+
+var (
+	kmsFactories   = make(map[string]KmsFactory)
+	kmsFactoriesMu sync.Mutex
+)
+
+// RegisterKms makes a KMS available as "<scheme>://..." key wrapper location.
+func RegisterKms(scheme string, factory KmsFactory) {
+	kmsFactoriesMu.Lock()
+	defer kmsFactoriesMu.Unlock()
+	kmsFactories[scheme] = factory
+}
+
+// NewKeyWrapper returns nil if location is empty, a registered KMS if the
+// location has a scheme, or a local keyring file otherwise.
+func NewKeyWrapper(location string) (KeyWrapper, error) {
+	if location == "" {
+		return nil, nil
+	}
+	if scheme, _, found := strings.Cut(location, "://"); found {
+		kmsFactoriesMu.Lock()
+		factory, ok := kmsFactories[scheme]
+		kmsFactoriesMu.Unlock()
+		if !ok {
+			return nil, fmt.Errorf("unknown kms %q", scheme)
+		}
+		return factory(location)
+	}
+	return LoadKeyring(location)
+}
+
+type keyringFile struct {
+	Primary string            `json:"primary"`
+	Keys    map[string]string `json:"keys"`
+}
+
+type Keyring struct {
+	path    string
+	primary string
+	keys    map[string]util.CipherKey
+	mu      sync.RWMutex
+}
+
+func LoadKeyring(path string) (*Keyring, error) {
+	k := &Keyring{path: path}
+	if err := k.Reload(); err != nil {
+		return nil, err
+	}
+	return k, nil
+}
+
+func (k *Keyring) Reload() error {
+	data, err := os.ReadFile(k.path)
+	if err != nil {
+		return fmt.Errorf("read keyring %s: %v", k.path, err)
+	}
+	var f keyringFile
+	if err = json.Unmarshal(data, &f); err != nil {
+		return fmt.Errorf("parse keyring %s: %v", k.path, err)
+	}
+	keys := make(map[string]util.CipherKey, len(f.Keys))
+	for id, encoded := range f.Keys {
+		key, decodeErr := base64.StdEncoding.DecodeString(encoded)
+		if decodeErr != nil {
+			return fmt.Errorf("keyring %s key %s: %v", k.path, id, decodeErr)
+		}
+		if len(key) != 32 {
+			return fmt.Errorf("keyring %s key %s: expected 32 bytes, got %d", k.path, id, len(key))
+		}
+		keys[id] = key
+	}
+	if _, found := keys[f.Primary]; !found {
+		return fmt.Errorf("keyring %s: primary key %q not found", k.path, f.Primary)
+	}
+
+	k.mu.Lock()
+	k.primary, k.keys = f.Primary, keys
+	k.mu.Unlock()
+	return nil
+}
+
+func (k *Keyring) CurrentKeyId() string {
+	k.mu.RLock()
+	defer k.mu.RUnlock()
+	return k.primary
+}
+
+func (k *Keyring) WrapKey(dataKey []byte) (keyId string, wrapped []byte, err error) {
+	k.mu.RLock()
+	keyId, masterKey := k.primary, k.keys[k.primary]
+	k.mu.RUnlock()
+	wrapped, err = util.Encrypt(dataKey, masterKey)
+	return
+}
+
+func (k *Keyring) UnwrapKey(keyId string, wrapped []byte) ([]byte, error) {
+	k.mu.RLock()
+	masterKey, found := k.keys[keyId]
+	k.mu.RUnlock()
+	if !found {
+		return nil, fmt.Errorf("master key %q not in keyring %s", keyId, k.path)
+	}
+	dataKey, err := util.Decrypt(wrapped, masterKey)
+	if err != nil {
+		return nil, fmt.Errorf("unwrap data key with %q: %v", keyId, err)
+	}
+	return dataKey, nil
+}
@@ -0,0 +1,74 @@
+package sequence
+
+import (
+	"fmt"
+	"sync"
+
+	"github.com/gateway-dao/seaweedfs/weed/glog"
+)
+
+const DefaultRaftSequencerRangeSize = 10000
+
+// ReserveFunc reserves count file ids through the raft log, none of them below minFileId.
+// The reserved range starts at the returned file id, and is never reserved again, even by another leader.
+type ReserveFunc func(minFileId, count uint64) (start uint64, err error)
+
+// RaftSequencer hands out file ids from ranges reserved in the raft log.
+// Since the ranges are committed before use, a new leader never hands out a file id handed out by a previous one,
+// without waiting for the volume servers to report their max file keys.
+// Ranges are reserved in batches of rangeSize to reduce the raft traffic.
+type RaftSequencer struct {
+	counter      uint64 // the next file id to hand out
+	rangeEnd     uint64 // the file ids below rangeEnd, and from counter, are reserved for this sequencer
+	minFileId    uint64 // the next range must start above the file ids seen on the volume servers
+	rangeSize    uint64
+	reserve      ReserveFunc
+	sequenceLock sync.Mutex
+}
+
+func NewRaftSequencer(rangeSize uint64, reserve ReserveFunc) *RaftSequencer {
+	if rangeSize == 0 {
+		rangeSize = DefaultRaftSequencerRangeSize
+	}
+	return &RaftSequencer{
+		minFileId: 1,
+		rangeSize: rangeSize,
+		reserve:   reserve,
+	}
+}
+
+func (m *RaftSequencer) NextFileId(count uint64) (uint64, error) {
+	m.sequenceLock.Lock()
+	defer m.sequenceLock.Unlock()
+
+	if m.counter+count > m.rangeEnd {
+		reserveCount := m.rangeSize
+		if count > reserveCount {
+			reserveCount = count
+		}
+		start, err := m.reserve(m.minFileId, reserveCount)
+		if err != nil {
+			return 0, fmt.Errorf("reserve %d file ids: %v", reserveCount, err)
+		}
+		glog.V(1).Infof("reserved file ids [%d,%d)", start, start+reserveCount)
+		// the rest of the previous range is dropped, the ranges are not contiguous after a leader change
+		m.counter, m.rangeEnd = start, start+reserveCount
+	}
+
+	ret := m.counter
+	m.counter += count
+	return ret, nil
+}
+
+// SetMax makes sure the file ids handed out later are above the file ids seen on the volume servers.
+func (m *RaftSequencer) SetMax(seenValue uint64) {
+	m.sequenceLock.Lock()
+	defer m.sequenceLock.Unlock()
+	if m.minFileId <= seenValue {
+		m.minFileId = seenValue + 1
+	}
+	if m.counter <= seenValue {
+		// drop the current range, it overlaps with file ids not from the raft log
+		m.counter, m.rangeEnd = 0, 0
+	}
+}
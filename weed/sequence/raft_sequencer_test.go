@@ -0,0 +1,53 @@
+package sequence
+
+import (
+	"fmt"
+	"testing"
+)
+
+func TestRaftSequencer(t *testing.T) {
+	var maxFileId uint64
+	var reserveCount int
+	reserve := func(minFileId, count uint64) (uint64, error) {
+		reserveCount++
+		start := maxFileId
+		if start < minFileId {
+			start = minFileId
+		}
+		maxFileId = start + count
+		return start, nil
+	}
+
+	seq := NewRaftSequencer(100, reserve)
+	for i := uint64(1); i <= 250; i++ {
+		if id, err := seq.NextFileId(1); err != nil || id != i {
+			t.Fatalf("file id %d, expected %d: %v", id, i, err)
+		}
+	}
+	if reserveCount != 3 {
+		t.Fatalf("reserved %d times, expected 3", reserveCount)
+	}
+
+	// a large request gets its own range
+	if id, _ := seq.NextFileId(1000); id != 301 {
+		t.Fatalf("file id %d, expected 301", id)
+	}
+
+	// the file ids seen on the volume servers are never handed out
+	seq.SetMax(5000)
+	if id, _ := seq.NextFileId(1); id != 5001 {
+		t.Fatalf("file id %d, expected 5001", id)
+	}
+	seq.SetMax(10)
+	if id, _ := seq.NextFileId(1); id != 5002 {
+		t.Fatalf("file id %d, expected 5002", id)
+	}
+
+	// no file id is handed out if the range can not be reserved
+	failing := NewRaftSequencer(100, func(minFileId, count uint64) (uint64, error) {
+		return 0, fmt.Errorf("not the leader")
+	})
+	if _, err := failing.NextFileId(1); err == nil {
+		t.Fatalf("file id without a reserved range")
+	}
+}
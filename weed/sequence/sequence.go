@@ -1,6 +1,6 @@
 package sequence
 
 type Sequencer interface {
-	NextFileId(count uint64) uint64
+	NextFileId(count uint64) (uint64, error)
 	SetMax(uint64)
 }
@@ -13,7 +13,7 @@ func TestSequencer(t *testing.T) {
 	last := uint64(0)
 	bytes := make([]byte, types.NeedleIdSize)
 	for i := 0; i < 100; i++ {
-		next := seq.NextFileId(1)
+		next, _ := seq.NextFileId(1)
 		types.NeedleIdToBytes(bytes, types.NeedleId(next))
 		println(hex.EncodeToString(bytes))
 		if last == next {
@@ -135,7 +135,7 @@ func submitForClientHandler(w http.ResponseWriter, r *http.Request, masterFn ope
 	debug("parsing upload file...")
 	bytesBuffer := bufPool.Get().(*bytes.Buffer)
 	defer bufPool.Put(bytesBuffer)
-	pu, pe := needle.ParseUpload(r, 256*1024*1024, bytesBuffer)
+	pu, pe := needle.ParseUpload(r, 256*1024*1024, bytesBuffer, nil)
 	if pe != nil {
 		writeJsonError(w, r, http.StatusBadRequest, pe)
 		return
@@ -172,6 +172,9 @@ func (fs *FilerServer) UpdateEntry(ctx context.Context, req *filer_pb.UpdateEntr
 	glog.V(4).Infof("UpdateEntry %v", req)
 
 	fullpath := util.Join(req.Directory, req.Entry.Name)
+	if err := filer.CheckSnapshotWrite(util.FullPath(fullpath), req.IsFromOtherCluster); err != nil {
+		return &filer_pb.UpdateEntryResponse{}, err
+	}
 	entry, err := fs.filer.FindEntry(ctx, util.FullPath(fullpath))
 	if err != nil {
 		return &filer_pb.UpdateEntryResponse{}, fmt.Errorf("not found %s: %v", fullpath, err)
@@ -189,12 +192,22 @@ func (fs *FilerServer) UpdateEntry(ctx context.Context, req *filer_pb.UpdateEntr
 		return &filer_pb.UpdateEntryResponse{}, err
 	}
 
+	if !req.IsFromOtherCluster {
+		if ctx, err = fs.filer.ReserveUpdateQuota(ctx, entry, newEntry); err != nil {
+			return &filer_pb.UpdateEntryResponse{}, err
+		}
+	}
+
+	keepsVersion := fs.filer.KeepsVersion(entry, newEntry)
 	if err = fs.filer.UpdateEntry(ctx, entry, newEntry); err == nil {
-		fs.filer.DeleteChunksNotRecursive(garbage)
+		if !keepsVersion {
+			fs.filer.DeleteChunksNotRecursive(garbage)
+		}
 
 		fs.filer.NotifyUpdateEvent(ctx, entry, newEntry, true, req.IsFromOtherCluster, req.Signatures)
 
 	} else {
+		fs.filer.ReleaseQuota(ctx)
 		glog.V(3).Infof("UpdateEntry %s: %v", filepath.Join(req.Directory, req.Entry.Name), err)
 	}
 
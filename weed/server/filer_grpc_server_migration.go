@@ -0,0 +1,19 @@
+package weed_server
+
+import (
+	"context"
+
+	"github.com/gateway-dao/seaweedfs/weed/pb/filer_pb"
+)
+
+func (fs *FilerServer) MigrateStore(ctx context.Context, req *filer_pb.MigrateStoreRequest) (*filer_pb.MigrateStoreResponse, error) {
+
+	status, err := fs.filer.MigrateStore(req.Action, req.SampleRate, fs.option.Host)
+	if err != nil {
+		return nil, err
+	}
+
+	return &filer_pb.MigrateStoreResponse{
+		Status: status,
+	}, nil
+}
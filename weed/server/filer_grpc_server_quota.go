@@ -0,0 +1,55 @@
+package weed_server
+
+import (
+	"context"
+
+	"github.com/gateway-dao/seaweedfs/weed/pb/filer_pb"
+	"github.com/gateway-dao/seaweedfs/weed/util"
+)
+
+func (fs *FilerServer) SetDirectoryQuota(ctx context.Context, req *filer_pb.SetDirectoryQuotaRequest) (*filer_pb.SetDirectoryQuotaResponse, error) {
+
+	quota, err := fs.filer.SetDirectoryQuota(ctx, util.FullPath(req.Directory), req.MaxBytes, req.MaxEntries)
+	if err != nil {
+		return nil, err
+	}
+
+	return &filer_pb.SetDirectoryQuotaResponse{
+		Quota: quota,
+	}, nil
+}
+
+func (fs *FilerServer) ListDirectoryQuotas(ctx context.Context, req *filer_pb.ListDirectoryQuotasRequest) (*filer_pb.ListDirectoryQuotasResponse, error) {
+
+	quotas, err := fs.filer.ListDirectoryQuotas(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	return &filer_pb.ListDirectoryQuotasResponse{
+		Quotas: quotas,
+	}, nil
+}
+
+func (fs *FilerServer) RecalculateDirectoryQuotas(ctx context.Context, req *filer_pb.RecalculateDirectoryQuotasRequest) (*filer_pb.RecalculateDirectoryQuotasResponse, error) {
+
+	quotas, err := fs.filer.RecalculateDirectoryQuotas(ctx, util.FullPath(req.Directory), req.IsMoved)
+	if err != nil {
+		return nil, err
+	}
+
+	return &filer_pb.RecalculateDirectoryQuotasResponse{
+		Quotas: quotas,
+	}, nil
+}
+
+// UpdateDirectoryQuotaUsage changes the usage owned by this filer for another filer.
+func (fs *FilerServer) UpdateDirectoryQuotaUsage(ctx context.Context, req *filer_pb.UpdateDirectoryQuotaUsageRequest) (*filer_pb.UpdateDirectoryQuotaUsageResponse, error) {
+
+	resp := &filer_pb.UpdateDirectoryQuotaUsageResponse{}
+	if err := fs.filer.UpdateLocalQuotaUsage(ctx, util.FullPath(req.Directory), util.FullPath(req.Path), req.DeltaBytes, req.DeltaEntries, req.CheckLimits); err != nil {
+		resp.Error = err.Error()
+	}
+
+	return resp, nil
+}
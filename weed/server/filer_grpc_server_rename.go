@@ -34,6 +34,11 @@ func (fs *FilerServer) AtomicRenameEntry(ctx context.Context, req *filer_pb.Atom
 		return nil, fmt.Errorf("%s/%s not found: %v", req.OldDirectory, req.OldName, err)
 	}
 
+	if err = fs.filer.CheckMoveQuota(ctx, oldEntry.FullPath, newParent.Child(req.NewName)); err != nil {
+		fs.filer.RollbackTransaction(ctx)
+		return nil, err
+	}
+
 	moveErr := fs.moveEntry(ctx, nil, oldParent, oldEntry, newParent, req.NewName, req.Signatures)
 	if moveErr != nil {
 		fs.filer.RollbackTransaction(ctx)
@@ -72,6 +77,11 @@ func (fs *FilerServer) StreamRenameEntry(req *filer_pb.StreamRenameEntryRequest,
 		return fmt.Errorf("%s/%s not found: %v", req.OldDirectory, req.OldName, err)
 	}
 
+	if err = fs.filer.CheckMoveQuota(ctx, oldEntry.FullPath, newParent.Child(req.NewName)); err != nil {
+		fs.filer.RollbackTransaction(ctx)
+		return err
+	}
+
 	if oldEntry.IsDirectory() {
 		// follow https://pubs.opengroup.org/onlinepubs/000095399/functions/rename.html
 		targetDir := newParent.Child(req.NewName)
@@ -161,6 +171,9 @@ func (fs *FilerServer) moveSelfEntry(ctx context.Context, stream filer_pb.Seawee
 		return nil
 	}
 
+	// the quotas are checked before moving
+	ctx = context.WithValue(ctx, "OP", "MV")
+
 	// add to new directory
 	newEntry := &filer.Entry{
 		FullPath:        newPath,
@@ -176,6 +189,12 @@ func (fs *FilerServer) moveSelfEntry(ctx context.Context, stream filer_pb.Seawee
 	if createErr := fs.filer.CreateEntry(ctx, newEntry, false, false, signatures, false, fs.filer.MaxFilenameLength); createErr != nil {
 		return createErr
 	}
+	if !entry.IsDirectory() {
+		// the versions may share chunks with the file
+		if moveErr := fs.filer.MoveVersions(ctx, oldPath, newPath); moveErr != nil {
+			return moveErr
+		}
+	}
 	if stream != nil {
 		if err := stream.Send(&filer_pb.StreamRenameEntryResponse{
 			Directory: string(oldParent),
@@ -202,7 +221,6 @@ func (fs *FilerServer) moveSelfEntry(ctx context.Context, stream filer_pb.Seawee
 	}
 
 	// delete old entry
-	ctx = context.WithValue(ctx, "OP", "MV")
 	deleteErr := fs.filer.DeleteEntryMetaAndData(ctx, oldPath, false, false, false, false, signatures)
 	if deleteErr != nil {
 		return deleteErr
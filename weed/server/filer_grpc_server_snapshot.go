@@ -0,0 +1,56 @@
+package weed_server
+
+import (
+	"context"
+
+	"github.com/gateway-dao/seaweedfs/weed/pb/filer_pb"
+	"github.com/gateway-dao/seaweedfs/weed/util"
+)
+
+func (fs *FilerServer) CreateSnapshot(ctx context.Context, req *filer_pb.CreateSnapshotRequest) (*filer_pb.CreateSnapshotResponse, error) {
+
+	snapshot, err := fs.filer.CreateSnapshot(ctx, req.Name, util.FullPath(req.Directory))
+	if err != nil {
+		return nil, err
+	}
+
+	return &filer_pb.CreateSnapshotResponse{
+		Snapshot: snapshot,
+	}, nil
+}
+
+func (fs *FilerServer) ListSnapshots(ctx context.Context, req *filer_pb.ListSnapshotsRequest) (*filer_pb.ListSnapshotsResponse, error) {
+
+	snapshots, err := fs.filer.ListSnapshots(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	return &filer_pb.ListSnapshotsResponse{
+		Snapshots: snapshots,
+	}, nil
+}
+
+func (fs *FilerServer) DeleteSnapshot(ctx context.Context, req *filer_pb.DeleteSnapshotRequest) (*filer_pb.DeleteSnapshotResponse, error) {
+
+	freedChunkCount, err := fs.filer.DeleteSnapshot(ctx, req.Name)
+	if err != nil {
+		return nil, err
+	}
+
+	return &filer_pb.DeleteSnapshotResponse{
+		FreedChunkCount: freedChunkCount,
+	}, nil
+}
+
+func (fs *FilerServer) RestoreSnapshot(ctx context.Context, req *filer_pb.RestoreSnapshotRequest) (*filer_pb.RestoreSnapshotResponse, error) {
+
+	entryCount, err := fs.filer.RestoreSnapshot(ctx, req.Name, util.FullPath(req.Directory))
+	if err != nil {
+		return nil, err
+	}
+
+	return &filer_pb.RestoreSnapshotResponse{
+		EntryCount: entryCount,
+	}, nil
+}
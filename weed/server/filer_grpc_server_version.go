@@ -0,0 +1,48 @@
+package weed_server
+
+import (
+	"context"
+
+	"github.com/gateway-dao/seaweedfs/weed/filer"
+	"github.com/gateway-dao/seaweedfs/weed/pb/filer_pb"
+	"github.com/gateway-dao/seaweedfs/weed/util"
+)
+
+func (fs *FilerServer) ListEntryVersions(ctx context.Context, req *filer_pb.ListEntryVersionsRequest) (*filer_pb.ListEntryVersionsResponse, error) {
+
+	versions, err := fs.filer.ListVersions(ctx, util.NewFullPath(req.Directory, req.Name))
+	if err != nil {
+		return nil, err
+	}
+
+	resp := &filer_pb.ListEntryVersionsResponse{}
+	for _, version := range versions {
+		resp.Versions = append(resp.Versions, &filer_pb.EntryVersion{
+			VersionId:      version.Name(),
+			Entry:          version.ToProtoEntry(),
+			IsDeleteMarker: filer.IsDeleteMarker(version),
+		})
+	}
+	return resp, nil
+}
+
+func (fs *FilerServer) RestoreEntryVersion(ctx context.Context, req *filer_pb.RestoreEntryVersionRequest) (*filer_pb.RestoreEntryVersionResponse, error) {
+
+	entry, err := fs.filer.RestoreVersion(ctx, util.NewFullPath(req.Directory, req.Name), req.VersionId)
+	if err != nil {
+		return nil, err
+	}
+
+	return &filer_pb.RestoreEntryVersionResponse{
+		Entry: entry.ToProtoEntry(),
+	}, nil
+}
+
+func (fs *FilerServer) DeleteEntryVersion(ctx context.Context, req *filer_pb.DeleteEntryVersionRequest) (*filer_pb.DeleteEntryVersionResponse, error) {
+
+	if err := fs.filer.DeleteVersion(ctx, util.NewFullPath(req.Directory, req.Name), req.VersionId); err != nil {
+		return nil, err
+	}
+
+	return &filer_pb.DeleteEntryVersionResponse{}, nil
+}
@@ -34,6 +34,7 @@ import (
 	_ "github.com/gateway-dao/seaweedfs/weed/filer/mongodb"
 	_ "github.com/gateway-dao/seaweedfs/weed/filer/mysql"
 	_ "github.com/gateway-dao/seaweedfs/weed/filer/mysql2"
+	_ "github.com/gateway-dao/seaweedfs/weed/filer/pebble"
 	_ "github.com/gateway-dao/seaweedfs/weed/filer/postgres"
 	_ "github.com/gateway-dao/seaweedfs/weed/filer/postgres2"
 	_ "github.com/gateway-dao/seaweedfs/weed/filer/redis"
@@ -209,6 +210,13 @@ func NewFilerServer(defaultMux, readonlyMux *http.ServeMux, option *FilerOption)
 
 	fs.filer.LoadRemoteStorageConfAndMapping()
 
+	fs.filer.LoadSnapshots()
+	fs.filer.LoadDirectoryQuotas()
+	go fs.filer.LoopPurgeTrash()
+	go fs.filer.LoopPruneVersions()
+	go fs.filer.LoopRefreshStoreMigration(fs.option.Host)
+	fs.filer.ResumeStoreMigration(fs.option.Host)
+
 	grace.OnInterrupt(func() {
 		fs.filer.Shutdown()
 	})
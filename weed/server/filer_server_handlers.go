@@ -83,6 +83,8 @@ func (fs *FilerServer) filerHandler(w http.ResponseWriter, r *http.Request) {
 	case http.MethodDelete:
 		if _, ok := r.URL.Query()["tagging"]; ok {
 			fs.DeleteTaggingHandler(w, r)
+		} else if r.URL.Query().Get("versionId") != "" {
+			fs.DeleteVersionHandler(w, r)
 		} else {
 			fs.DeleteHandler(w, r)
 		}
@@ -103,7 +105,9 @@ func (fs *FilerServer) filerHandler(w http.ResponseWriter, r *http.Request) {
 			fs.inFlightDataLimitCond.Signal()
 		}()
 
-		if r.Method == http.MethodPut {
+		if r.URL.Query().Get("restoreVersion") != "" {
+			fs.RestoreVersionHandler(w, r)
+		} else if r.Method == http.MethodPut {
 			if _, ok := r.URL.Query()["tagging"]; ok {
 				fs.PutTaggingHandler(w, r)
 			} else {
@@ -90,13 +90,27 @@ func checkPreconditions(w http.ResponseWriter, r *http.Request, entry *filer.Ent
 
 func (fs *FilerServer) GetOrHeadHandler(w http.ResponseWriter, r *http.Request) {
 
+	if _, ok := r.URL.Query()["versions"]; ok {
+		fs.ListVersionsHandler(w, r)
+		return
+	}
+
 	path := r.URL.Path
 	isForDirectory := strings.HasSuffix(path, "/")
 	if isForDirectory && len(path) > 1 {
 		path = path[:len(path)-1]
 	}
+	// read one version of the file
+	findPath := util.FullPath(path)
+	if versionId := r.URL.Query().Get("versionId"); versionId != "" {
+		if err := filer.CheckVersionId(versionId); err != nil {
+			writeJsonError(w, r, http.StatusBadRequest, err)
+			return
+		}
+		findPath = filer.VersionPath(findPath, versionId)
+	}
 
-	entry, err := fs.filer.FindEntry(context.Background(), util.FullPath(path))
+	entry, err := fs.filer.FindEntry(context.Background(), findPath)
 	if err != nil {
 		if path == "/" {
 			fs.listDirectoryHandler(w, r)
@@ -113,6 +127,10 @@ func (fs *FilerServer) GetOrHeadHandler(w http.ResponseWriter, r *http.Request)
 		}
 		return
 	}
+	if findPath != util.FullPath(path) {
+		entry = entry.ShallowClone()
+		entry.FullPath = util.FullPath(path)
+	}
 
 	query := r.URL.Query()
 
@@ -0,0 +1,86 @@
+package weed_server
+
+import (
+	"context"
+	"net/http"
+	"strings"
+
+	"github.com/gateway-dao/seaweedfs/weed/filer"
+	"github.com/gateway-dao/seaweedfs/weed/glog"
+	"github.com/gateway-dao/seaweedfs/weed/util"
+)
+
+type entryVersion struct {
+	VersionId      string `json:"versionId"`
+	IsDeleteMarker bool   `json:"isDeleteMarker,omitempty"`
+	*filer.Entry
+}
+
+func versionedFilePath(r *http.Request) util.FullPath {
+	path := r.URL.Path
+	if strings.HasSuffix(path, "/") {
+		path = path[:len(path)-1]
+	}
+	return util.FullPath(path)
+}
+
+// list the versions of one file, oldest first
+// curl http://localhost:8888/path/to/a/file?versions
+func (fs *FilerServer) ListVersionsHandler(w http.ResponseWriter, r *http.Request) {
+
+	path := versionedFilePath(r)
+
+	versions, err := fs.filer.ListVersions(context.Background(), path)
+	if err != nil {
+		writeJsonError(w, r, http.StatusInternalServerError, err)
+		return
+	}
+
+	list := make([]entryVersion, 0, len(versions))
+	for _, version := range versions {
+		list = append(list, entryVersion{
+			VersionId:      version.Name(),
+			IsDeleteMarker: filer.IsDeleteMarker(version),
+			Entry:          version,
+		})
+	}
+
+	writeJsonQuiet(w, r, http.StatusOK, struct {
+		Path     string
+		Versions []entryVersion
+	}{
+		Path:     string(path),
+		Versions: list,
+	})
+}
+
+// replace one file with one of its versions
+// curl -X POST http://localhost:8888/path/to/a/file?restoreVersion=1700000000000000000
+func (fs *FilerServer) RestoreVersionHandler(w http.ResponseWriter, r *http.Request) {
+
+	path := versionedFilePath(r)
+
+	entry, err := fs.filer.RestoreVersion(context.Background(), path, r.URL.Query().Get("restoreVersion"))
+	if err != nil {
+		glog.V(0).Infof("failing to restore %s version: %v", path, err)
+		writeJsonError(w, r, http.StatusInternalServerError, err)
+		return
+	}
+
+	writeJsonQuiet(w, r, http.StatusAccepted, entry)
+}
+
+// delete one version of one file
+// curl -X DELETE http://localhost:8888/path/to/a/file?versionId=1700000000000000000
+func (fs *FilerServer) DeleteVersionHandler(w http.ResponseWriter, r *http.Request) {
+
+	path := versionedFilePath(r)
+
+	if err := fs.filer.DeleteVersion(context.Background(), path, r.URL.Query().Get("versionId")); err != nil {
+		glog.V(0).Infof("failing to delete %s version: %v", path, err)
+		writeJsonError(w, r, http.StatusInternalServerError, err)
+		return
+	}
+
+	w.WriteHeader(http.StatusNoContent)
+}
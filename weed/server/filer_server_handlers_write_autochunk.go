@@ -53,6 +53,8 @@ func (fs *FilerServer) autoChunk(ctx context.Context, w http.ResponseWriter, r *
 			writeJsonError(w, r, util.HttpStatusCancelled, err)
 		} else if strings.HasSuffix(err.Error(), "is a file") || strings.HasSuffix(err.Error(), "already exists") {
 			writeJsonError(w, r, http.StatusConflict, err)
+		} else if strings.Contains(err.Error(), filer.MsgQuotaExceeded) {
+			writeJsonError(w, r, http.StatusInsufficientStorage, err)
 		} else {
 			writeJsonError(w, r, http.StatusInternalServerError, err)
 		}
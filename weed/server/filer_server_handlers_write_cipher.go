@@ -34,7 +34,7 @@ func (fs *FilerServer) encrypt(ctx context.Context, w http.ResponseWriter, r *ht
 	bytesBuffer := bufPool.Get().(*bytes.Buffer)
 	defer bufPool.Put(bytesBuffer)
 
-	pu, err := needle.ParseUpload(r, sizeLimit, bytesBuffer)
+	pu, err := needle.ParseUpload(r, sizeLimit, bytesBuffer, nil)
 	uncompressedData := pu.Data
 	if pu.IsGzipped {
 		uncompressedData = pu.UncompressedData
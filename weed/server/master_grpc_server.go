@@ -150,7 +150,9 @@ func (ms *MasterServer) SendHeartbeat(stream master_pb.Seaweed_SendHeartbeatServ
 			}
 
 			if err := stream.Send(&master_pb.HeartbeatResponse{
-				VolumeSizeLimit: uint64(ms.option.VolumeSizeLimitMB) * 1024 * 1024,
+				VolumeSizeLimit:  uint64(ms.option.VolumeSizeLimitMB) * 1024 * 1024,
+				IoQos:            ms.ioQos,
+				AsyncReplication: ms.asyncReplication,
 			}); err != nil {
 				glog.Warningf("SendHeartbeat.Send volume size to %s:%d %v", dn.Ip, dn.Port, err)
 				return err
@@ -160,6 +162,9 @@ func (ms *MasterServer) SendHeartbeat(stream master_pb.Seaweed_SendHeartbeatServ
 		}
 
 		dn.AdjustMaxVolumeCounts(heartbeat.MaxVolumeCounts)
+		if len(heartbeat.FreeDiskBytes) > 0 {
+			dn.UpdateLoadStats(heartbeat.FreeDiskBytes, heartbeat.WriteBytesPerSecond)
+		}
 
 		glog.V(4).Infof("master received heartbeat %s", heartbeat.String())
 		stats.MasterReceivedHeartbeatCounter.WithLabelValues("total").Inc()
@@ -196,6 +201,7 @@ func (ms *MasterServer) SendHeartbeat(stream master_pb.Seaweed_SendHeartbeatServ
 
 			// process heartbeat.Volumes
 			stats.MasterReceivedHeartbeatCounter.WithLabelValues("Volumes").Inc()
+			staleReplicas := ms.Topo.StaleReplicas(dn)
 			newVolumes, deletedVolumes := ms.Topo.SyncDataNodeRegistration(heartbeat.Volumes, dn)
 
 			for _, v := range newVolumes {
@@ -206,6 +212,21 @@ func (ms *MasterServer) SendHeartbeat(stream master_pb.Seaweed_SendHeartbeatServ
 				glog.V(0).Infof("master see deleted volume %d from %s", uint32(v.Id), dn.Url())
 				message.DeletedVids = append(message.DeletedVids, uint32(v.Id))
 			}
+
+			// hide the replicas lagging behind the primary data center from the readers
+			for vid := range ms.Topo.StaleReplicas(dn) {
+				if !staleReplicas[vid] {
+					glog.V(0).Infof("master see stale replica of volume %d on %s", vid, dn.Url())
+					message.DeletedVids = append(message.DeletedVids, uint32(vid))
+				}
+				delete(staleReplicas, vid)
+			}
+			for vid := range staleReplicas {
+				if dn.HasVolumesById(vid) {
+					glog.V(0).Infof("master see replica of volume %d caught up on %s", vid, dn.Url())
+					message.NewVids = append(message.NewVids, uint32(vid))
+				}
+			}
 		}
 
 		if len(heartbeat.NewEcShards) > 0 || len(heartbeat.DeletedEcShards) > 0 {
@@ -421,6 +442,8 @@ func (ms *MasterServer) GetMasterConfiguration(ctx context.Context, req *master_
 		VolumeSizeLimitMB:      uint32(ms.option.VolumeSizeLimitMB),
 		VolumePreallocate:      ms.option.VolumePreallocate,
 		Leader:                 string(leader),
+		IoQos:                  ms.ioQos,
+		AsyncReplication:       ms.asyncReplication,
 	}
 
 	return resp, nil
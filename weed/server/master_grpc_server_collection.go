@@ -2,12 +2,14 @@ package weed_server
 
 import (
 	"context"
+	"fmt"
 
 	"github.com/seaweedfs/raft"
 
 	"github.com/gateway-dao/seaweedfs/weed/operation"
 	"github.com/gateway-dao/seaweedfs/weed/pb/master_pb"
 	"github.com/gateway-dao/seaweedfs/weed/pb/volume_server_pb"
+	"github.com/gateway-dao/seaweedfs/weed/storage/erasure_coding"
 )
 
 func (ms *MasterServer) CollectionList(ctx context.Context, req *master_pb.CollectionListRequest) (*master_pb.CollectionListResponse, error) {
@@ -50,6 +52,34 @@ func (ms *MasterServer) CollectionDelete(ctx context.Context, req *master_pb.Col
 	return resp, nil
 }
 
+func (ms *MasterServer) SetCollectionEcScheme(ctx context.Context, req *master_pb.SetCollectionEcSchemeRequest) (*master_pb.SetCollectionEcSchemeResponse, error) {
+
+	if !ms.Topo.IsLeader() {
+		return nil, raft.NotLeaderError
+	}
+
+	scheme, err := erasure_coding.ParseEcScheme(req.EcScheme)
+	if err != nil {
+		return nil, err
+	}
+	if err = ms.Topo.SetCollectionEcScheme(req.Collection, scheme); err != nil {
+		return nil, fmt.Errorf("set collection %q ec scheme %s: %v", req.Collection, scheme, err)
+	}
+
+	return &master_pb.SetCollectionEcSchemeResponse{}, nil
+}
+
+func (ms *MasterServer) GetCollectionEcScheme(ctx context.Context, req *master_pb.GetCollectionEcSchemeRequest) (*master_pb.GetCollectionEcSchemeResponse, error) {
+
+	if !ms.Topo.IsLeader() {
+		return nil, raft.NotLeaderError
+	}
+
+	return &master_pb.GetCollectionEcSchemeResponse{
+		EcScheme: ms.Topo.GetCollectionEcScheme(req.Collection).String(),
+	}, nil
+}
+
 func (ms *MasterServer) doDeleteNormalCollection(collectionName string) error {
 
 	collection, ok := ms.Topo.FindCollection(collectionName)
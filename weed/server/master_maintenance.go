@@ -0,0 +1,617 @@
+package weed_server
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"io"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"google.golang.org/protobuf/proto"
+
+	"github.com/gateway-dao/seaweedfs/weed/glog"
+	"github.com/gateway-dao/seaweedfs/weed/pb/master_pb"
+	"github.com/gateway-dao/seaweedfs/weed/security"
+	"github.com/gateway-dao/seaweedfs/weed/shell"
+	"github.com/gateway-dao/seaweedfs/weed/util"
+)
+
+/*
+The maintenance scheduler repairs and balances the volumes from the leader
+master, instead of running "volume.fix.replication", "ec.rebuild",
+"volume.vacuum" and "volume.balance" in the maintenance scripts.
+
+Each task plans its actions from the volumes reported by the heartbeats, with
+the planning code of the shell commands, and runs the shell command for one
+volume per action, holding the cluster admin lock. A task only starts actions
+within its time window, and at most max_actions_per_hour. The actions of a task
+run one after another, and different tasks run in parallel.
+
+The scheduler is inspected with /vol/maintenance, and paused and resumed with
+/vol/maintenance/pause and /vol/maintenance/resume, optionally for one task
+with ?task=<name>. The pause is kept by the leader only, and is lost when the
+leadership changes.
+*/
+
+const (
+	maintenanceFixReplication = "fix.replication"
+	maintenanceEcRebuild      = "ec.rebuild"
+	maintenanceVacuum         = "vacuum"
+	maintenanceBalance        = "balance"
+
+	maintenanceActionPlanned = "planned"
+	maintenanceActionRunning = "running"
+	maintenanceActionDone    = "done"
+	maintenanceActionFailed  = "failed"
+
+	maintenanceHistorySize    = 100
+	maintenanceFailureBackoff = time.Hour
+	// the lock name used by the shell, see shell.NewCommandEnv
+	shellAdminLockName = "shell"
+)
+
+// maintenanceWindow is a daily period in local time, from start to end since midnight.
+// It may wrap around midnight. The zero window is the whole day.
+type maintenanceWindow struct {
+	start, end time.Duration
+}
+
+func parseMaintenanceWindow(text string) (w maintenanceWindow, err error) {
+	if text == "" {
+		return
+	}
+	startText, endText, found := strings.Cut(text, "-")
+	if !found {
+		return w, fmt.Errorf("window %q is not like 01:00-06:00", text)
+	}
+	if w.start, err = parseTimeOfDay(startText); err != nil {
+		return w, fmt.Errorf("window %q: %v", text, err)
+	}
+	if w.end, err = parseTimeOfDay(endText); err != nil {
+		return w, fmt.Errorf("window %q: %v", text, err)
+	}
+	return
+}
+
+func parseTimeOfDay(text string) (time.Duration, error) {
+	t, err := time.Parse("15:04", strings.TrimSpace(text))
+	if err != nil {
+		return 0, err
+	}
+	return time.Duration(t.Hour())*time.Hour + time.Duration(t.Minute())*time.Minute, nil
+}
+
+func (w maintenanceWindow) contains(t time.Time) bool {
+	if w.start == w.end {
+		return true
+	}
+	year, month, day := t.Date()
+	sinceMidnight := t.Sub(time.Date(year, month, day, 0, 0, 0, 0, t.Location()))
+	if w.start < w.end {
+		return w.start <= sinceMidnight && sinceMidnight < w.end
+	}
+	return w.start <= sinceMidnight || sinceMidnight < w.end
+}
+
+func (w maintenanceWindow) String() string {
+	if w.start == w.end {
+		return ""
+	}
+	format := func(d time.Duration) string {
+		return fmt.Sprintf("%02d:%02d", int(d.Hours()), int(d.Minutes())%60)
+	}
+	return format(w.start) + "-" + format(w.end)
+}
+
+type maintenanceTask struct {
+	name              string
+	interval          time.Duration
+	window            maintenanceWindow
+	maxActionsPerHour int
+	garbageThreshold  float64
+	paused            bool
+	lastCheck         time.Time
+	started           []time.Time // the actions started within the last hour
+}
+
+// budget is how many actions can start now.
+func (task *maintenanceTask) budget(now time.Time) int {
+	if task.paused || !task.window.contains(now) {
+		return 0
+	}
+	recent := task.started[:0]
+	for _, started := range task.started {
+		if now.Sub(started) < time.Hour {
+			recent = append(recent, started)
+		}
+	}
+	task.started = recent
+	if task.maxActionsPerHour <= 0 {
+		return -1
+	}
+	if len(recent) >= task.maxActionsPerHour {
+		return 0
+	}
+	return task.maxActionsPerHour - len(recent)
+}
+
+type MaintenanceAction struct {
+	Task       string
+	VolumeId   uint32
+	Collection string
+	Reason     string
+	Command    string
+	State      string
+	Planned    time.Time
+	Started    time.Time
+	Finished   time.Time
+	Message    string
+
+	name string
+	args []string
+}
+
+func newMaintenanceAction(task string, vid uint32, collection, reason string, now time.Time, name string, args ...string) *MaintenanceAction {
+	return &MaintenanceAction{
+		Task:       task,
+		VolumeId:   vid,
+		Collection: collection,
+		Reason:     reason,
+		Command:    name + " " + strings.Join(args, " "),
+		State:      maintenanceActionPlanned,
+		Planned:    now,
+		name:       name,
+		args:       args,
+	}
+}
+
+// planMaintenance plans the actions of the task, one per volume, with the planning code of the shell commands.
+func planMaintenance(task *maintenanceTask, topologyInfo *master_pb.TopologyInfo, now time.Time) (actions []*MaintenanceAction, err error) {
+	volumes := make(map[uint32]*master_pb.VolumeInformationMessage)
+	for _, dc := range topologyInfo.DataCenterInfos {
+		for _, rack := range dc.RackInfos {
+			for _, dn := range rack.DataNodeInfos {
+				for _, diskInfo := range dn.DiskInfos {
+					for _, v := range diskInfo.VolumeInfos {
+						if existing, found := volumes[v.Id]; !found || task.name != maintenanceVacuum || v.DeletedByteCount > existing.DeletedByteCount {
+							volumes[v.Id] = v
+						}
+					}
+				}
+			}
+		}
+	}
+	collectionOf := func(vid uint32) string {
+		if v, found := volumes[vid]; found {
+			return v.Collection
+		}
+		return ""
+	}
+
+	switch task.name {
+	case maintenanceFixReplication:
+		underReplicated, overReplicated, misplaced := shell.PlanVolumeFixReplication(topologyInfo)
+		for reason, vids := range map[string][]uint32{
+			"under replicated": underReplicated,
+			"over replicated":  overReplicated,
+			"misplaced":        misplaced,
+		} {
+			for _, vid := range vids {
+				actions = append(actions, newMaintenanceAction(task.name, vid, collectionOf(vid), reason, now,
+					"volume.fix.replication", "-volumeId="+strconv.FormatUint(uint64(vid), 10)))
+			}
+		}
+	case maintenanceEcRebuild:
+		for _, repair := range shell.PlanEcRebuild(topologyInfo) {
+			if repair.Unrepairable {
+				glog.Warningf("ec volume %d is unrepairable with %d shards", repair.VolumeId, repair.ShardCount)
+				continue
+			}
+			actions = append(actions, newMaintenanceAction(task.name, uint32(repair.VolumeId), repair.Collection,
+				fmt.Sprintf("%d of %d shards", repair.ShardCount, repair.TotalShards), now,
+				"ec.rebuild", "-collection="+repair.Collection, "-volumeId="+repair.VolumeId.String(), "-force"))
+		}
+	case maintenanceVacuum:
+		for vid, v := range volumes {
+			if v.ReadOnly || v.RemoteStorageName != "" || v.Size == 0 {
+				continue
+			}
+			garbageRatio := float64(v.DeletedByteCount) / float64(v.Size)
+			if garbageRatio < task.garbageThreshold {
+				continue
+			}
+			actions = append(actions, newMaintenanceAction(task.name, vid, v.Collection,
+				fmt.Sprintf("%.0f%% garbage", garbageRatio*100), now,
+				"volume.vacuum", "-volumeId="+strconv.FormatUint(uint64(vid), 10),
+				"-garbageThreshold="+strconv.FormatFloat(task.garbageThreshold, 'f', -1, 64)))
+		}
+	case maintenanceBalance:
+		// the simulation changes the volume counts
+		moves, err := shell.PlanVolumeBalance(proto.Clone(topologyInfo).(*master_pb.TopologyInfo))
+		if err != nil {
+			return nil, err
+		}
+		for _, move := range moves {
+			actions = append(actions, newMaintenanceAction(task.name, move.VolumeId, move.Collection,
+				fmt.Sprintf("%s => %s", move.Source, move.Target), now,
+				"volume.move", "-volumeId="+strconv.FormatUint(uint64(move.VolumeId), 10),
+				"-source="+move.Source, "-target="+move.Target, "-disk="+move.DiskType))
+		}
+	default:
+		return nil, fmt.Errorf("unknown maintenance task %s", task.name)
+	}
+	sort.Slice(actions, func(i, j int) bool {
+		return actions[i].VolumeId < actions[j].VolumeId
+	})
+	return
+}
+
+type MaintenanceTaskStatus struct {
+	Name              string
+	Interval          string
+	Window            string
+	MaxActionsPerHour int
+	ActionsLastHour   int
+	Paused            bool
+	LastCheck         time.Time
+}
+
+type MaintenanceStatus struct {
+	Enabled       bool
+	DryRun        bool
+	Paused        bool
+	MaxConcurrent int
+	LockedBy      string
+	Tasks         []MaintenanceTaskStatus
+	Planned       []MaintenanceAction
+	Running       []MaintenanceAction
+	History       []MaintenanceAction
+}
+
+type maintenanceScheduler struct {
+	sync.Mutex
+	tasks         []*maintenanceTask
+	dryRun        bool
+	paused        bool
+	maxConcurrent int
+	lockedBy      string
+	planned       []*MaintenanceAction
+	running       map[uint32]*MaintenanceAction // by volume id
+	history       []*MaintenanceAction
+	failedAt      map[string]time.Time // by action command
+}
+
+func newMaintenanceScheduler(tasks []*maintenanceTask, dryRun bool, maxConcurrent int) *maintenanceScheduler {
+	if maxConcurrent <= 0 {
+		maxConcurrent = 1
+	}
+	return &maintenanceScheduler{
+		tasks:         tasks,
+		dryRun:        dryRun,
+		maxConcurrent: maxConcurrent,
+		running:       make(map[uint32]*MaintenanceAction),
+		failedAt:      make(map[string]time.Time),
+	}
+}
+
+func (s *maintenanceScheduler) status() (status MaintenanceStatus) {
+	if s == nil {
+		return
+	}
+	s.Lock()
+	defer s.Unlock()
+	status.Enabled = true
+	status.DryRun = s.dryRun
+	status.Paused = s.paused
+	status.MaxConcurrent = s.maxConcurrent
+	status.LockedBy = s.lockedBy
+	now := time.Now()
+	for _, task := range s.tasks {
+		task.budget(now)
+		status.Tasks = append(status.Tasks, MaintenanceTaskStatus{
+			Name:              task.name,
+			Interval:          task.interval.String(),
+			Window:            task.window.String(),
+			MaxActionsPerHour: task.maxActionsPerHour,
+			ActionsLastHour:   len(task.started),
+			Paused:            task.paused,
+			LastCheck:         task.lastCheck,
+		})
+	}
+	// copies, since the actions are updated while running
+	for _, action := range s.planned {
+		status.Planned = append(status.Planned, *action)
+	}
+	for _, action := range s.running {
+		status.Running = append(status.Running, *action)
+	}
+	sort.Slice(status.Running, func(i, j int) bool {
+		return status.Running[i].VolumeId < status.Running[j].VolumeId
+	})
+	// most recent first
+	for i := len(s.history) - 1; i >= 0; i-- {
+		status.History = append(status.History, *s.history[i])
+	}
+	return
+}
+
+// setPaused pauses or resumes the scheduler, or only the named task.
+func (s *maintenanceScheduler) setPaused(taskName string, paused bool) error {
+	if s == nil {
+		return fmt.Errorf("maintenance scheduler is not enabled")
+	}
+	s.Lock()
+	defer s.Unlock()
+	if taskName == "" {
+		s.paused = paused
+		return nil
+	}
+	for _, task := range s.tasks {
+		if task.name == taskName {
+			task.paused = paused
+			return nil
+		}
+	}
+	return fmt.Errorf("unknown maintenance task %s", taskName)
+}
+
+// dueTasks returns the tasks to check now.
+func (s *maintenanceScheduler) dueTasks(now time.Time) (tasks []*maintenanceTask) {
+	s.Lock()
+	defer s.Unlock()
+	for _, task := range s.tasks {
+		if now.Sub(task.lastCheck) >= task.interval {
+			task.lastCheck = now
+			tasks = append(tasks, task)
+		}
+	}
+	return
+}
+
+// plan replaces the planned actions of the task, and returns the actions to start now,
+// skipping the volumes with running actions and the actions failed recently.
+func (s *maintenanceScheduler) plan(task *maintenanceTask, candidates []*MaintenanceAction, now time.Time) (runnable []*MaintenanceAction) {
+	s.Lock()
+	defer s.Unlock()
+	planned := s.planned[:0]
+	for _, action := range s.planned {
+		if action.Task != task.name {
+			planned = append(planned, action)
+		}
+	}
+	s.planned = planned
+
+	budget := -1
+	if !s.paused && !s.dryRun {
+		budget = task.budget(now)
+	}
+	seen := make(map[uint32]bool)
+	for _, action := range candidates {
+		if _, found := s.running[action.VolumeId]; found || seen[action.VolumeId] {
+			continue
+		}
+		if failedAt, found := s.failedAt[action.Command]; found && now.Sub(failedAt) < maintenanceFailureBackoff {
+			continue
+		}
+		seen[action.VolumeId] = true
+		s.planned = append(s.planned, action)
+		if s.paused || s.dryRun || budget == 0 {
+			continue
+		}
+		runnable = append(runnable, action)
+		budget--
+	}
+	return
+}
+
+// start marks the action as running, unless the volume is busy or the task is paused meanwhile.
+func (s *maintenanceScheduler) start(task *maintenanceTask, action *MaintenanceAction) bool {
+	s.Lock()
+	defer s.Unlock()
+	if s.paused || task.paused {
+		return false
+	}
+	if _, found := s.running[action.VolumeId]; found {
+		return false
+	}
+	action.State = maintenanceActionRunning
+	action.Started = time.Now()
+	task.started = append(task.started, action.Started)
+	s.running[action.VolumeId] = action
+	for i, planned := range s.planned {
+		if planned == action {
+			s.planned = append(s.planned[:i], s.planned[i+1:]...)
+			break
+		}
+	}
+	return true
+}
+
+func (s *maintenanceScheduler) finish(action *MaintenanceAction, err error, output string) {
+	s.Lock()
+	defer s.Unlock()
+	action.Finished = time.Now()
+	if err != nil {
+		action.State = maintenanceActionFailed
+		action.Message = err.Error()
+		s.failedAt[action.Command] = action.Finished
+	} else {
+		action.State = maintenanceActionDone
+		action.Message = lastLine(output)
+		delete(s.failedAt, action.Command)
+	}
+	delete(s.running, action.VolumeId)
+	s.history = append(s.history, action)
+	if len(s.history) > maintenanceHistorySize {
+		s.history = s.history[len(s.history)-maintenanceHistorySize:]
+	}
+}
+
+func (s *maintenanceScheduler) setLockedBy(client string) {
+	s.Lock()
+	defer s.Unlock()
+	s.lockedBy = client
+}
+
+func loadMaintenanceTask(v *util.ViperProxy, name, key string, intervalMinutes, maxActionsPerHour int, window string) (*maintenanceTask, error) {
+	prefix := "master.maintenance.scheduler." + key + "."
+	v.SetDefault(prefix+"enabled", true)
+	v.SetDefault(prefix+"interval_minutes", intervalMinutes)
+	v.SetDefault(prefix+"max_actions_per_hour", maxActionsPerHour)
+	v.SetDefault(prefix+"window", window)
+	v.SetDefault(prefix+"garbage_threshold", 0.3)
+	if !v.GetBool(prefix + "enabled") {
+		return nil, nil
+	}
+	w, err := parseMaintenanceWindow(v.GetString(prefix + "window"))
+	if err != nil {
+		return nil, fmt.Errorf("%s: %v", name, err)
+	}
+	return &maintenanceTask{
+		name:              name,
+		interval:          time.Duration(v.GetInt(prefix+"interval_minutes")) * time.Minute,
+		window:            w,
+		maxActionsPerHour: v.GetInt(prefix + "max_actions_per_hour"),
+		garbageThreshold:  v.GetFloat64(prefix + "garbage_threshold"),
+	}, nil
+}
+
+func (ms *MasterServer) startMaintenanceScheduler() {
+	v := util.GetViper()
+	if !v.GetBool("master.maintenance.scheduler.enabled") {
+		return
+	}
+	v.SetDefault("master.maintenance.scheduler.dry_run", true)
+	v.SetDefault("master.maintenance.scheduler.max_concurrent", 2)
+
+	// repair before balancing
+	var tasks []*maintenanceTask
+	for _, t := range []struct {
+		name, key         string
+		intervalMinutes   int
+		maxActionsPerHour int
+		window            string
+	}{
+		{maintenanceFixReplication, "fix_replication", 17, 60, ""},
+		{maintenanceEcRebuild, "ec_rebuild", 17, 20, ""},
+		{maintenanceVacuum, "vacuum", 15, 60, ""},
+		{maintenanceBalance, "balance", 360, 20, ""},
+	} {
+		task, err := loadMaintenanceTask(v, t.name, t.key, t.intervalMinutes, t.maxActionsPerHour, t.window)
+		if err != nil {
+			glog.Fatalf("master.maintenance.scheduler: %v", err)
+		}
+		if task != nil {
+			tasks = append(tasks, task)
+		}
+	}
+	if len(tasks) == 0 {
+		glog.Warningf("master.maintenance.scheduler is enabled without tasks")
+		return
+	}
+
+	ms.maintenance = newMaintenanceScheduler(tasks, v.GetBool("master.maintenance.scheduler.dry_run"), v.GetInt("master.maintenance.scheduler.max_concurrent"))
+	glog.V(0).Infof("maintenance scheduler with %d tasks, dry run %v", len(tasks), ms.maintenance.dryRun)
+
+	masterAddress := string(ms.option.Master)
+	var shellOptions shell.ShellOptions
+	shellOptions.GrpcDialOption = security.LoadClientTLS(v, "grpc.master")
+	shellOptions.Masters = &masterAddress
+	shellOptions.Directory = "/"
+	emptyFilerGroup := ""
+	shellOptions.FilerGroup = &emptyFilerGroup
+
+	commandEnv := shell.NewCommandEnv(&shellOptions)
+	if !ms.maintenance.dryRun {
+		go commandEnv.MasterClient.KeepConnectedToMaster(context.Background())
+	}
+
+	go func() {
+		for {
+			time.Sleep(time.Minute)
+			if ms.Topo.IsLeader() {
+				ms.checkMaintenance(commandEnv)
+			}
+		}
+	}()
+}
+
+func (ms *MasterServer) checkMaintenance(commandEnv *shell.CommandEnv) {
+	s := ms.maintenance
+	now := time.Now()
+	tasks := s.dueTasks(now)
+	if len(tasks) == 0 {
+		return
+	}
+
+	topologyInfo := ms.Topo.ToTopologyInfo()
+	runnable := make(map[*maintenanceTask][]*MaintenanceAction)
+	for _, task := range tasks {
+		candidates, err := planMaintenance(task, topologyInfo, now)
+		if err != nil {
+			glog.Errorf("plan %s: %v", task.name, err)
+			continue
+		}
+		if actions := s.plan(task, candidates, now); len(actions) > 0 {
+			runnable[task] = actions
+		}
+		if s.dryRun {
+			for _, action := range candidates {
+				glog.V(0).Infof("maintenance dry run: volume %d would run: %s", action.VolumeId, action.Command)
+			}
+		}
+	}
+	if len(runnable) == 0 {
+		return
+	}
+
+	if ms.MasterClient.GetMaster(context.Background()) == "" {
+		return
+	}
+	// leave the cluster to the operators holding the admin lock
+	if client, message, isLocked := ms.adminLocks.isLocked(shellAdminLockName); isLocked {
+		glog.V(0).Infof("maintenance waits for the admin lock held by %s: %s", client, message)
+		s.setLockedBy(client)
+		return
+	}
+	s.setLockedBy("")
+	if err := runShellCommand(commandEnv, "lock", nil, io.Discard); err != nil {
+		glog.Errorf("maintenance lock: %v", err)
+		return
+	}
+	defer runShellCommand(commandEnv, "unlock", nil, io.Discard)
+
+	var wg sync.WaitGroup
+	limiter := make(chan struct{}, s.maxConcurrent)
+	for _, task := range s.tasks {
+		actions, found := runnable[task]
+		if !found {
+			continue
+		}
+		limiter <- struct{}{}
+		wg.Add(1)
+		go func(task *maintenanceTask, actions []*MaintenanceAction) {
+			defer func() {
+				<-limiter
+				wg.Done()
+			}()
+			for _, action := range actions {
+				if !ms.Topo.IsLeader() || !s.start(task, action) {
+					continue
+				}
+				glog.V(0).Infof("maintenance %s volume %d: %s", task.name, action.VolumeId, action.Command)
+				var output bytes.Buffer
+				err := runShellCommand(commandEnv, action.name, action.args, &output)
+				if err != nil {
+					glog.Errorf("maintenance %s volume %d: %v", task.name, action.VolumeId, err)
+				}
+				s.finish(action, err, output.String())
+			}
+		}(task, actions)
+	}
+	wg.Wait()
+}
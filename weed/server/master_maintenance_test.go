@@ -0,0 +1,171 @@
+package weed_server
+
+import (
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/gateway-dao/seaweedfs/weed/pb/master_pb"
+)
+
+func TestMaintenanceWindow(t *testing.T) {
+	day := time.Date(2024, 3, 1, 0, 0, 0, 0, time.Local)
+	for _, tt := range []struct {
+		window   string
+		at       time.Duration
+		expected bool
+	}{
+		{"", 13 * time.Hour, true},
+		{"01:00-06:00", 3 * time.Hour, true},
+		{"01:00-06:00", 6 * time.Hour, false},
+		{"22:30-02:00", 23 * time.Hour, true},
+		{"22:30-02:00", time.Hour, true},
+		{"22:30-02:00", 12 * time.Hour, false},
+	} {
+		w, err := parseMaintenanceWindow(tt.window)
+		if err != nil {
+			t.Fatalf("parse %q: %v", tt.window, err)
+		}
+		if w.String() != tt.window {
+			t.Errorf("window %q is formatted as %q", tt.window, w.String())
+		}
+		if w.contains(day.Add(tt.at)) != tt.expected {
+			t.Errorf("window %q contains %v: expected %v", tt.window, tt.at, tt.expected)
+		}
+	}
+	for _, bad := range []string{"01:00", "1am-6am", "01:00-25:00"} {
+		if _, err := parseMaintenanceWindow(bad); err == nil {
+			t.Errorf("window %q should be rejected", bad)
+		}
+	}
+}
+
+func TestPlanMaintenance(t *testing.T) {
+	volume := func(id uint32, replicaPlacement uint32, size, deleted uint64) *master_pb.VolumeInformationMessage {
+		return &master_pb.VolumeInformationMessage{
+			Id:               id,
+			Collection:       "pics",
+			ReplicaPlacement: replicaPlacement,
+			Size:             size,
+			DeletedByteCount: deleted,
+		}
+	}
+	node := func(id string, volumes []*master_pb.VolumeInformationMessage, ecShards ...*master_pb.VolumeEcShardInformationMessage) *master_pb.DataNodeInfo {
+		return &master_pb.DataNodeInfo{Id: id, DiskInfos: map[string]*master_pb.DiskInfo{"": {
+			MaxVolumeCount: 10,
+			VolumeCount:    int64(len(volumes)),
+			VolumeInfos:    volumes,
+			EcShardInfos:   ecShards,
+		}}}
+	}
+	topologyInfo := &master_pb.TopologyInfo{
+		DataCenterInfos: []*master_pb.DataCenterInfo{{
+			Id: "dc1",
+			RackInfos: []*master_pb.RackInfo{{
+				Id: "rack1",
+				DataNodeInfos: []*master_pb.DataNodeInfo{
+					node("127.0.0.1:8080", []*master_pb.VolumeInformationMessage{
+						volume(1, 1, 100, 0),  // one of two replicas
+						volume(2, 0, 100, 50), // garbage
+						volume(3, 0, 100, 10),
+						volume(4, 0, 100, 0),
+						volume(5, 0, 100, 0),
+					}, &master_pb.VolumeEcShardInformationMessage{Id: 9, Collection: "pics", EcIndexBits: 0x3ff}),
+					node("127.0.0.1:8081", nil,
+						&master_pb.VolumeEcShardInformationMessage{Id: 9, Collection: "pics", EcIndexBits: 0x400}),
+				},
+			}},
+		}},
+	}
+
+	now := time.Now()
+	plan := func(name string) []*MaintenanceAction {
+		actions, err := planMaintenance(&maintenanceTask{name: name, garbageThreshold: 0.3}, topologyInfo, now)
+		if err != nil {
+			t.Fatalf("plan %s: %v", name, err)
+		}
+		return actions
+	}
+
+	if actions := plan(maintenanceFixReplication); len(actions) != 1 || actions[0].Command != "volume.fix.replication -volumeId=1" || actions[0].Reason != "under replicated" {
+		t.Errorf("unexpected fix replication actions %+v", actions)
+	}
+	if actions := plan(maintenanceVacuum); len(actions) != 1 || actions[0].Command != "volume.vacuum -volumeId=2 -garbageThreshold=0.3" {
+		t.Errorf("unexpected vacuum actions %+v", actions)
+	}
+	if actions := plan(maintenanceEcRebuild); len(actions) != 1 || actions[0].Command != "ec.rebuild -collection=pics -volumeId=9 -force" || actions[0].Reason != "11 of 14 shards" {
+		t.Errorf("unexpected ec rebuild actions %+v", actions)
+	}
+	actions := plan(maintenanceBalance)
+	if len(actions) == 0 {
+		t.Fatalf("no volumes balanced")
+	}
+	for _, action := range actions {
+		if action.Command != fmt.Sprintf("volume.move -volumeId=%d -source=127.0.0.1:8080 -target=127.0.0.1:8081 -disk=", action.VolumeId) {
+			t.Errorf("unexpected balance action %+v", action)
+		}
+	}
+	if topologyInfo.DataCenterInfos[0].RackInfos[0].DataNodeInfos[0].DiskInfos[""].VolumeCount != 5 {
+		t.Errorf("planning changes the topology")
+	}
+}
+
+func TestMaintenanceScheduler(t *testing.T) {
+	task := &maintenanceTask{name: maintenanceVacuum, maxActionsPerHour: 2}
+	s := newMaintenanceScheduler([]*maintenanceTask{task}, false, 1)
+	now := time.Now()
+	candidates := func() (actions []*MaintenanceAction) {
+		for vid := uint32(1); vid <= 4; vid++ {
+			actions = append(actions, newMaintenanceAction(task.name, vid, "", "", now, "volume.vacuum", fmt.Sprintf("-volumeId=%d", vid)))
+		}
+		return
+	}
+
+	if tasks := s.dueTasks(now); len(tasks) != 1 {
+		t.Fatalf("task is not due")
+	}
+	runnable := s.plan(task, candidates(), now)
+	if len(runnable) != 2 || len(s.status().Planned) != 4 {
+		t.Fatalf("rate limit is not applied: %d runnable, %d planned", len(runnable), len(s.status().Planned))
+	}
+	s.start(task, runnable[0])
+	s.finish(runnable[0], nil, "vacuumed\n")
+	s.start(task, runnable[1])
+	s.finish(runnable[1], fmt.Errorf("volume is busy"), "")
+
+	// the hourly limit is used up, and the failed action is not planned again
+	if runnable = s.plan(task, candidates(), now); len(runnable) != 0 {
+		t.Errorf("more actions than the rate limit: %+v", runnable)
+	}
+	if status := s.status(); len(status.Planned) != 3 || status.Tasks[0].ActionsLastHour != 2 {
+		t.Errorf("unexpected status %+v", status)
+	}
+	if runnable = s.plan(task, candidates(), now.Add(time.Hour+time.Minute)); len(runnable) != 2 || runnable[0].VolumeId != 1 {
+		t.Errorf("unexpected actions after an hour: %+v", runnable)
+	}
+
+	// paused tasks only plan
+	if err := s.setPaused(maintenanceVacuum, true); err != nil {
+		t.Fatalf("pause: %v", err)
+	}
+	if runnable = s.plan(task, candidates(), now.Add(2*time.Hour)); len(runnable) != 0 {
+		t.Errorf("paused task runs %+v", runnable)
+	}
+	if err := s.setPaused("unknown", true); err == nil {
+		t.Errorf("unknown task is paused")
+	}
+	s.setPaused(maintenanceVacuum, false)
+	s.setPaused("", true)
+	if runnable = s.plan(task, candidates(), now.Add(2*time.Hour)); len(runnable) != 0 || !s.status().Paused {
+		t.Errorf("paused scheduler runs %+v", runnable)
+	}
+	s.setPaused("", false)
+	if runnable = s.plan(task, candidates(), now.Add(2*time.Hour)); len(runnable) != 2 {
+		t.Errorf("resumed scheduler runs %+v", runnable)
+	}
+
+	status := s.status()
+	if len(status.History) != 2 || status.History[0].State != maintenanceActionFailed || status.History[1].Message != "vacuumed" {
+		t.Errorf("unexpected history %+v", status.History)
+	}
+}
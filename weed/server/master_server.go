@@ -28,6 +28,8 @@ import (
 	"github.com/gateway-dao/seaweedfs/weed/security"
 	"github.com/gateway-dao/seaweedfs/weed/sequence"
 	"github.com/gateway-dao/seaweedfs/weed/shell"
+	"github.com/gateway-dao/seaweedfs/weed/storage"
+	"github.com/gateway-dao/seaweedfs/weed/storage/qos"
 	"github.com/gateway-dao/seaweedfs/weed/topology"
 	"github.com/gateway-dao/seaweedfs/weed/util"
 	"github.com/gateway-dao/seaweedfs/weed/wdclient"
@@ -36,6 +38,7 @@ import (
 const (
 	SequencerType        = "master.sequencer.type"
 	SequencerSnowflakeId = "master.sequencer.sequencer_snowflake_id"
+	SequencerRangeSize   = "master.sequencer.sequencer_range_size"
 )
 
 type MasterOption struct {
@@ -52,6 +55,7 @@ type MasterOption struct {
 	MetricsIntervalSec      int
 	IsFollower              bool
 	EventStore              *event.LevelDbEventStore[*event.MasterServerEvent]
+	KeyWrapper              security.KeyWrapper // wraps the data keys of new volumes for encryption at rest
 }
 
 type MasterServer struct {
@@ -80,6 +84,19 @@ type MasterServer struct {
 	Cluster *cluster.Cluster
 
 	EventStore *event.LevelDbEventStore[*event.MasterServerEvent]
+
+	tiering *volumeTiering
+
+	maintenance *maintenanceScheduler
+
+	// the evacuations of the draining volume servers, on the leader
+	evacuations *volumeServerEvacuations
+
+	// I/O budgets of the volume servers
+	ioQos *master_pb.IoQosConfiguration
+
+	// the collections replicated asynchronously across data centers
+	asyncReplication *master_pb.AsyncReplicationConfiguration
 }
 
 func NewMasterServer(r *mux.Router, option *MasterOption, peers map[string]pb.ServerAddress) *MasterServer {
@@ -123,6 +140,7 @@ func NewMasterServer(r *mux.Router, option *MasterOption, peers map[string]pb.Se
 		adminLocks:              NewAdminLocks(),
 		Cluster:                 cluster.NewCluster(),
 		EventStore:              option.EventStore,
+		evacuations:             newVolumeServerEvacuations(),
 	}
 	ms.boundedLeaderChan = make(chan int, 16)
 
@@ -134,10 +152,25 @@ func NewMasterServer(r *mux.Router, option *MasterOption, peers map[string]pb.Se
 	}
 	ms.Topo = topology.NewTopology("topo", seq, uint64(ms.option.VolumeSizeLimitMB)*1024*1024, 5, replicationAsMin)
 	ms.vg = topology.NewDefaultVolumeGrowth()
+	placement, err := topology.LoadVolumePlacement(v)
+	if err != nil {
+		glog.Fatalf("load volume placement configuration: %v", err)
+	}
+	ms.vg.Placement = placement
 	glog.V(0).Infoln("Volume Size Limit is", ms.option.VolumeSizeLimitMB, "MB")
 
 	ms.guard = security.NewGuard(ms.option.WhiteList, signingKey, expiresAfterSec, readSigningKey, readExpiresAfterSec)
 
+	ioQos, err := qos.LoadConfiguration(v)
+	if err != nil {
+		glog.Fatalf("load io qos configuration: %v", err)
+	}
+	ms.ioQos = ioQos
+
+	ms.asyncReplication = storage.LoadAsyncReplicationConfiguration(v)
+	ms.Topo.AsyncReplication = storage.NewAsyncReplication(ms.asyncReplication)
+	ms.Topo.KeyWrapper = option.KeyWrapper
+
 	handleStaticResources2(r)
 	r.HandleFunc("/", ms.proxyToLeader(ms.uiStatusHandler))
 	r.HandleFunc("/ui/index.html", ms.uiStatusHandler)
@@ -149,6 +182,11 @@ func NewMasterServer(r *mux.Router, option *MasterOption, peers map[string]pb.Se
 		r.HandleFunc("/vol/grow", ms.proxyToLeader(ms.guard.WhiteList(ms.volumeGrowHandler)))
 		r.HandleFunc("/vol/status", ms.proxyToLeader(ms.guard.WhiteList(ms.volumeStatusHandler)))
 		r.HandleFunc("/vol/vacuum", ms.proxyToLeader(ms.guard.WhiteList(ms.volumeVacuumHandler)))
+		r.HandleFunc("/vol/tiering", ms.proxyToLeader(ms.guard.WhiteList(ms.volumeTieringHandler)))
+		r.HandleFunc("/vol/maintenance", ms.proxyToLeader(ms.guard.WhiteList(ms.maintenanceHandler)))
+		r.HandleFunc("/vol/maintenance/pause", ms.proxyToLeader(ms.guard.WhiteList(ms.maintenancePauseHandler)))
+		r.HandleFunc("/vol/maintenance/resume", ms.proxyToLeader(ms.guard.WhiteList(ms.maintenanceResumeHandler)))
+		r.HandleFunc("/vol/server/state", ms.proxyToLeader(ms.guard.WhiteList(ms.volumeServerStateHandler)))
 		r.HandleFunc("/submit", ms.guard.WhiteList(ms.submitFromMasterServerHandler))
 		/*
 			r.HandleFunc("/stats/health", ms.guard.WhiteList(statsHealthHandler))
@@ -169,6 +207,9 @@ func NewMasterServer(r *mux.Router, option *MasterOption, peers map[string]pb.Se
 
 	if !option.IsFollower {
 		ms.startAdminScripts()
+		ms.startVolumeTiering()
+		ms.startMaintenanceScheduler()
+		ms.startVolumeServerEvacuation()
 	}
 
 	return ms
@@ -352,6 +393,10 @@ func (ms *MasterServer) createSequencer(option *MasterOption) sequence.Sequencer
 			glog.Error(err)
 			seq = nil
 		}
+	case "raft_range":
+		seq = sequence.NewRaftSequencer(uint64(v.GetInt(SequencerRangeSize)), func(minFileId, count uint64) (uint64, error) {
+			return ms.Topo.ReserveFileIds(minFileId, count)
+		})
 	case "raft":
 		fallthrough
 	default:
@@ -71,7 +71,7 @@ func (ms *MasterServer) findVolumeLocation(collection, vid string) operation.Loo
 		if newVolumeIdErr != nil {
 			err = fmt.Errorf("Unknown volume id %s", vid)
 		} else {
-			machines := ms.Topo.Lookup(collection, volumeId)
+			machines := ms.Topo.SortByReplicationLag(volumeId, ms.Topo.Lookup(collection, volumeId))
 			for _, loc := range machines {
 				locations = append(locations, operation.Location{
 					Url: loc.Url(), PublicUrl: loc.PublicUrl, DataCenter: loc.GetDataCenterId(),
@@ -104,6 +104,53 @@ func (ms *MasterServer) volumeStatusHandler(w http.ResponseWriter, r *http.Reque
 	writeJsonQuiet(w, r, http.StatusOK, m)
 }
 
+func (ms *MasterServer) volumeTieringHandler(w http.ResponseWriter, r *http.Request) {
+	writeJsonQuiet(w, r, http.StatusOK, ms.tiering.status())
+}
+
+func (ms *MasterServer) maintenanceHandler(w http.ResponseWriter, r *http.Request) {
+	writeJsonQuiet(w, r, http.StatusOK, ms.maintenance.status())
+}
+
+func (ms *MasterServer) maintenancePauseHandler(w http.ResponseWriter, r *http.Request) {
+	if err := ms.maintenance.setPaused(r.FormValue("task"), true); err != nil {
+		writeJsonError(w, r, http.StatusBadRequest, err)
+		return
+	}
+	writeJsonQuiet(w, r, http.StatusOK, ms.maintenance.status())
+}
+
+func (ms *MasterServer) maintenanceResumeHandler(w http.ResponseWriter, r *http.Request) {
+	if err := ms.maintenance.setPaused(r.FormValue("task"), false); err != nil {
+		writeJsonError(w, r, http.StatusBadRequest, err)
+		return
+	}
+	writeJsonQuiet(w, r, http.StatusOK, ms.maintenance.status())
+}
+
+// volumeServerStateHandler sets the state of the volume server with "node" and "state",
+// or lists the volume servers not active.
+func (ms *MasterServer) volumeServerStateHandler(w http.ResponseWriter, r *http.Request) {
+	if node, state := r.FormValue("node"), r.FormValue("state"); node != "" || state != "" {
+		resp, err := ms.SetVolumeServerState(context.Background(), &master_pb.SetVolumeServerStateRequest{
+			Node:  node,
+			State: state,
+		})
+		if err != nil {
+			writeJsonError(w, r, http.StatusBadRequest, err)
+			return
+		}
+		writeJsonQuiet(w, r, http.StatusOK, resp.State)
+		return
+	}
+	resp, err := ms.ListVolumeServerStates(context.Background(), &master_pb.ListVolumeServerStatesRequest{})
+	if err != nil {
+		writeJsonError(w, r, http.StatusInternalServerError, err)
+		return
+	}
+	writeJsonQuiet(w, r, http.StatusOK, resp.States)
+}
+
 func (ms *MasterServer) redirectHandler(w http.ResponseWriter, r *http.Request) {
 	vid, _, _, _, _ := parseURLPath(r.URL.Path)
 	collection := r.FormValue("collection")
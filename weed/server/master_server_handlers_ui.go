@@ -28,6 +28,7 @@ func (ms *MasterServer) uiStatusHandler(w http.ResponseWriter, r *http.Request)
 			Stats             map[string]interface{}
 			Counters          *stats.ServerStats
 			VolumeSizeLimitMB uint32
+			Tiering           TieringStatus
 		}{
 			util.Version(),
 			ms.Topo.ToInfo(),
@@ -35,6 +36,7 @@ func (ms *MasterServer) uiStatusHandler(w http.ResponseWriter, r *http.Request)
 			infos,
 			serverStats,
 			ms.option.VolumeSizeLimitMB,
+			ms.tiering.status(),
 		}
 		ui.StatusTpl.Execute(w, args)
 	} else if ms.Topo.HashicorpRaft != nil {
@@ -45,6 +47,7 @@ func (ms *MasterServer) uiStatusHandler(w http.ResponseWriter, r *http.Request)
 			Stats             map[string]interface{}
 			Counters          *stats.ServerStats
 			VolumeSizeLimitMB uint32
+			Tiering           TieringStatus
 		}{
 			util.Version(),
 			ms.Topo.ToInfo(),
@@ -52,6 +55,7 @@ func (ms *MasterServer) uiStatusHandler(w http.ResponseWriter, r *http.Request)
 			infos,
 			serverStats,
 			ms.option.VolumeSizeLimitMB,
+			ms.tiering.status(),
 		}
 		ui.StatusNewRaftTpl.Execute(w, args)
 	}
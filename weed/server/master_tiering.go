@@ -0,0 +1,463 @@
+package weed_server
+
+import (
+	"bytes"
+	"context"
+	"flag"
+	"fmt"
+	"io"
+	"path/filepath"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/gateway-dao/seaweedfs/weed/glog"
+	"github.com/gateway-dao/seaweedfs/weed/pb/master_pb"
+	"github.com/gateway-dao/seaweedfs/weed/security"
+	"github.com/gateway-dao/seaweedfs/weed/shell"
+	"github.com/gateway-dao/seaweedfs/weed/storage/needle"
+	"github.com/gateway-dao/seaweedfs/weed/storage/types"
+	"github.com/gateway-dao/seaweedfs/weed/util"
+)
+
+/*
+Volume tiering moves volumes between disk types, or uploads them to a remote
+storage backend, following the rules in the [master.tiering] section of
+master.toml. For example:
+
+	move -collection=pics -fromDiskType=ssd -toDiskType=hdd -quietFor=720h
+	upload -fromDiskType=hdd -dest=s3.default -readOnly -quietFor=2160h
+
+The leader master checks the volumes reported by the heartbeats periodically,
+and runs "volume.tier.move" or "volume.tier.upload" for each matching volume,
+holding the cluster admin lock, like the maintenance scripts do.
+*/
+
+const (
+	tieringActionMove   = "move"
+	tieringActionUpload = "upload"
+
+	tieringJobPlanned = "planned"
+	tieringJobRunning = "running"
+	tieringJobDone    = "done"
+	tieringJobFailed  = "failed"
+
+	tieringHistorySize    = 100
+	tieringFailureBackoff = 24 * time.Hour
+)
+
+type tieringRule struct {
+	line            string
+	action          string
+	collection      string
+	fromDiskType    types.DiskType
+	anyDiskType     bool
+	toDiskType      types.DiskType
+	dest            string
+	quietFor        time.Duration
+	fullPercent     float64
+	readOnly        bool
+	ioBytePerSecond int64
+}
+
+func parseTieringRules(text string) (rules []*tieringRule, err error) {
+	for _, line := range strings.Split(text, "\n") {
+		line = strings.TrimSpace(line)
+		if line == "" || strings.HasPrefix(line, "#") {
+			continue
+		}
+		rule, err := parseTieringRule(line)
+		if err != nil {
+			return nil, fmt.Errorf("tiering rule %q: %v", line, err)
+		}
+		rules = append(rules, rule)
+	}
+	return
+}
+
+func parseTieringRule(line string) (*tieringRule, error) {
+	args := strings.Fields(line)
+	rule := &tieringRule{line: line, action: args[0]}
+
+	ruleFlags := flag.NewFlagSet(rule.action, flag.ContinueOnError)
+	ruleFlags.SetOutput(io.Discard)
+	collection := ruleFlags.String("collection", "", "match with wildcard characters '*' and '?'")
+	fromDiskType := ruleFlags.String("fromDiskType", "", "the disk type of the volumes")
+	toDiskType := ruleFlags.String("toDiskType", "", "the target disk type, for move")
+	dest := ruleFlags.String("dest", "", "the remote storage backend, for upload")
+	quietFor := ruleFlags.Duration("quietFor", 24*time.Hour, "select volumes without writes for this period")
+	fullPercent := ruleFlags.Float64("fullPercent", 0, "select volumes larger than this percentage of the volume size limit")
+	readOnly := ruleFlags.Bool("readOnly", false, "only select readonly volumes")
+	ioBytePerSecond := ruleFlags.Int64("ioBytePerSecond", 0, "limit the speed of move")
+	if err := ruleFlags.Parse(args[1:]); err != nil {
+		return nil, err
+	}
+	if ruleFlags.NArg() > 0 {
+		return nil, fmt.Errorf("unexpected arguments %v", ruleFlags.Args())
+	}
+	if _, err := filepath.Match(*collection, ""); err != nil {
+		return nil, fmt.Errorf("collection pattern: %v", err)
+	}
+
+	rule.collection = *collection
+	rule.fromDiskType = types.ToDiskType(*fromDiskType)
+	rule.toDiskType = types.ToDiskType(*toDiskType)
+	rule.dest = *dest
+	rule.quietFor = *quietFor
+	rule.fullPercent = *fullPercent
+	rule.readOnly = *readOnly
+	rule.ioBytePerSecond = *ioBytePerSecond
+
+	switch rule.action {
+	case tieringActionMove:
+		if rule.fromDiskType == rule.toDiskType {
+			return nil, fmt.Errorf("source disk type %s is the same as target disk type", rule.fromDiskType.ReadableString())
+		}
+	case tieringActionUpload:
+		if rule.dest == "" {
+			return nil, fmt.Errorf("missing -dest")
+		}
+		rule.anyDiskType = *fromDiskType == ""
+	default:
+		return nil, fmt.Errorf("unknown action %s, expecting %s or %s", rule.action, tieringActionMove, tieringActionUpload)
+	}
+	return rule, nil
+}
+
+func (rule *tieringRule) matches(v *master_pb.VolumeInformationMessage, volumeSizeLimit uint64, now time.Time) bool {
+	if v.RemoteStorageName != "" {
+		return false
+	}
+	if rule.collection != "" {
+		if matched, _ := filepath.Match(rule.collection, v.Collection); !matched {
+			return false
+		}
+	}
+	if !rule.anyDiskType && types.ToDiskType(v.DiskType) != rule.fromDiskType {
+		return false
+	}
+	if rule.readOnly && !v.ReadOnly {
+		return false
+	}
+	if v.ModifiedAtSecond+int64(rule.quietFor/time.Second) >= now.Unix() {
+		return false
+	}
+	return float64(v.Size) >= rule.fullPercent/100*float64(volumeSizeLimit)
+}
+
+func (rule *tieringRule) commandArgs(vid needle.VolumeId, collection string) (name string, args []string) {
+	if rule.action == tieringActionUpload {
+		return "volume.tier.upload", []string{
+			"-volumeId=" + vid.String(),
+			"-collection=" + collection,
+			"-dest=" + rule.dest,
+		}
+	}
+	args = []string{
+		"-volumeId=" + vid.String(),
+		"-fromDiskType=" + rule.fromDiskType.ReadableString(),
+		"-toDiskType=" + rule.toDiskType.ReadableString(),
+		"-force",
+	}
+	if rule.ioBytePerSecond > 0 {
+		args = append(args, "-ioBytePerSecond="+strconv.FormatInt(rule.ioBytePerSecond, 10))
+	}
+	return "volume.tier.move", args
+}
+
+type TieringJob struct {
+	VolumeId   needle.VolumeId
+	Collection string
+	Rule       string
+	Command    string
+	State      string
+	Planned    time.Time
+	Started    time.Time
+	Finished   time.Time
+	Message    string
+
+	rule *tieringRule
+}
+
+// planTiering selects the volumes to tier, with the first matching rule.
+// All replicas of a volume have to match the rule.
+func planTiering(topologyInfo *master_pb.TopologyInfo, rules []*tieringRule, volumeSizeLimit uint64, now time.Time) (jobs []*TieringJob) {
+	replicas := make(map[needle.VolumeId][]*master_pb.VolumeInformationMessage)
+	for _, dc := range topologyInfo.DataCenterInfos {
+		for _, rack := range dc.RackInfos {
+			for _, dn := range rack.DataNodeInfos {
+				for _, diskInfo := range dn.DiskInfos {
+					for _, v := range diskInfo.VolumeInfos {
+						vid := needle.VolumeId(v.Id)
+						replicas[vid] = append(replicas[vid], v)
+					}
+				}
+			}
+		}
+	}
+
+	for vid, volumes := range replicas {
+		for _, rule := range rules {
+			matched := true
+			for _, v := range volumes {
+				if !rule.matches(v, volumeSizeLimit, now) {
+					matched = false
+					break
+				}
+			}
+			if !matched {
+				continue
+			}
+			name, args := rule.commandArgs(vid, volumes[0].Collection)
+			jobs = append(jobs, &TieringJob{
+				VolumeId:   vid,
+				Collection: volumes[0].Collection,
+				Rule:       rule.line,
+				Command:    name + " " + strings.Join(args, " "),
+				State:      tieringJobPlanned,
+				Planned:    now,
+				rule:       rule,
+			})
+			break
+		}
+	}
+	sort.Slice(jobs, func(i, j int) bool {
+		return jobs[i].VolumeId < jobs[j].VolumeId
+	})
+	return
+}
+
+type TieringStatus struct {
+	Enabled       bool
+	DryRun        bool
+	MaxConcurrent int
+	LastCheck     time.Time
+	Rules         []string
+	Planned       []TieringJob
+	Running       []TieringJob
+	History       []TieringJob
+}
+
+type volumeTiering struct {
+	sync.Mutex
+	rules           []*tieringRule
+	dryRun          bool
+	maxConcurrent   int
+	maxJobsPerCheck int
+	lastCheck       time.Time
+	planned         []*TieringJob
+	running         map[needle.VolumeId]*TieringJob
+	history         []*TieringJob
+	failedAt        map[needle.VolumeId]time.Time
+}
+
+func (t *volumeTiering) status() (status TieringStatus) {
+	if t == nil {
+		return
+	}
+	t.Lock()
+	defer t.Unlock()
+	status.Enabled = true
+	status.DryRun = t.dryRun
+	status.MaxConcurrent = t.maxConcurrent
+	status.LastCheck = t.lastCheck
+	for _, rule := range t.rules {
+		status.Rules = append(status.Rules, rule.line)
+	}
+	// copies, since the jobs are updated while running
+	for _, job := range t.planned {
+		status.Planned = append(status.Planned, *job)
+	}
+	for _, job := range t.running {
+		status.Running = append(status.Running, *job)
+	}
+	sort.Slice(status.Running, func(i, j int) bool {
+		return status.Running[i].VolumeId < status.Running[j].VolumeId
+	})
+	// most recent first
+	for i := len(t.history) - 1; i >= 0; i-- {
+		status.History = append(status.History, *t.history[i])
+	}
+	return
+}
+
+// plan keeps the jobs not running and not failed recently, up to the limit.
+func (t *volumeTiering) plan(candidates []*TieringJob, now time.Time) []*TieringJob {
+	t.Lock()
+	defer t.Unlock()
+	t.lastCheck = now
+	t.planned = t.planned[:0]
+	for _, job := range candidates {
+		if _, found := t.running[job.VolumeId]; found {
+			continue
+		}
+		if failedAt, found := t.failedAt[job.VolumeId]; found && now.Sub(failedAt) < tieringFailureBackoff {
+			continue
+		}
+		if t.maxJobsPerCheck > 0 && len(t.planned) >= t.maxJobsPerCheck {
+			break
+		}
+		t.planned = append(t.planned, job)
+	}
+	return append([]*TieringJob{}, t.planned...)
+}
+
+func (t *volumeTiering) start(job *TieringJob) {
+	t.Lock()
+	defer t.Unlock()
+	job.State = tieringJobRunning
+	job.Started = time.Now()
+	t.running[job.VolumeId] = job
+	for i, planned := range t.planned {
+		if planned == job {
+			t.planned = append(t.planned[:i], t.planned[i+1:]...)
+			break
+		}
+	}
+}
+
+func (t *volumeTiering) finish(job *TieringJob, err error, output string) {
+	t.Lock()
+	defer t.Unlock()
+	job.Finished = time.Now()
+	if err != nil {
+		job.State = tieringJobFailed
+		job.Message = err.Error()
+		t.failedAt[job.VolumeId] = job.Finished
+	} else {
+		job.State = tieringJobDone
+		job.Message = lastLine(output)
+		delete(t.failedAt, job.VolumeId)
+	}
+	delete(t.running, job.VolumeId)
+	t.history = append(t.history, job)
+	if len(t.history) > tieringHistorySize {
+		t.history = t.history[len(t.history)-tieringHistorySize:]
+	}
+}
+
+func lastLine(output string) string {
+	output = strings.TrimSpace(output)
+	if i := strings.LastIndexByte(output, '\n'); i >= 0 {
+		return output[i+1:]
+	}
+	return output
+}
+
+func (ms *MasterServer) startVolumeTiering() {
+	v := util.GetViper()
+	if !v.GetBool("master.tiering.enabled") {
+		return
+	}
+	rules, err := parseTieringRules(v.GetString("master.tiering.rules"))
+	if err != nil {
+		glog.Fatalf("master.tiering: %v", err)
+	}
+	if len(rules) == 0 {
+		glog.Warningf("master.tiering is enabled without rules")
+		return
+	}
+
+	v.SetDefault("master.tiering.dry_run", true)
+	v.SetDefault("master.tiering.check_interval_minutes", 60)
+	v.SetDefault("master.tiering.max_concurrent", 2)
+	v.SetDefault("master.tiering.max_volumes_per_check", 20)
+	checkInterval := time.Duration(v.GetInt("master.tiering.check_interval_minutes")) * time.Minute
+
+	ms.tiering = &volumeTiering{
+		rules:           rules,
+		dryRun:          v.GetBool("master.tiering.dry_run"),
+		maxConcurrent:   v.GetInt("master.tiering.max_concurrent"),
+		maxJobsPerCheck: v.GetInt("master.tiering.max_volumes_per_check"),
+		running:         make(map[needle.VolumeId]*TieringJob),
+		failedAt:        make(map[needle.VolumeId]time.Time),
+	}
+	if ms.tiering.maxConcurrent <= 0 {
+		ms.tiering.maxConcurrent = 1
+	}
+	glog.V(0).Infof("volume tiering with %d rules, dry run %v", len(rules), ms.tiering.dryRun)
+
+	masterAddress := string(ms.option.Master)
+	var shellOptions shell.ShellOptions
+	shellOptions.GrpcDialOption = security.LoadClientTLS(v, "grpc.master")
+	shellOptions.Masters = &masterAddress
+	shellOptions.Directory = "/"
+	emptyFilerGroup := ""
+	shellOptions.FilerGroup = &emptyFilerGroup
+
+	commandEnv := shell.NewCommandEnv(&shellOptions)
+	if !ms.tiering.dryRun {
+		go commandEnv.MasterClient.KeepConnectedToMaster(context.Background())
+	}
+
+	go func() {
+		for {
+			time.Sleep(checkInterval)
+			if ms.Topo.IsLeader() {
+				ms.checkVolumeTiering(commandEnv)
+			}
+		}
+	}()
+}
+
+func (ms *MasterServer) checkVolumeTiering(commandEnv *shell.CommandEnv) {
+	t := ms.tiering
+	volumeSizeLimit := uint64(ms.option.VolumeSizeLimitMB) * 1024 * 1024
+	jobs := t.plan(planTiering(ms.Topo.ToTopologyInfo(), t.rules, volumeSizeLimit, time.Now()), time.Now())
+	if len(jobs) == 0 {
+		return
+	}
+
+	if t.dryRun {
+		for _, job := range jobs {
+			glog.V(0).Infof("tiering dry run: volume %d would run: %s", job.VolumeId, job.Command)
+		}
+		return
+	}
+
+	if ms.MasterClient.GetMaster(context.Background()) == "" {
+		return
+	}
+	if err := runShellCommand(commandEnv, "lock", nil, io.Discard); err != nil {
+		glog.Errorf("tiering lock: %v", err)
+		return
+	}
+	defer runShellCommand(commandEnv, "unlock", nil, io.Discard)
+
+	var wg sync.WaitGroup
+	limiter := make(chan struct{}, t.maxConcurrent)
+	for _, job := range jobs {
+		if !ms.Topo.IsLeader() {
+			break
+		}
+		limiter <- struct{}{}
+		wg.Add(1)
+		go func(job *TieringJob) {
+			defer func() {
+				<-limiter
+				wg.Done()
+			}()
+			t.start(job)
+			glog.V(0).Infof("tiering volume %d: %s", job.VolumeId, job.Command)
+			name, args := job.rule.commandArgs(job.VolumeId, job.Collection)
+			var output bytes.Buffer
+			err := runShellCommand(commandEnv, name, args, &output)
+			if err != nil {
+				glog.Errorf("tiering volume %d: %v", job.VolumeId, err)
+			}
+			t.finish(job, err, output.String())
+		}(job)
+	}
+	wg.Wait()
+}
+
+func runShellCommand(commandEnv *shell.CommandEnv, name string, args []string, writer io.Writer) error {
+	for _, c := range shell.Commands {
+		if c.Name() == name {
+			return c.Do(args, commandEnv, writer)
+		}
+	}
+	return fmt.Errorf("unknown command %s", name)
+}
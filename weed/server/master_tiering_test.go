@@ -0,0 +1,104 @@
+package weed_server
+
+import (
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/gateway-dao/seaweedfs/weed/pb/master_pb"
+	"github.com/gateway-dao/seaweedfs/weed/storage/needle"
+)
+
+func TestPlanTiering(t *testing.T) {
+	rules, err := parseTieringRules(`
+		# cold pictures go to hdd first
+		move -collection=pic* -fromDiskType=ssd -toDiskType=hdd -quietFor=720h
+		upload -fromDiskType=hdd -dest=s3.default -readOnly -quietFor=2160h -fullPercent=50
+	`)
+	if err != nil {
+		t.Fatalf("parse: %v", err)
+	}
+	if len(rules) != 2 {
+		t.Fatalf("expected 2 rules, got %d", len(rules))
+	}
+
+	for _, bad := range []string{
+		"move -fromDiskType=hdd",
+		"upload -fromDiskType=hdd",
+		"copy -dest=s3.default",
+		"move -fromDiskType=ssd -toDiskType=hdd extra",
+	} {
+		if _, err := parseTieringRules(bad); err == nil {
+			t.Errorf("rule %q should be rejected", bad)
+		}
+	}
+
+	now := time.Now()
+	day := int64(24 * 3600)
+	limit := uint64(1000)
+	volume := func(id uint32, collection, diskType string, idleDays int64, size uint64, readOnly bool) *master_pb.VolumeInformationMessage {
+		return &master_pb.VolumeInformationMessage{
+			Id:               id,
+			Collection:       collection,
+			DiskType:         diskType,
+			ModifiedAtSecond: now.Unix() - idleDays*day,
+			Size:             size,
+			ReadOnly:         readOnly,
+		}
+	}
+	node := func(volumes ...*master_pb.VolumeInformationMessage) *master_pb.DataNodeInfo {
+		return &master_pb.DataNodeInfo{DiskInfos: map[string]*master_pb.DiskInfo{"": {VolumeInfos: volumes}}}
+	}
+	remote := volume(7, "", "", 100, 900, true)
+	remote.RemoteStorageName = "s3.default"
+	topologyInfo := &master_pb.TopologyInfo{
+		DataCenterInfos: []*master_pb.DataCenterInfo{{
+			RackInfos: []*master_pb.RackInfo{{
+				DataNodeInfos: []*master_pb.DataNodeInfo{
+					node(
+						volume(1, "pics", "ssd", 31, 10, false), // moved
+						volume(2, "pics", "ssd", 10, 10, false), // recently written
+						volume(3, "docs", "ssd", 31, 10, false), // other collection
+						volume(4, "docs", "", 100, 900, true),   // uploaded
+						volume(5, "docs", "", 100, 100, true),   // too small
+						volume(6, "docs", "", 100, 900, false),  // writable
+						remote,                                  // already remote
+						volume(8, "docs", "", 100, 900, true),   // one replica is still written
+					),
+					node(
+						volume(8, "docs", "", 1, 900, true),
+					),
+				},
+			}},
+		}},
+	}
+
+	jobs := planTiering(topologyInfo, rules, limit, now)
+	if len(jobs) != 2 {
+		t.Fatalf("expected 2 jobs, got %+v", jobs)
+	}
+	if jobs[0].VolumeId != 1 || jobs[0].Command != "volume.tier.move -volumeId=1 -fromDiskType=ssd -toDiskType=hdd -force" {
+		t.Errorf("unexpected move job %+v", jobs[0])
+	}
+	if jobs[1].VolumeId != 4 || jobs[1].Command != "volume.tier.upload -volumeId=4 -collection=docs -dest=s3.default" {
+		t.Errorf("unexpected upload job %+v", jobs[1])
+	}
+
+	// running and recently failed volumes are not planned again
+	tiering := &volumeTiering{
+		running:  make(map[needle.VolumeId]*TieringJob),
+		failedAt: make(map[needle.VolumeId]time.Time),
+	}
+	planned := tiering.plan(jobs, now)
+	tiering.start(planned[0])
+	tiering.finish(planned[0], nil, "moved\n")
+	tiering.start(planned[1])
+	tiering.finish(planned[1], fmt.Errorf("no target server"), "")
+	if planned = tiering.plan(planTiering(topologyInfo, rules, limit, now), now); len(planned) != 1 || planned[0].VolumeId != 1 {
+		t.Errorf("failed volume should be skipped: %+v", planned)
+	}
+	status := tiering.status()
+	if len(status.History) != 2 || status.History[0].State != tieringJobFailed || status.History[1].Message != "moved" {
+		t.Errorf("unexpected history %+v", status.History)
+	}
+}
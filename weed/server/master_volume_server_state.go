@@ -0,0 +1,286 @@
+package weed_server
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"io"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/gateway-dao/seaweedfs/weed/glog"
+	"github.com/gateway-dao/seaweedfs/weed/pb/master_pb"
+	"github.com/gateway-dao/seaweedfs/weed/security"
+	"github.com/gateway-dao/seaweedfs/weed/shell"
+	"github.com/gateway-dao/seaweedfs/weed/topology"
+	"github.com/gateway-dao/seaweedfs/weed/util"
+	"github.com/seaweedfs/raft"
+)
+
+/*
+Volume servers are taken out of service by setting their state to draining or maintenance,
+with the SetVolumeServerState gRPC, /vol/server/state, or the volumeServer.state shell command.
+The states are kept in the raft log, see topology.NodeState.
+
+The leader master evacuates the draining volume servers one at a time in the background,
+with "volumeServer.evacuate -skipNonMoveable -force" while holding the admin lock.
+The progress is the count of volumes and ec shards still on the volume server.
+*/
+
+const (
+	evacuationRunning = "running"
+	evacuationDone    = "done"
+	evacuationFailed  = "failed"
+
+	evacuationCheckInterval = time.Minute
+	evacuationRetryInterval = 10 * time.Minute
+)
+
+type volumeServerEvacuation struct {
+	state        string
+	message      string
+	initialCount uint64
+	updatedAt    time.Time
+}
+
+// volumeServerEvacuations tracks the evacuations on the leader, by volume server url.
+// A new leader starts tracking the draining volume servers from their current volume counts.
+type volumeServerEvacuations struct {
+	sync.Mutex
+	evacuations map[string]*volumeServerEvacuation
+}
+
+func newVolumeServerEvacuations() *volumeServerEvacuations {
+	return &volumeServerEvacuations{
+		evacuations: make(map[string]*volumeServerEvacuation),
+	}
+}
+
+func (e *volumeServerEvacuations) start(node string, count uint64, now time.Time) {
+	e.Lock()
+	defer e.Unlock()
+	e.evacuations[node] = &volumeServerEvacuation{
+		state:        evacuationRunning,
+		initialCount: count,
+		updatedAt:    now,
+	}
+}
+
+// track returns the evacuation of the volume server, and starts tracking it if not yet.
+func (e *volumeServerEvacuations) track(node string, count uint64, now time.Time) volumeServerEvacuation {
+	e.Lock()
+	defer e.Unlock()
+	evacuation, found := e.evacuations[node]
+	if !found {
+		evacuation = &volumeServerEvacuation{
+			state:        evacuationRunning,
+			initialCount: count,
+			updatedAt:    now,
+		}
+		e.evacuations[node] = evacuation
+	}
+	return *evacuation
+}
+
+func (e *volumeServerEvacuations) update(node, state, message string, now time.Time) {
+	e.Lock()
+	defer e.Unlock()
+	if evacuation, found := e.evacuations[node]; found {
+		evacuation.state, evacuation.message, evacuation.updatedAt = state, message, now
+	}
+}
+
+func (e *volumeServerEvacuations) remove(node string) {
+	e.Lock()
+	defer e.Unlock()
+	delete(e.evacuations, node)
+}
+
+func (e *volumeServerEvacuations) get(node string) (evacuation volumeServerEvacuation, found bool) {
+	e.Lock()
+	defer e.Unlock()
+	if p, ok := e.evacuations[node]; ok {
+		return *p, true
+	}
+	return
+}
+
+// dataNodeItemCount is the count of volumes and ec shards on the data node
+func dataNodeItemCount(dn *topology.DataNode) uint64 {
+	if dn == nil {
+		return 0
+	}
+	info := dn.ToInfo()
+	return uint64(info.Volumes + info.EcShards)
+}
+
+func (ms *MasterServer) volumeServerState(node string, state topology.NodeState) *master_pb.VolumeServerState {
+	s := &master_pb.VolumeServerState{
+		Node:           node,
+		State:          string(state),
+		RemainingCount: dataNodeItemCount(ms.Topo.FindDataNode(node)),
+	}
+	if state != topology.NodeStateDraining {
+		return s
+	}
+	if evacuation, found := ms.evacuations.get(node); found {
+		s.Evacuation = evacuation.state
+		s.Message = evacuation.message
+		s.InitialCount = evacuation.initialCount
+		s.UpdatedAtNs = evacuation.updatedAt.UnixNano()
+	}
+	return s
+}
+
+func (ms *MasterServer) SetVolumeServerState(ctx context.Context, req *master_pb.SetVolumeServerStateRequest) (*master_pb.SetVolumeServerStateResponse, error) {
+	if !ms.Topo.IsLeader() {
+		return nil, raft.NotLeaderError
+	}
+
+	state, err := topology.ParseNodeState(req.State)
+	if err != nil {
+		return nil, err
+	}
+	// an offline volume server can still be returned to active
+	dn := ms.Topo.FindDataNode(req.Node)
+	if dn == nil && state != topology.NodeStateActive {
+		return nil, fmt.Errorf("volume server %s not found", req.Node)
+	}
+
+	if err = ms.Topo.SetNodeState(req.Node, state); err != nil {
+		return nil, fmt.Errorf("set volume server %s %s: %v", req.Node, state, err)
+	}
+	if state == topology.NodeStateDraining {
+		ms.evacuations.start(req.Node, dataNodeItemCount(dn), time.Now())
+	} else {
+		ms.evacuations.remove(req.Node)
+	}
+
+	return &master_pb.SetVolumeServerStateResponse{
+		State: ms.volumeServerState(req.Node, state),
+	}, nil
+}
+
+func (ms *MasterServer) ListVolumeServerStates(ctx context.Context, req *master_pb.ListVolumeServerStatesRequest) (*master_pb.ListVolumeServerStatesResponse, error) {
+	if !ms.Topo.IsLeader() {
+		return nil, raft.NotLeaderError
+	}
+
+	states := ms.Topo.NodeStates()
+	var nodes []string
+	for node := range states {
+		nodes = append(nodes, node)
+	}
+	sort.Strings(nodes)
+
+	resp := &master_pb.ListVolumeServerStatesResponse{}
+	for _, node := range nodes {
+		resp.States = append(resp.States, ms.volumeServerState(node, states[node]))
+	}
+	return resp, nil
+}
+
+func (ms *MasterServer) startVolumeServerEvacuation() {
+	v := util.GetViper()
+
+	masterAddress := string(ms.option.Master)
+	var shellOptions shell.ShellOptions
+	shellOptions.GrpcDialOption = security.LoadClientTLS(v, "grpc.master")
+	shellOptions.Masters = &masterAddress
+	shellOptions.Directory = "/"
+	emptyFilerGroup := ""
+	shellOptions.FilerGroup = &emptyFilerGroup
+
+	commandEnv := shell.NewCommandEnv(&shellOptions)
+	var connectOnce sync.Once
+
+	go func() {
+		for {
+			time.Sleep(evacuationCheckInterval)
+			if !ms.Topo.IsLeader() {
+				continue
+			}
+			if nodes := ms.pendingEvacuations(time.Now()); len(nodes) > 0 {
+				// only connect when there is something to evacuate
+				connectOnce.Do(func() {
+					go commandEnv.MasterClient.KeepConnectedToMaster(context.Background())
+				})
+				ms.evacuateVolumeServers(commandEnv, nodes)
+			}
+		}
+	}()
+}
+
+// pendingEvacuations returns the draining volume servers with volumes or ec shards left,
+// skipping the recently failed ones.
+func (ms *MasterServer) pendingEvacuations(now time.Time) (nodes []string) {
+	for node, state := range ms.Topo.NodeStates() {
+		if state != topology.NodeStateDraining {
+			continue
+		}
+		dn := ms.Topo.FindDataNode(node)
+		remaining := dataNodeItemCount(dn)
+		evacuation := ms.evacuations.track(node, remaining, now)
+		switch {
+		case dn == nil:
+			ms.evacuations.update(node, evacuation.state, "volume server is not connected", evacuation.updatedAt)
+		case remaining == 0:
+			if evacuation.state != evacuationDone {
+				ms.evacuations.update(node, evacuationDone, "", now)
+			}
+		case evacuation.state == evacuationFailed && now.Before(evacuation.updatedAt.Add(evacuationRetryInterval)):
+		default:
+			nodes = append(nodes, node)
+		}
+	}
+	sort.Strings(nodes)
+	return
+}
+
+func (ms *MasterServer) evacuateVolumeServers(commandEnv *shell.CommandEnv, nodes []string) {
+	if ms.MasterClient.GetMaster(context.Background()) == "" {
+		return
+	}
+	// leave the cluster to the operators holding the admin lock
+	if client, message, isLocked := ms.adminLocks.isLocked(shellAdminLockName); isLocked {
+		glog.V(0).Infof("volume server evacuation waits for the admin lock held by %s: %s", client, message)
+		return
+	}
+	if err := runShellCommand(commandEnv, "lock", nil, io.Discard); err != nil {
+		glog.Errorf("volume server evacuation lock: %v", err)
+		return
+	}
+	defer runShellCommand(commandEnv, "unlock", nil, io.Discard)
+
+	for _, node := range nodes {
+		// the state may have changed while evacuating the other volume servers
+		if !ms.Topo.IsLeader() || ms.Topo.GetNodeState(node) != topology.NodeStateDraining {
+			continue
+		}
+		ms.evacuations.update(node, evacuationRunning, "", time.Now())
+		glog.V(0).Infof("evacuating volume server %s", node)
+
+		var output bytes.Buffer
+		err := runShellCommand(commandEnv, "volumeServer.evacuate", []string{"-node=" + node, "-skipNonMoveable", "-force"}, &output)
+		glog.V(1).Infof("evacuate volume server %s: %s", node, output.String())
+		if err != nil {
+			glog.Errorf("evacuate volume server %s: %v", node, err)
+			ms.evacuations.update(node, evacuationFailed, err.Error(), time.Now())
+			continue
+		}
+
+		// the topology is updated by the heartbeats of the volume servers
+		if remaining := dataNodeItemCount(ms.Topo.FindDataNode(node)); remaining > 0 {
+			message := fmt.Sprintf("%d volumes and ec shards are not moved yet", remaining)
+			if lines := strings.Split(strings.TrimSpace(output.String()), "\n"); len(lines) > 0 && lines[len(lines)-1] != "" {
+				message += ": " + lines[len(lines)-1]
+			}
+			ms.evacuations.update(node, evacuationFailed, message, time.Now())
+			continue
+		}
+		ms.evacuations.update(node, evacuationDone, "", time.Now())
+		glog.V(0).Infof("evacuated volume server %s", node)
+	}
+}
@@ -0,0 +1,167 @@
+package weed_server
+
+import (
+	"fmt"
+	"io"
+	"testing"
+	"time"
+
+	hashicorpRaft "github.com/hashicorp/raft"
+
+	"github.com/gateway-dao/seaweedfs/weed/sequence"
+	"github.com/gateway-dao/seaweedfs/weed/storage/erasure_coding"
+	"github.com/gateway-dao/seaweedfs/weed/topology"
+)
+
+type testRaftMaster struct {
+	topo      *topology.Topology
+	raft      *hashicorpRaft.Raft
+	transport *hashicorpRaft.InmemTransport
+}
+
+// newTestRaftMasters starts masters with raft sequencers, connected by in-memory raft transports.
+func newTestRaftMasters(t *testing.T, n int, rangeSize uint64) []*testRaftMaster {
+	var masters []*testRaftMaster
+	var servers []hashicorpRaft.Server
+	for i := 0; i < n; i++ {
+		m := &testRaftMaster{}
+		m.topo = topology.NewTopology("topo", nil, 32*1024, 5, false)
+		m.topo.Sequence = sequence.NewRaftSequencer(rangeSize, m.topo.ReserveFileIds)
+		var addr hashicorpRaft.ServerAddress
+		addr, m.transport = hashicorpRaft.NewInmemTransport(hashicorpRaft.ServerAddress(fmt.Sprintf("master%d", i)))
+		servers = append(servers, hashicorpRaft.Server{ID: hashicorpRaft.ServerID(addr), Address: addr})
+		masters = append(masters, m)
+	}
+	for _, m := range masters {
+		for _, peer := range masters {
+			m.transport.Connect(peer.transport.LocalAddr(), peer.transport)
+		}
+	}
+	for i, m := range masters {
+		c := hashicorpRaft.DefaultConfig()
+		c.LocalID = servers[i].ID
+		c.HeartbeatTimeout = 50 * time.Millisecond
+		c.ElectionTimeout = 50 * time.Millisecond
+		c.LeaderLeaseTimeout = 50 * time.Millisecond
+		c.CommitTimeout = 5 * time.Millisecond
+		c.LogOutput = io.Discard
+		r, err := hashicorpRaft.NewRaft(c, &StateMachine{topo: m.topo}, hashicorpRaft.NewInmemStore(), hashicorpRaft.NewInmemStore(),
+			hashicorpRaft.NewInmemSnapshotStore(), m.transport)
+		if err != nil {
+			t.Fatalf("start raft %d: %v", i, err)
+		}
+		m.raft = r
+		m.topo.HashicorpRaft = r
+		t.Cleanup(func() { r.Shutdown() })
+	}
+	if err := masters[0].raft.BootstrapCluster(hashicorpRaft.Configuration{Servers: servers}).Error(); err != nil {
+		t.Fatalf("bootstrap: %v", err)
+	}
+	return masters
+}
+
+func waitForRaftLeader(t *testing.T, masters []*testRaftMaster, except *testRaftMaster) *testRaftMaster {
+	for start := time.Now(); time.Since(start) < 10*time.Second; time.Sleep(10 * time.Millisecond) {
+		for _, m := range masters {
+			if m != except && m.raft.State() == hashicorpRaft.Leader {
+				return m
+			}
+		}
+	}
+	t.Fatalf("no leader elected")
+	return nil
+}
+
+func allocateFileIds(t *testing.T, m *testRaftMaster, count int, seen map[uint64]bool) {
+	for i := 0; i < count; i++ {
+		id, err := m.topo.Sequence.NextFileId(1)
+		if err != nil {
+			t.Fatalf("allocate file id: %v", err)
+		}
+		if seen[id] {
+			t.Fatalf("file id %d is handed out twice", id)
+		}
+		seen[id] = true
+	}
+}
+
+func TestRaftSequencerLeaderChanges(t *testing.T) {
+	masters := newTestRaftMasters(t, 3, 10)
+	seen := make(map[uint64]bool)
+
+	leader := waitForRaftLeader(t, masters, nil)
+	allocateFileIds(t, leader, 25, seen)
+
+	// transfer the leadership, the previous leader keeps the rest of its range
+	if err := leader.raft.LeadershipTransfer().Error(); err != nil {
+		t.Fatalf("leadership transfer: %v", err)
+	}
+	previous := leader
+	leader = waitForRaftLeader(t, masters, previous)
+	allocateFileIds(t, leader, 25, seen)
+	allocateFileIds(t, previous, 5, seen)
+	if _, err := previous.topo.Sequence.NextFileId(10); err == nil {
+		t.Fatalf("a follower reserved file ids")
+	}
+
+	// the volume servers report file ids from before the raft sequencer
+	leader.topo.Sequence.SetMax(1000)
+	allocateFileIds(t, leader, 5, seen)
+	if !seen[1001] {
+		t.Fatalf("file ids do not continue after the reported max file key")
+	}
+
+	// the leader fails
+	leader.raft.Shutdown()
+	failed := leader
+	leader = waitForRaftLeader(t, masters, failed)
+	allocateFileIds(t, leader, 25, seen)
+
+	// every master knows the reserved ranges
+	if err := leader.raft.Barrier(time.Second).Error(); err != nil {
+		t.Fatalf("barrier: %v", err)
+	}
+	for _, m := range masters {
+		if m == failed {
+			continue
+		}
+		for start := time.Now(); m.topo.GetMaxFileId() != leader.topo.GetMaxFileId() && time.Since(start) < 5*time.Second; {
+			time.Sleep(10 * time.Millisecond)
+		}
+		if m.topo.GetMaxFileId() != leader.topo.GetMaxFileId() {
+			t.Fatalf("max file id %d, expected %d", m.topo.GetMaxFileId(), leader.topo.GetMaxFileId())
+		}
+	}
+}
+
+func TestRaftStateMachineRecovery(t *testing.T) {
+	topo := topology.NewTopology("topo", nil, 32*1024, 5, false)
+	topo.UpAdjustMaxVolumeId(7)
+	topo.UpAdjustMaxFileId(12345)
+	topo.ApplyNodeState(topology.NewSetNodeStateCommand("127.0.0.1:8080", topology.NodeStateDraining))
+	topo.ApplyCollectionEcScheme(topology.NewSetCollectionEcSchemeCommand("logs", erasure_coding.EcScheme{DataShards: 6, ParityShards: 3}))
+	data, err := StateMachine{topo: topo}.Save()
+	if err != nil {
+		t.Fatalf("save: %v", err)
+	}
+
+	restored := topology.NewTopology("topo", nil, 32*1024, 5, false)
+	if err = (StateMachine{topo: restored}).Recovery(data); err != nil {
+		t.Fatalf("recovery: %v", err)
+	}
+	if restored.GetMaxVolumeId() != 7 || restored.GetMaxFileId() != 12345 {
+		t.Fatalf("restored max volume id %d max file id %d", restored.GetMaxVolumeId(), restored.GetMaxFileId())
+	}
+	if state := restored.GetNodeState("127.0.0.1:8080"); state != topology.NodeStateDraining {
+		t.Fatalf("restored volume server state %s", state)
+	}
+	if scheme := restored.GetCollectionEcScheme("logs"); scheme.String() != "6+3" {
+		t.Fatalf("restored ec scheme %s", scheme)
+	}
+
+	// snapshots from before the raft sequencer
+	old := topology.NewTopology("topo", nil, 32*1024, 5, false)
+	if err = (StateMachine{topo: old}).Recovery([]byte(`{"maxVolumeId":3}`)); err != nil || old.GetMaxVolumeId() != 3 {
+		t.Fatalf("recover old snapshot: %v", err)
+	}
+}
@@ -51,25 +51,57 @@ type StateMachine struct {
 var _ hashicorpRaft.FSM = &StateMachine{}
 
 func (s StateMachine) Save() ([]byte, error) {
-	state := topology.MaxVolumeIdCommand{
-		MaxVolumeId: s.topo.GetMaxVolumeId(),
+	state := topology.ClusterState{
+		MaxVolumeId:         s.topo.GetMaxVolumeId(),
+		MaxFileId:           s.topo.GetMaxFileId(),
+		NodeStates:          s.topo.NodeStates(),
+		CollectionEcSchemes: s.topo.CollectionEcSchemes(),
 	}
 	glog.V(1).Infof("Save raft state %+v", state)
 	return json.Marshal(state)
 }
 
 func (s StateMachine) Recovery(data []byte) error {
-	state := topology.MaxVolumeIdCommand{}
+	state := topology.ClusterState{}
 	err := json.Unmarshal(data, &state)
 	if err != nil {
 		return err
 	}
 	glog.V(1).Infof("Recovery raft state %+v", state)
 	s.topo.UpAdjustMaxVolumeId(state.MaxVolumeId)
+	s.topo.UpAdjustMaxFileId(state.MaxFileId)
+	s.topo.RestoreNodeStates(state.NodeStates)
+	s.topo.RestoreCollectionEcSchemes(state.CollectionEcSchemes)
 	return nil
 }
 
 func (s *StateMachine) Apply(l *hashicorpRaft.Log) interface{} {
+	reserveFileIds := topology.ReserveFileIdsCommand{}
+	if err := json.Unmarshal(l.Data, &reserveFileIds); err != nil {
+		return err
+	}
+	if reserveFileIds.Count > 0 {
+		return s.topo.ApplyReserveFileIds(&reserveFileIds)
+	}
+
+	nodeState := topology.SetNodeStateCommand{}
+	if err := json.Unmarshal(l.Data, &nodeState); err != nil {
+		return err
+	}
+	if nodeState.Node != "" {
+		s.topo.ApplyNodeState(&nodeState)
+		return nil
+	}
+
+	ecScheme := topology.SetCollectionEcSchemeCommand{}
+	if err := json.Unmarshal(l.Data, &ecScheme); err != nil {
+		return err
+	}
+	if ecScheme.Scheme != "" {
+		s.topo.ApplyCollectionEcScheme(&ecScheme)
+		return nil
+	}
+
 	before := s.topo.GetMaxVolumeId()
 	state := topology.MaxVolumeIdCommand{}
 	err := json.Unmarshal(l.Data, &state)
@@ -83,8 +115,11 @@ func (s *StateMachine) Apply(l *hashicorpRaft.Log) interface{} {
 }
 
 func (s *StateMachine) Snapshot() (hashicorpRaft.FSMSnapshot, error) {
-	return &topology.MaxVolumeIdCommand{
-		MaxVolumeId: s.topo.GetMaxVolumeId(),
+	return &topology.ClusterState{
+		MaxVolumeId:         s.topo.GetMaxVolumeId(),
+		MaxFileId:           s.topo.GetMaxFileId(),
+		NodeStates:          s.topo.NodeStates(),
+		CollectionEcSchemes: s.topo.CollectionEcSchemes(),
 	}, nil
 }
 
@@ -112,6 +147,9 @@ func NewRaftServer(option *RaftServerOption) (*RaftServer, error) {
 	}
 
 	raft.RegisterCommand(&topology.MaxVolumeIdCommand{})
+	raft.RegisterCommand(&topology.ReserveFileIdsCommand{})
+	raft.RegisterCommand(&topology.SetNodeStateCommand{})
+	raft.RegisterCommand(&topology.SetCollectionEcSchemeCommand{})
 
 	var err error
 	transporter := raft.NewGrpcTransporter(option.GrpcDialOption)
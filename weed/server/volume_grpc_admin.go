@@ -52,6 +52,7 @@ func (vs *VolumeServer) AllocateVolume(ctx context.Context, req *volume_server_p
 		req.MemoryMapMaxSizeMb,
 		types.ToDiskType(req.DiskType),
 		vs.ldbTimout,
+		req.Encryption,
 	)
 
 	if err != nil {
@@ -36,6 +36,8 @@ func (vs *VolumeServer) checkWithMaster() (err error) {
 				}
 				vs.metricsAddress, vs.metricsIntervalSec = resp.MetricsAddress, int(resp.MetricsIntervalSeconds)
 				backend.LoadFromPbStorageBackends(resp.StorageBackends)
+				vs.ioQos.Configure(resp.IoQos)
+				vs.asyncReplication.Configure(resp.AsyncReplication)
 				return nil
 			})
 			if err == nil {
@@ -133,6 +135,11 @@ func (vs *VolumeServer) doHeartbeat(masterAddress pb.ServerAddress, grpcDialOpti
 				glog.Errorf("Shut down Volume Server due to duplicate volume directories: %v", duplicateDir)
 				os.Exit(1)
 			}
+			if in.GetVolumeSizeLimit() != 0 {
+				// the i/o budgets and async replication come with the volume size limit, when registered to a master
+				vs.ioQos.Configure(in.GetIoQos())
+				vs.asyncReplication.Configure(in.GetAsyncReplication())
+			}
 			if in.GetVolumeSizeLimit() != 0 && vs.store.GetVolumeSizeLimit() != in.GetVolumeSizeLimit() {
 				vs.store.SetVolumeSizeLimit(in.GetVolumeSizeLimit())
 				if vs.store.MaybeAdjustVolumeMax() {
@@ -0,0 +1,77 @@
+package weed_server
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/gateway-dao/seaweedfs/weed/pb/volume_server_pb"
+	"github.com/gateway-dao/seaweedfs/weed/storage/needle"
+	"github.com/gateway-dao/seaweedfs/weed/util"
+)
+
+func (vs *VolumeServer) VolumeAddCompressionDictionary(ctx context.Context, req *volume_server_pb.VolumeAddCompressionDictionaryRequest) (*volume_server_pb.VolumeAddCompressionDictionaryResponse, error) {
+
+	dictId, err := vs.store.AddCompressionDictionary(req.Collection, req.Dictionary)
+	if err != nil {
+		return nil, err
+	}
+
+	return &volume_server_pb.VolumeAddCompressionDictionaryResponse{
+		DictionaryId: dictId,
+	}, nil
+}
+
+func (vs *VolumeServer) VolumeCompressionDictionaries(ctx context.Context, req *volume_server_pb.VolumeCompressionDictionariesRequest) (*volume_server_pb.VolumeCompressionDictionariesResponse, error) {
+
+	dictionaries, err := vs.store.ReadCompressionDictionaries(req.Collection)
+	if err != nil {
+		return nil, err
+	}
+
+	resp := &volume_server_pb.VolumeCompressionDictionariesResponse{}
+	for _, d := range dictionaries {
+		resp.Dictionaries = append(resp.Dictionaries, &volume_server_pb.VolumeCompressionDictionariesResponse_Dictionary{
+			DictionaryId: d.DictId,
+			Dictionary:   d.Dictionary,
+			CreatedAtNs:  d.CreatedAt.UnixNano(),
+		})
+	}
+	return resp, nil
+}
+
+// copyCompressionDictionaries saves the dictionaries of the collection on the source volume server,
+// so the volumes and ec shards copied from it can be read here.
+func (vs *VolumeServer) copyCompressionDictionaries(client volume_server_pb.VolumeServerClient, collection string) error {
+	resp, err := client.VolumeCompressionDictionaries(context.Background(), &volume_server_pb.VolumeCompressionDictionariesRequest{
+		Collection: collection,
+	})
+	if err != nil {
+		return fmt.Errorf("read compression dictionaries of collection %q: %v", collection, err)
+	}
+	for _, d := range resp.Dictionaries {
+		if _, err = vs.store.SaveCompressionDictionary(collection, d.Dictionary, time.Unix(0, d.CreatedAtNs)); err != nil {
+			return fmt.Errorf("save compression dictionary %d of collection %q: %v", d.DictionaryId, collection, err)
+		}
+	}
+	return nil
+}
+
+// needleCompressor returns how to compress uploaded data not compressed by the client.
+// Zstd uses the dictionary trained for the collection of the volume, if any.
+func (vs *VolumeServer) needleCompressor(volumeId needle.VolumeId) util.Compressor {
+	if vs.compression != "zstd" {
+		return nil
+	}
+	v := vs.store.GetVolume(volumeId)
+	if v == nil {
+		return util.ZstdData
+	}
+	dictId, found := vs.store.CompressionDictionary(v.Collection)
+	if !found {
+		return util.ZstdData
+	}
+	return func(input []byte) ([]byte, error) {
+		return util.ZstdDataWithDictionary(input, dictId)
+	}
+}
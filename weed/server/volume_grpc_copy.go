@@ -18,6 +18,7 @@ import (
 	"github.com/gateway-dao/seaweedfs/weed/storage"
 	"github.com/gateway-dao/seaweedfs/weed/storage/erasure_coding"
 	"github.com/gateway-dao/seaweedfs/weed/storage/needle"
+	"github.com/gateway-dao/seaweedfs/weed/storage/qos"
 	"github.com/gateway-dao/seaweedfs/weed/storage/types"
 	"github.com/gateway-dao/seaweedfs/weed/util"
 )
@@ -25,6 +26,7 @@ import (
 const BufferSizeLimit = 1024 * 1024 * 2
 
 // VolumeCopy copy the .idx .dat .vif files, and mount the volume
+// The copy progress is checkpointed in a .cpy file, so that a retry from the same source resumes an interrupted copy.
 func (vs *VolumeServer) VolumeCopy(req *volume_server_pb.VolumeCopyRequest, stream volume_server_pb.VolumeServer_VolumeCopyServer) error {
 
 	v := vs.store.GetVolume(needle.VolumeId(req.VolumeId))
@@ -49,8 +51,7 @@ func (vs *VolumeServer) VolumeCopy(req *volume_server_pb.VolumeCopyRequest, stre
 	var volFileInfoResp *volume_server_pb.ReadVolumeFileStatusResponse
 	var dataBaseFileName, indexBaseFileName, idxFileName, datFileName string
 	var hasRemoteDatFile bool
-	err := operation.WithVolumeServerClient(true, pb.ServerAddress(req.SourceDataNode), vs.grpcDialOption, func(client volume_server_pb.VolumeServerClient) error {
-		var err error
+	err := operation.WithVolumeServerClient(true, pb.ServerAddress(req.SourceDataNode), vs.grpcDialOption, func(client volume_server_pb.VolumeServerClient) (err error) {
 		volFileInfoResp, err = client.ReadVolumeFileStatus(context.Background(),
 			&volume_server_pb.ReadVolumeFileStatusRequest{
 				VolumeId: req.VolumeId,
@@ -58,28 +59,60 @@ func (vs *VolumeServer) VolumeCopy(req *volume_server_pb.VolumeCopyRequest, stre
 		if nil != err {
 			return fmt.Errorf("read volume file status failed, %v", err)
 		}
+		hasRemoteDatFile = volFileInfoResp.VolumeInfo != nil && len(volFileInfoResp.VolumeInfo.Files) > 0
 
-		diskType := volFileInfoResp.DiskType
-		if req.DiskType != "" {
-			diskType = req.DiskType
+		if err = vs.copyCompressionDictionaries(client, volFileInfoResp.Collection); err != nil {
+			return err
 		}
-		location := vs.store.FindFreeLocation(types.ToDiskType(diskType))
+
+		// resume an interrupted copy from the same source, unless the source volume is compacted since
+		location, checkpoint := vs.store.FindCopyCheckpoint(volFileInfoResp.Collection, needle.VolumeId(req.VolumeId))
+		if checkpoint != nil && (hasRemoteDatFile || checkpoint.SourceDataNode != req.SourceDataNode ||
+			checkpoint.CompactionRevision != volFileInfoResp.CompactionRevision || checkpoint.DatFileSize > volFileInfoResp.DatFileSize) {
+			glog.V(0).Infof("discard interrupted copy of volume %d from %s", req.VolumeId, checkpoint.SourceDataNode)
+			removeVolumeCopyFiles(storage.VolumeFileName(location.Directory, volFileInfoResp.Collection, int(req.VolumeId)),
+				storage.VolumeFileName(location.IdxDirectory, volFileInfoResp.Collection, int(req.VolumeId)))
+			location, checkpoint = nil, nil
+		}
+
 		if location == nil {
-			return fmt.Errorf("no space left for disk type %s", types.ToDiskType(diskType).ReadableString())
+			diskType := volFileInfoResp.DiskType
+			if req.DiskType != "" {
+				diskType = req.DiskType
+			}
+			location = vs.store.FindFreeLocation(types.ToDiskType(diskType))
+			if location == nil {
+				return fmt.Errorf("no space left for disk type %s", types.ToDiskType(diskType).ReadableString())
+			}
 		}
 
 		dataBaseFileName = storage.VolumeFileName(location.Directory, volFileInfoResp.Collection, int(req.VolumeId))
 		indexBaseFileName = storage.VolumeFileName(location.IdxDirectory, volFileInfoResp.Collection, int(req.VolumeId))
-		hasRemoteDatFile = volFileInfoResp.VolumeInfo != nil && len(volFileInfoResp.VolumeInfo.Files) > 0
+		idxFileName = indexBaseFileName + ".idx"
+		datFileName = dataBaseFileName + ".dat"
+
+		var copiedDatSize uint64
+		if checkpoint != nil {
+			copiedDatSize = checkpoint.CopiedDatSize
+		}
+		checkpoint = &storage.CopyCheckpoint{
+			SourceDataNode:     req.SourceDataNode,
+			Collection:         volFileInfoResp.Collection,
+			CompactionRevision: volFileInfoResp.CompactionRevision,
+			DatFileSize:        volFileInfoResp.DatFileSize,
+			CopiedDatSize:      copiedDatSize,
+		}
 
 		util.WriteFile(dataBaseFileName+".note", []byte(fmt.Sprintf("copying from %s", req.SourceDataNode)), 0755)
 
 		defer func() {
 			if err != nil {
-				os.Remove(dataBaseFileName + ".dat")
-				os.Remove(indexBaseFileName + ".idx")
-				os.Remove(dataBaseFileName + ".vif")
-				os.Remove(dataBaseFileName + ".note")
+				// keep the copied data for a retry to resume
+				if checkpoint.CopiedDatSize > 0 {
+					glog.V(0).Infof("copy volume %d from %s interrupted after %d bytes: %v", req.VolumeId, req.SourceDataNode, checkpoint.CopiedDatSize, err)
+					return
+				}
+				removeVolumeCopyFiles(dataBaseFileName, indexBaseFileName)
 			}
 		}()
 
@@ -97,7 +130,7 @@ func (vs *VolumeServer) VolumeCopy(req *volume_server_pb.VolumeCopyRequest, stre
 			glog.V(0).Infof("connect to %s: %v", vs.GetMaster(context.Background()), grpcErr)
 		}
 
-		if preallocateSize > 0 && !hasRemoteDatFile {
+		if preallocateSize > 0 && !hasRemoteDatFile && copiedDatSize == 0 {
 			volumeFile := dataBaseFileName + ".dat"
 			_, err := backend.CreateVolumeFile(volumeFile, preallocateSize, 0)
 			if err != nil {
@@ -106,7 +139,9 @@ func (vs *VolumeServer) VolumeCopy(req *volume_server_pb.VolumeCopyRequest, stre
 		}
 
 		// println("source:", volFileInfoResp.String())
-		copyResponse := &volume_server_pb.VolumeCopyResponse{}
+		copyResponse := &volume_server_pb.VolumeCopyResponse{
+			TotalBytes: int64(volFileInfoResp.DatFileSize),
+		}
 		reportInterval := int64(1024 * 1024 * 128)
 		nextReportTarget := reportInterval
 		var modifiedTsNs int64
@@ -119,10 +154,48 @@ func (vs *VolumeServer) VolumeCopy(req *volume_server_pb.VolumeCopyRequest, stre
 		}
 		throttler := util.NewWriteThrottler(ioBytePerSecond)
 
+		copyIdxFile := func() error {
+			if modifiedTsNs, err = vs.doCopyFileWithThrottler(client, false, req.Collection, req.VolumeId, volFileInfoResp.CompactionRevision, volFileInfoResp.IdxFileSize, indexBaseFileName, ".idx", false, false, nil, throttler); err != nil {
+				return err
+			}
+			if modifiedTsNs > 0 {
+				os.Chtimes(idxFileName, time.Unix(0, modifiedTsNs), time.Unix(0, modifiedTsNs))
+			}
+			return nil
+		}
+
+		idxCopied := false
 		if !hasRemoteDatFile {
-			if modifiedTsNs, err = vs.doCopyFileWithThrottler(client, false, req.Collection, req.VolumeId, volFileInfoResp.CompactionRevision, volFileInfoResp.DatFileSize, dataBaseFileName, ".dat", false, true, func(processed int64) bool {
+			var startOffset uint64
+			if copiedDatSize > 0 {
+				// the .idx file tells the needle boundaries to resume from
+				if err = copyIdxFile(); err != nil {
+					return err
+				}
+				idxCopied = true
+				startOffset = findCopyResumeOffset(idxFileName, datFileName, copiedDatSize)
+				glog.V(0).Infof("resume copying volume %d from %s at %d of %d bytes", req.VolumeId, req.SourceDataNode, startOffset, volFileInfoResp.DatFileSize)
+				copyResponse.ResumedBytes = int64(startOffset)
+				copyResponse.ProcessedBytes = int64(startOffset)
+				if sendErr = stream.Send(copyResponse); sendErr != nil {
+					return sendErr
+				}
+			}
+			checkpoint.CopiedDatSize = startOffset
+			if err = checkpoint.Save(dataBaseFileName); err != nil {
+				return fmt.Errorf("save copy checkpoint: %v", err)
+			}
+
+			if modifiedTsNs, err = vs.doCopyDatFile(client, req, volFileInfoResp.CompactionRevision, startOffset, volFileInfoResp.DatFileSize, datFileName, func(dst *os.File, processed int64) bool {
 				if processed > nextReportTarget {
-					copyResponse.ProcessedBytes = processed
+					// only the bytes synced to disk can be resumed from
+					if syncErr := dst.Sync(); syncErr == nil {
+						checkpoint.CopiedDatSize = startOffset + uint64(processed)
+						if saveErr := checkpoint.Save(dataBaseFileName); saveErr != nil {
+							glog.Warningf("save copy checkpoint of volume %d: %v", req.VolumeId, saveErr)
+						}
+					}
+					copyResponse.ProcessedBytes = int64(startOffset) + processed
 					if sendErr = stream.Send(copyResponse); sendErr != nil {
 						return false
 					}
@@ -136,15 +209,14 @@ func (vs *VolumeServer) VolumeCopy(req *volume_server_pb.VolumeCopyRequest, stre
 				return sendErr
 			}
 			if modifiedTsNs > 0 {
-				os.Chtimes(dataBaseFileName+".dat", time.Unix(0, modifiedTsNs), time.Unix(0, modifiedTsNs))
+				os.Chtimes(datFileName, time.Unix(0, modifiedTsNs), time.Unix(0, modifiedTsNs))
 			}
 		}
 
-		if modifiedTsNs, err = vs.doCopyFileWithThrottler(client, false, req.Collection, req.VolumeId, volFileInfoResp.CompactionRevision, volFileInfoResp.IdxFileSize, indexBaseFileName, ".idx", false, false, nil, throttler); err != nil {
-			return err
-		}
-		if modifiedTsNs > 0 {
-			os.Chtimes(indexBaseFileName+".idx", time.Unix(0, modifiedTsNs), time.Unix(0, modifiedTsNs))
+		if !idxCopied {
+			if err = copyIdxFile(); err != nil {
+				return err
+			}
 		}
 
 		if modifiedTsNs, err = vs.doCopyFileWithThrottler(client, false, req.Collection, req.VolumeId, volFileInfoResp.CompactionRevision, 1024*1024, dataBaseFileName, ".vif", false, true, nil, throttler); err != nil {
@@ -154,6 +226,7 @@ func (vs *VolumeServer) VolumeCopy(req *volume_server_pb.VolumeCopyRequest, stre
 			os.Chtimes(dataBaseFileName+".vif", time.Unix(0, modifiedTsNs), time.Unix(0, modifiedTsNs))
 		}
 
+		storage.RemoveCopyCheckpoint(dataBaseFileName)
 		os.Remove(dataBaseFileName + ".note")
 
 		return nil
@@ -166,9 +239,6 @@ func (vs *VolumeServer) VolumeCopy(req *volume_server_pb.VolumeCopyRequest, stre
 		return fmt.Errorf("not found volume %d file", req.VolumeId)
 	}
 
-	idxFileName = indexBaseFileName + ".idx"
-	datFileName = dataBaseFileName + ".dat"
-
 	defer func() {
 		if err != nil && dataBaseFileName != "" {
 			os.Remove(idxFileName)
@@ -196,6 +266,37 @@ func (vs *VolumeServer) VolumeCopy(req *volume_server_pb.VolumeCopyRequest, stre
 	return err
 }
 
+func removeVolumeCopyFiles(dataBaseFileName, indexBaseFileName string) {
+	os.Remove(dataBaseFileName + ".dat")
+	os.Remove(indexBaseFileName + ".idx")
+	os.Remove(dataBaseFileName + ".vif")
+	os.Remove(dataBaseFileName + ".note")
+	storage.RemoveCopyCheckpoint(dataBaseFileName)
+}
+
+// findCopyResumeOffset returns where to resume copying the .dat file, or 0 to copy it again.
+func findCopyResumeOffset(idxFileName, datFileName string, copiedDatSize uint64) uint64 {
+	idxFile, err := os.Open(idxFileName)
+	if err != nil {
+		return 0
+	}
+	defer idxFile.Close()
+	datFile, err := os.Open(datFileName)
+	if err != nil {
+		return 0
+	}
+	defer datFile.Close()
+	if stat, statErr := datFile.Stat(); statErr != nil || uint64(stat.Size()) < copiedDatSize {
+		return 0
+	}
+	offset, err := storage.CopyResumeOffset(idxFile, datFile, copiedDatSize)
+	if err != nil {
+		glog.Warningf("resume copying %s: %v", datFileName, err)
+		return 0
+	}
+	return offset
+}
+
 func (vs *VolumeServer) doCopyFile(client volume_server_pb.VolumeServerClient, isEcVolume bool, collection string, vid, compactRevision uint32, stopOffset uint64, baseFileName, ext string, isAppend, ignoreSourceFileNotFound bool, progressFn storage.ProgressFunc) (modifiedTsNs int64, err error) {
 	return vs.doCopyFileWithThrottler(client, isEcVolume, collection, vid, compactRevision, stopOffset, baseFileName, ext, isAppend, ignoreSourceFileNotFound, progressFn, util.NewWriteThrottler(vs.compactionBytePerSecond))
 }
@@ -266,6 +367,47 @@ func writeToFile(client volume_server_pb.VolumeServer_CopyFileClient, fileName s
 	}
 	defer dst.Close()
 
+	return receiveToFile(client, dst, fileName, wt, progressFn)
+}
+
+// doCopyDatFile copies the .dat file starting from startOffset, and syncs it to disk.
+func (vs *VolumeServer) doCopyDatFile(client volume_server_pb.VolumeServerClient, req *volume_server_pb.VolumeCopyRequest, compactRevision uint32, startOffset, stopOffset uint64, datFileName string, progressFn func(dst *os.File, processed int64) bool, throttler *util.WriteThrottler) (modifiedTsNs int64, err error) {
+
+	copyFileClient, err := client.CopyFile(context.Background(), &volume_server_pb.CopyFileRequest{
+		VolumeId:                 req.VolumeId,
+		Ext:                      ".dat",
+		CompactionRevision:       compactRevision,
+		StopOffset:               stopOffset,
+		Collection:               req.Collection,
+		IgnoreSourceFileNotFound: true,
+		StartOffset:              startOffset,
+	})
+	if err != nil {
+		return modifiedTsNs, fmt.Errorf("failed to start copying volume %d .dat file: %v", req.VolumeId, err)
+	}
+
+	dst, err := os.OpenFile(datFileName, os.O_WRONLY|os.O_CREATE, 0644)
+	if err != nil {
+		return modifiedTsNs, err
+	}
+	defer dst.Close()
+	if err = dst.Truncate(int64(startOffset)); err != nil {
+		return modifiedTsNs, err
+	}
+	if _, err = dst.Seek(int64(startOffset), io.SeekStart); err != nil {
+		return modifiedTsNs, err
+	}
+
+	modifiedTsNs, err = receiveToFile(copyFileClient, dst, datFileName, throttler, func(processed int64) bool {
+		return progressFn(dst, processed)
+	})
+	if err != nil {
+		return modifiedTsNs, fmt.Errorf("failed to copy %s file: %v", datFileName, err)
+	}
+	return modifiedTsNs, dst.Sync()
+}
+
+func receiveToFile(client volume_server_pb.VolumeServer_CopyFileClient, dst *os.File, fileName string, wt *util.WriteThrottler, progressFn storage.ProgressFunc) (modifiedTsNs int64, err error) {
 	var progressedBytes int64
 	for {
 		resp, receiveErr := client.Recv()
@@ -314,6 +456,7 @@ func (vs *VolumeServer) ReadVolumeFileStatus(ctx context.Context, req *volume_se
 // CopyFile client pulls the volume related file from the source server.
 // if req.CompactionRevision != math.MaxUint32, it ensures the compact revision is as expected
 // The copying still stop at req.StopOffset, but you can set it to math.MaxUint64 in order to read all data.
+// The copying starts from req.StartOffset, to resume an interrupted copy.
 func (vs *VolumeServer) CopyFile(req *volume_server_pb.CopyFileRequest, stream volume_server_pb.VolumeServer_CopyFileServer) error {
 
 	var fileName string
@@ -326,6 +469,12 @@ func (vs *VolumeServer) CopyFile(req *volume_server_pb.CopyFileRequest, stream v
 		if uint32(v.CompactionRevision) != req.CompactionRevision && req.CompactionRevision != math.MaxUint32 {
 			return fmt.Errorf("volume %d is compacted", req.VolumeId)
 		}
+		if req.Ext == ".dat" || req.Ext == ".idx" {
+			if err := v.StartFileCopy(); err != nil {
+				return err
+			}
+			defer v.FinishFileCopy()
+		}
 		v.SyncToDisk()
 		fileName = v.FileName(req.Ext)
 	} else {
@@ -359,6 +508,16 @@ func (vs *VolumeServer) CopyFile(req *volume_server_pb.CopyFileRequest, stream v
 	}
 	defer file.Close()
 
+	if req.StartOffset > 0 {
+		if req.StartOffset >= req.StopOffset {
+			return nil
+		}
+		if _, err = file.Seek(int64(req.StartOffset), io.SeekStart); err != nil {
+			return err
+		}
+		bytesToRead = int64(req.StopOffset - req.StartOffset)
+	}
+
 	fileInfo, err := file.Stat()
 	if err != nil {
 		return err
@@ -366,6 +525,7 @@ func (vs *VolumeServer) CopyFile(req *volume_server_pb.CopyFileRequest, stream v
 	fileModTsNs := fileInfo.ModTime().UnixNano()
 
 	buffer := make([]byte, BufferSizeLimit)
+	budget := vs.ioBudget(needle.VolumeId(req.VolumeId), qos.TrafficReplication)
 
 	for bytesToRead > 0 {
 		bytesread, err := file.Read(buffer)
@@ -383,6 +543,9 @@ func (vs *VolumeServer) CopyFile(req *volume_server_pb.CopyFileRequest, stream v
 		if int64(bytesread) > bytesToRead {
 			bytesread = int(bytesToRead)
 		}
+		if err = vs.ioQos.Wait(stream.Context(), budget, qos.Read, int64(bytesread)); err != nil {
+			return err
+		}
 		err = stream.Send(&volume_server_pb.CopyFileResponse{
 			FileContent:  buffer[:bytesread],
 			ModifiedTsNs: fileModTsNs,
@@ -6,6 +6,7 @@ import (
 	"io"
 
 	"github.com/gateway-dao/seaweedfs/weed/pb/volume_server_pb"
+	"github.com/gateway-dao/seaweedfs/weed/storage"
 	"github.com/gateway-dao/seaweedfs/weed/storage/backend"
 	"github.com/gateway-dao/seaweedfs/weed/storage/needle"
 )
@@ -17,20 +18,15 @@ func (vs *VolumeServer) VolumeIncrementalCopy(req *volume_server_pb.VolumeIncrem
 		return fmt.Errorf("not found volume id %d", req.VolumeId)
 	}
 
-	stopOffset, _, _ := v.FileStat()
-	foundOffset, isLastOne, err := v.BinarySearchByAppendAtNs(req.SinceNs)
-	if err != nil {
-		return fmt.Errorf("fail to locate by appendAtNs %d: %s", req.SinceNs, err)
-	}
-
-	if isLastOne {
+	return v.ReadAppendedSince(req.SinceNs, func(ranges []storage.DataRange) error {
+		buf := make([]byte, 1024*1024*2)
+		for _, r := range ranges {
+			if err := sendFileContent(v.DataBackend, buf, r.Start, r.Stop, stream); err != nil {
+				return err
+			}
+		}
 		return nil
-	}
-
-	startOffset := foundOffset.ToActualOffset()
-
-	buf := make([]byte, 1024*1024*2)
-	return sendFileContent(v.DataBackend, buf, startOffset, int64(stopOffset), stream)
+	})
 
 }
 
@@ -50,7 +46,8 @@ func (vs *VolumeServer) VolumeSyncStatus(ctx context.Context, req *volume_server
 func sendFileContent(datBackend backend.BackendStorageFile, buf []byte, startOffset, stopOffset int64, stream volume_server_pb.VolumeServer_VolumeIncrementalCopyServer) error {
 	var blockSizeLimit = int64(len(buf))
 	for i := int64(0); i < stopOffset-startOffset; i += blockSizeLimit {
-		n, readErr := datBackend.ReadAt(buf, startOffset+i)
+		// the bytes after stopOffset may not belong to the copied needles
+		n, readErr := datBackend.ReadAt(buf[:min(blockSizeLimit, stopOffset-startOffset-i)], startOffset+i)
 		if readErr == nil || readErr == io.EOF {
 			resp := &volume_server_pb.VolumeIncrementalCopyResponse{}
 			resp.FileContent = buf[:int64(n)]
@@ -0,0 +1,24 @@
+package weed_server
+
+import (
+	"context"
+
+	"github.com/gateway-dao/seaweedfs/weed/glog"
+	"github.com/gateway-dao/seaweedfs/weed/pb/volume_server_pb"
+)
+
+func (vs *VolumeServer) VolumeRotateEncryptionKeys(ctx context.Context, req *volume_server_pb.VolumeRotateEncryptionKeysRequest) (*volume_server_pb.VolumeRotateEncryptionKeysResponse, error) {
+
+	keyId, volumeCount, ecVolumeCount, err := vs.store.RotateEncryptionKeys()
+	if err != nil {
+		glog.Errorf("rotate encryption keys: %v", err)
+		return nil, err
+	}
+	glog.V(0).Infof("re-wrapped data keys of %d volumes and %d ec volumes with master key %s", volumeCount, ecVolumeCount, keyId)
+
+	return &volume_server_pb.VolumeRotateEncryptionKeysResponse{
+		KeyId:                keyId,
+		RotatedVolumeCount:   uint32(volumeCount),
+		RotatedEcVolumeCount: uint32(ecVolumeCount),
+	}, nil
+}
@@ -25,6 +25,10 @@ func registerEvent(eventType event.VolumeServerEventType, fid *string, vs *Volum
 		glog.V(3).Infof("Emitting DELETE event for %s", vs.store.Ip)
 	case event.VACUUM:
 		glog.V(3).Infof("Emitting VACUUM event for %s", vs.store.Ip)
+	case event.REPAIR:
+		glog.V(3).Infof("Emitting REPAIR event for %s", vs.store.Ip)
+	case event.APPEND:
+		glog.V(3).Infof("Emitting APPEND event for %s", vs.store.Ip)
 	default:
 		return fmt.Errorf("eventType undefined")
 	}
@@ -15,10 +15,21 @@ func (vs *VolumeServer) ReadNeedleBlob(ctx context.Context, req *volume_server_p
 		return nil, fmt.Errorf("not found volume id %d", req.VolumeId)
 	}
 
+	if req.NeedleId != 0 {
+		var size types.Size
+		resp.NeedleBlob, size, err = v.ReadNeedleBlobByKey(types.NeedleId(req.NeedleId))
+		if err != nil {
+			return nil, fmt.Errorf("read needle blob %s: %v", types.NeedleId(req.NeedleId), err)
+		}
+		resp.Size = int32(size)
+		return resp, nil
+	}
+
 	resp.NeedleBlob, err = v.ReadNeedleBlob(req.Offset, types.Size(req.Size))
 	if err != nil {
 		return nil, fmt.Errorf("read needle blob offset %d size %d: %v", req.Offset, req.Size, err)
 	}
+	resp.Size = req.Size
 
 	return resp, nil
 }
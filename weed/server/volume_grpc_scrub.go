@@ -0,0 +1,50 @@
+package weed_server
+
+import (
+	"context"
+	"slices"
+
+	"github.com/gateway-dao/seaweedfs/weed/pb/volume_server_pb"
+)
+
+func (vs *VolumeServer) VolumeScrubStatus(ctx context.Context, req *volume_server_pb.VolumeScrubStatusRequest) (*volume_server_pb.VolumeScrubStatusResponse, error) {
+	resp := &volume_server_pb.VolumeScrubStatusResponse{}
+	if vs.scrubber == nil {
+		return resp, nil
+	}
+
+	isRunning, currentVolumeId, results := vs.scrubber.status()
+	resp.IsRunning = isRunning
+	resp.CurrentVolumeId = uint32(currentVolumeId)
+
+	for _, result := range results {
+		if len(req.VolumeIds) > 0 && !slices.Contains(req.VolumeIds, uint32(result.VolumeId)) {
+			continue
+		}
+		status := &volume_server_pb.VolumeScrubStatus{
+			VolumeId:             uint32(result.VolumeId),
+			Collection:           result.Collection,
+			IsEcVolume:           result.IsEcVolume,
+			StartedAtNs:          result.StartedAt.UnixNano(),
+			CompletedAtNs:        result.CompletedAt.UnixNano(),
+			ScannedNeedleCount:   result.ScannedNeedles,
+			ScannedByteCount:     result.ScannedBytes,
+			CorruptedNeedleCount: result.CorruptedNeedles,
+			RepairedNeedleCount:  result.RepairedNeedles,
+		}
+		if result.Error != nil {
+			status.Error = result.Error.Error()
+		}
+		if v := vs.store.GetVolume(result.VolumeId); v != nil {
+			for _, key := range v.QuarantinedNeedleIds() {
+				status.QuarantinedNeedleIds = append(status.QuarantinedNeedleIds, uint64(key))
+			}
+		}
+		resp.Volumes = append(resp.Volumes, status)
+	}
+	slices.SortFunc(resp.Volumes, func(a, b *volume_server_pb.VolumeScrubStatus) int {
+		return int(a.VolumeId) - int(b.VolumeId)
+	})
+
+	return resp, nil
+}
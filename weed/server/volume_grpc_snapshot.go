@@ -0,0 +1,58 @@
+package weed_server
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/gateway-dao/seaweedfs/weed/pb/volume_server_pb"
+	"github.com/gateway-dao/seaweedfs/weed/storage/needle"
+)
+
+func (vs *VolumeServer) VolumeSnapshot(ctx context.Context, req *volume_server_pb.VolumeSnapshotRequest) (*volume_server_pb.VolumeSnapshotResponse, error) {
+
+	snapshot, err := vs.store.SnapshotVolume(needle.VolumeId(req.VolumeId), req.Name, req.HardLink, req.AsOfNs)
+	if err != nil {
+		return nil, fmt.Errorf("snapshot volume %d: %v", req.VolumeId, err)
+	}
+
+	return &volume_server_pb.VolumeSnapshotResponse{
+		Snapshot: snapshot,
+	}, nil
+}
+
+func (vs *VolumeServer) VolumeSnapshotList(ctx context.Context, req *volume_server_pb.VolumeSnapshotListRequest) (*volume_server_pb.VolumeSnapshotListResponse, error) {
+
+	var volumeIds []needle.VolumeId
+	for _, vid := range req.VolumeIds {
+		volumeIds = append(volumeIds, needle.VolumeId(vid))
+	}
+	snapshots, err := vs.store.ListVolumeSnapshots(volumeIds, req.Name)
+	if err != nil {
+		return nil, err
+	}
+
+	return &volume_server_pb.VolumeSnapshotListResponse{
+		Snapshots: snapshots,
+	}, nil
+}
+
+func (vs *VolumeServer) VolumeSnapshotRestore(ctx context.Context, req *volume_server_pb.VolumeSnapshotRestoreRequest) (*volume_server_pb.VolumeSnapshotRestoreResponse, error) {
+
+	if req.NewVolumeId == 0 {
+		return nil, fmt.Errorf("missing new volume id")
+	}
+	if err := vs.store.RestoreVolumeSnapshot(needle.VolumeId(req.VolumeId), req.Name, needle.VolumeId(req.NewVolumeId)); err != nil {
+		return nil, err
+	}
+
+	return &volume_server_pb.VolumeSnapshotRestoreResponse{}, nil
+}
+
+func (vs *VolumeServer) VolumeSnapshotDelete(ctx context.Context, req *volume_server_pb.VolumeSnapshotDeleteRequest) (*volume_server_pb.VolumeSnapshotDeleteResponse, error) {
+
+	if err := vs.store.DeleteVolumeSnapshot(needle.VolumeId(req.VolumeId), req.Name); err != nil {
+		return nil, err
+	}
+
+	return &volume_server_pb.VolumeSnapshotDeleteResponse{}, nil
+}
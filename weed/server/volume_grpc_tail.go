@@ -56,24 +56,24 @@ func (vs *VolumeServer) VolumeTailSender(req *volume_server_pb.VolumeTailSenderR
 
 func sendNeedlesSince(stream volume_server_pb.VolumeServer_VolumeTailSenderServer, v *storage.Volume, lastTimestampNs uint64) (lastProcessedTimestampNs uint64, err error) {
 
-	foundOffset, isLastOne, err := v.BinarySearchByAppendAtNs(lastTimestampNs)
-	if err != nil {
-		return 0, fmt.Errorf("fail to locate by appendAtNs %d: %s", lastTimestampNs, err)
-	}
-
-	// log.Printf("reading ts %d offset %d isLast %v", lastTimestampNs, foundOffset, isLastOne)
-
-	if isLastOne {
-		// need to heart beat to the client to ensure the connection health
-		sendErr := stream.Send(&volume_server_pb.VolumeTailSenderResponse{IsLastChunk: true})
-		return lastTimestampNs, sendErr
-	}
-
 	scanner := &VolumeFileScanner4Tailing{
 		stream: stream,
+		v:      v,
 	}
 
-	err = storage.ScanVolumeFileFrom(v.Version(), v.DataBackend, foundOffset.ToActualOffset(), scanner)
+	err = v.ReadAppendedSince(lastTimestampNs, func(ranges []storage.DataRange) error {
+		if len(ranges) == 0 {
+			// need to heart beat to the client to ensure the connection health
+			scanner.lastProcessedTimestampNs = lastTimestampNs
+			return stream.Send(&volume_server_pb.VolumeTailSenderResponse{IsLastChunk: true})
+		}
+		for _, r := range ranges {
+			if err := storage.ScanVolumeFileRange(v.Version(), v.DataBackend, r.Start, r.Stop, scanner); err != nil {
+				return err
+			}
+		}
+		return nil
+	})
 
 	return scanner.lastProcessedTimestampNs, err
 
@@ -91,8 +91,7 @@ func (vs *VolumeServer) VolumeTailReceiver(ctx context.Context, req *volume_serv
 	defer glog.V(1).Infof("receive tailing volume %d finished", v.Id)
 
 	return resp, operation.TailVolumeFromSource(pb.ServerAddress(req.SourceVolumeServer), vs.grpcDialOption, v.Id, req.SinceNs, int(req.IdleTimeoutSeconds), func(n *needle.Needle) error {
-		_, err := vs.store.WriteVolumeNeedle(v.Id, n, false, false)
-		return err
+		return vs.applyTailedNeedle(v.Id, n, false)
 	})
 
 }
@@ -100,6 +99,7 @@ func (vs *VolumeServer) VolumeTailReceiver(ctx context.Context, req *volume_serv
 // generate the volume idx
 type VolumeFileScanner4Tailing struct {
 	stream                   volume_server_pb.VolumeServer_VolumeTailSenderServer
+	v                        *storage.Volume
 	lastProcessedTimestampNs uint64
 }
 
@@ -113,6 +113,7 @@ func (scanner *VolumeFileScanner4Tailing) ReadNeedleBody() bool {
 
 func (scanner *VolumeFileScanner4Tailing) VisitNeedle(n *needle.Needle, offset int64, needleHeader, needleBody []byte) error {
 	isLastChunk := false
+	isDeleted := scanner.v.IsDeletionNeedle(n, offset)
 
 	// need to send body by chunks
 	for i := 0; i < len(needleBody); i += BufferSizeLimit {
@@ -126,6 +127,7 @@ func (scanner *VolumeFileScanner4Tailing) VisitNeedle(n *needle.Needle, offset i
 			NeedleHeader: needleHeader,
 			NeedleBody:   needleBody[i:stopOffset],
 			IsLastChunk:  isLastChunk,
+			IsDeleted:    isDeleted,
 		})
 		if sendErr != nil {
 			return sendErr
@@ -30,7 +30,7 @@ func (vs *VolumeServer) VolumeTierMoveDatFromRemote(req *volume_server_pb.Volume
 	}
 
 	// check whether the local .dat already exists
-	_, ok := v.DataBackend.(*backend.DiskFile)
+	_, ok := backend.UnwrapDiskFile(v.DataBackend)
 	if ok {
 		return fmt.Errorf("volume %d is already on local disk", req.VolumeId)
 	}
@@ -25,7 +25,7 @@ func (vs *VolumeServer) VolumeTierMoveDatToRemote(req *volume_server_pb.VolumeTi
 	}
 
 	// locate the disk file
-	diskFile, ok := v.DataBackend.(*backend.DiskFile)
+	diskFile, ok := backend.UnwrapDiskFile(v.DataBackend)
 	if !ok {
 		return nil // already copied to remove. fmt.Errorf("volume %d is not on local disk", req.VolumeId)
 	}
@@ -13,6 +13,7 @@ import (
 	"github.com/gateway-dao/seaweedfs/weed/glog"
 	"github.com/gateway-dao/seaweedfs/weed/pb/volume_server_pb"
 	"github.com/gateway-dao/seaweedfs/weed/storage/needle"
+	"github.com/gateway-dao/seaweedfs/weed/storage/qos"
 	"github.com/prometheus/procfs"
 )
 
@@ -45,7 +46,12 @@ func (vs *VolumeServer) VacuumVolumeCompact(req *volume_server_pb.VacuumVolumeCo
 	nextReportTarget := reportInterval
 	fs, fsErr := procfs.NewDefaultFS()
 	var sendErr error
-	err := vs.store.CompactVolume(needle.VolumeId(req.VolumeId), req.Preallocate, vs.compactionBytePerSecond, func(processed int64) bool {
+	budget, charged := vs.ioBudget(needle.VolumeId(req.VolumeId), qos.TrafficVacuum), int64(0)
+	err := vs.store.CompactVolume(needle.VolumeId(req.VolumeId), req.Preallocate, vs.compactionSegmentSize, vs.compactionBytePerSecond, func(processed int64) bool {
+		if sendErr = vs.ioQos.Wait(stream.Context(), budget, qos.Write, processed-charged); sendErr != nil {
+			return false
+		}
+		charged = processed
 		if processed > nextReportTarget {
 			resp.ProcessedBytes = processed
 			if fsErr == nil && numCPU > 0 {
@@ -67,7 +73,7 @@ func (vs *VolumeServer) VacuumVolumeCompact(req *volume_server_pb.VacuumVolumeCo
 		return err
 	}
 	if sendErr != nil {
-		glog.Errorf("failed compact volume %d report progress: %v", req.VolumeId, sendErr)
+		glog.Errorf("failed compact volume %d: %v", req.VolumeId, sendErr)
 		return sendErr
 	}
 
@@ -18,6 +18,10 @@ import (
 	"github.com/gateway-dao/seaweedfs/weed/glog"
 	"github.com/gateway-dao/seaweedfs/weed/security"
 	"github.com/gateway-dao/seaweedfs/weed/storage"
+	"github.com/gateway-dao/seaweedfs/weed/storage/backend"
+	"github.com/gateway-dao/seaweedfs/weed/storage/needle"
+	"github.com/gateway-dao/seaweedfs/weed/storage/qos"
+	"github.com/gateway-dao/seaweedfs/weed/util/chunk_cache"
 )
 
 type VolumeServer struct {
@@ -47,16 +51,25 @@ type VolumeServer struct {
 	FixJpgOrientation       bool
 	ReadMode                string
 	compactionBytePerSecond int64
+	compactionSegmentSize   int64
 	metricsAddress          string
 	metricsIntervalSec      int
 	fileSizeLimitBytes      int64
 	isHeartbeating          bool
 	stopChan                chan bool
+	scrubber                *volumeScrubber
+	readRepair              bool
+	compression             string // gzip or zstd, for uploads not compressed by clients
+	needleCache             *chunk_cache.NeedleCache
+	ioQos                   *qos.Scheduler
+	asyncReplication        *storage.AsyncReplication
+	asyncReplicas           map[needle.VolumeId]*asyncReplicaFollower
+	asyncReplicasLock       sync.Mutex
 }
 
 func NewVolumeServer(adminMux, publicMux *http.ServeMux, ip string,
 	port int, grpcPort int, publicUrl string,
-	folders []string, maxCounts []int32, minFreeSpaces []util.MinFreeSpace, diskTypes []types.DiskType,
+	folders []string, maxCounts []int32, minFreeSpaces []util.MinFreeSpace, diskTypes []types.DiskType, diskIos []backend.DiskIoMode,
 	idxFolder string,
 	needleMapKind storage.NeedleMapKind,
 	masterNodes []pb.ServerAddress, pulseSeconds int,
@@ -65,6 +78,7 @@ func NewVolumeServer(adminMux, publicMux *http.ServeMux, ip string,
 	fixJpgOrientation bool,
 	readMode string,
 	compactionMBPerSecond int,
+	compactionSegmentMB int,
 	fileSizeLimitMB int,
 	concurrentUploadLimit int64,
 	concurrentDownloadLimit int64,
@@ -72,6 +86,12 @@ func NewVolumeServer(adminMux, publicMux *http.ServeMux, ip string,
 	hasSlowRead bool,
 	readBufferSizeMB int,
 	ldbTimeout int64,
+	scrubInterval time.Duration,
+	scrubMBPerSecond int,
+	readRepair bool,
+	compression string,
+	needleCache *chunk_cache.NeedleCache,
+	keyWrapper security.KeyWrapper,
 	eventStore *event.LevelDbEventStore[*event.VolumeServerEvent],
 ) *VolumeServer {
 
@@ -95,6 +115,7 @@ func NewVolumeServer(adminMux, publicMux *http.ServeMux, ip string,
 		ReadMode:                      readMode,
 		grpcDialOption:                security.LoadClientTLS(util.GetViper(), "grpc.volume"),
 		compactionBytePerSecond:       int64(compactionMBPerSecond) * 1024 * 1024,
+		compactionSegmentSize:         int64(compactionSegmentMB) * 1024 * 1024,
 		fileSizeLimitBytes:            int64(fileSizeLimitMB) * 1024 * 1024,
 		isHeartbeating:                true,
 		stopChan:                      make(chan bool),
@@ -106,12 +127,22 @@ func NewVolumeServer(adminMux, publicMux *http.ServeMux, ip string,
 		hasSlowRead:                   hasSlowRead,
 		readBufferSizeMB:              readBufferSizeMB,
 		ldbTimout:                     ldbTimeout,
+		readRepair:                    readRepair,
+		compression:                   compression,
+		needleCache:                   needleCache,
+		ioQos:                         qos.NewScheduler(),
+		asyncReplication:              storage.NewAsyncReplication(nil),
+		asyncReplicas:                 make(map[needle.VolumeId]*asyncReplicaFollower),
 	}
 	vs.SeedMasterNodes = masterNodes
 
 	vs.checkWithMaster()
 
-	vs.store = storage.NewStore(vs.grpcDialOption, ip, port, grpcPort, publicUrl, folders, maxCounts, minFreeSpaces, idxFolder, vs.needleMapKind, diskTypes, ldbTimeout)
+	vs.store = storage.NewStore(vs.grpcDialOption, ip, port, grpcPort, publicUrl, folders, maxCounts, minFreeSpaces, idxFolder, vs.needleMapKind, diskTypes, diskIos, ldbTimeout, keyWrapper)
+	if needleCache != nil {
+		vs.store.SetNeedleCache(needleCache)
+	}
+	vs.store.AsyncReplication = vs.asyncReplication
 	vs.guard = security.NewGuard(whiteList, signingKey, expiresAfterSec, readSigningKey, readExpiresAfterSec)
 
 	handleStaticResources(adminMux)
@@ -134,6 +165,11 @@ func NewVolumeServer(adminMux, publicMux *http.ServeMux, ip string,
 	}
 
 	go vs.heartbeat()
+	go vs.loopAsyncReplication()
+	if scrubInterval > 0 {
+		vs.scrubber = newVolumeScrubber(scrubInterval, int64(scrubMBPerSecond)*1024*1024)
+		go vs.loopScrubbing()
+	}
 	go stats.LoopPushingMetric("volumeServer", util.JoinHostPort(ip, port), vs.metricsAddress, vs.metricsIntervalSec)
 
 	return vs
@@ -151,6 +187,10 @@ func (vs *VolumeServer) LoadNewVolumes() {
 
 func (vs *VolumeServer) Shutdown() {
 	glog.V(0).Infoln("Shutting down volume server...")
+	vs.stopAsyncReplicas()
 	vs.store.Close()
+	if vs.needleCache != nil {
+		vs.needleCache.Shutdown()
+	}
 	glog.V(0).Infoln("Shut down successfully!")
 }
@@ -0,0 +1,167 @@
+package weed_server
+
+import (
+	"context"
+	"net/http"
+	"net/url"
+	"time"
+
+	"github.com/gateway-dao/seaweedfs/weed/glog"
+	"github.com/gateway-dao/seaweedfs/weed/operation"
+	"github.com/gateway-dao/seaweedfs/weed/pb"
+	"github.com/gateway-dao/seaweedfs/weed/storage"
+	"github.com/gateway-dao/seaweedfs/weed/storage/needle"
+	"github.com/gateway-dao/seaweedfs/weed/topology"
+	"github.com/gateway-dao/seaweedfs/weed/util"
+)
+
+const (
+	asyncReplicationCheckInterval = 15 * time.Second
+	asyncReplicationRetryInterval = 5 * time.Second
+)
+
+// asyncReplicaFollower tails a replica in the primary data center, to feed a local replica in another data center.
+type asyncReplicaFollower struct {
+	source pb.ServerAddress
+	cancel context.CancelFunc
+}
+
+func (vs *VolumeServer) loopAsyncReplication() {
+	ticker := time.NewTicker(asyncReplicationCheckInterval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-vs.stopChan:
+			vs.stopAsyncReplicas()
+			return
+		case <-ticker.C:
+		}
+		vs.checkAsyncReplicas()
+	}
+}
+
+// checkAsyncReplicas starts following the primary data center for the local replicas outside of it,
+// and stops following for the replicas no longer replicated asynchronously.
+func (vs *VolumeServer) checkAsyncReplicas() {
+	sources := make(map[needle.VolumeId]pb.ServerAddress)
+	volumes := make(map[needle.VolumeId]*storage.Volume)
+	for _, v := range vs.store.AsyncReplicatedVolumes() {
+		source, err := vs.asyncReplicationSource(v.Id)
+		if err != nil {
+			glog.V(1).Infof("async replication of volume %d: %v", v.Id, err)
+			continue
+		}
+		if source != "" {
+			sources[v.Id] = source
+			volumes[v.Id] = v
+		}
+	}
+
+	vs.asyncReplicasLock.Lock()
+	defer vs.asyncReplicasLock.Unlock()
+	for vid, follower := range vs.asyncReplicas {
+		if source, found := sources[vid]; !found || source != follower.source {
+			follower.cancel()
+			delete(vs.asyncReplicas, vid)
+		}
+	}
+	for vid, source := range sources {
+		if _, found := vs.asyncReplicas[vid]; found {
+			continue
+		}
+		ctx, cancel := context.WithCancel(context.Background())
+		vs.asyncReplicas[vid] = &asyncReplicaFollower{source: source, cancel: cancel}
+		go vs.followAsyncReplica(ctx, volumes[vid], source)
+	}
+}
+
+func (vs *VolumeServer) stopAsyncReplicas() {
+	vs.asyncReplicasLock.Lock()
+	defer vs.asyncReplicasLock.Unlock()
+	for vid, follower := range vs.asyncReplicas {
+		follower.cancel()
+		delete(vs.asyncReplicas, vid)
+	}
+}
+
+// asyncReplicationSource finds a replica in the primary data center to follow,
+// or an empty address if this volume server is in the primary data center.
+func (vs *VolumeServer) asyncReplicationSource(vid needle.VolumeId) (pb.ServerAddress, error) {
+	lookupResult, err := operation.LookupVolumeId(vs.GetMaster, vs.grpcDialOption, vid.String())
+	if err != nil {
+		return "", err
+	}
+	selfDataCenter, primary, found := topology.AsyncReplicationDataCenters(vs.store, lookupResult.Locations)
+	if !found || selfDataCenter == primary {
+		return "", nil
+	}
+	for _, location := range lookupResult.Locations {
+		if location.DataCenter == primary {
+			return location.ServerAddress(), nil
+		}
+	}
+	return "", nil
+}
+
+func (vs *VolumeServer) followAsyncReplica(ctx context.Context, v *storage.Volume, source pb.ServerAddress) {
+	glog.V(0).Infof("volume %d follows %s asynchronously", v.Id, source)
+	defer v.StopAsyncReplica()
+	for {
+		sinceNs := v.StartAsyncReplica(string(source))
+		err := operation.TailVolumeNeedles(ctx, source, vs.grpcDialOption, v.Id, sinceNs, 0, func(n *needle.Needle, isDeleted bool) error {
+			if err := vs.applyTailedNeedle(v.Id, n, isDeleted); err != nil {
+				return err
+			}
+			v.AdvanceAsyncReplica(n.AppendAtNs)
+			return nil
+		}, v.AsyncReplicaCaughtUp)
+		select {
+		case <-ctx.Done():
+			glog.V(0).Infof("volume %d stops following %s", v.Id, source)
+			return
+		case <-time.After(asyncReplicationRetryInterval):
+		}
+		if err != nil {
+			glog.V(0).Infof("volume %d follows %s: %v", v.Id, source, err)
+		}
+	}
+}
+
+// applyTailedNeedle writes a needle tailed from another replica of the volume.
+func (vs *VolumeServer) applyTailedNeedle(vid needle.VolumeId, n *needle.Needle, isDeleted bool) error {
+	if isDeleted {
+		_, err := vs.store.DeleteVolumeNeedle(vid, n)
+		return err
+	}
+	if link, ok := n.GetAppendLink(); ok {
+		// the link points into the source volume, so append the data to the needle here
+		n.Pairs, n.PairsSize = nil, 0
+		n.Flags &^= needle.FlagHasPairs
+		_, err := vs.store.AppendVolumeNeedle(vid, n, link.DataOffset, false)
+		return err
+	}
+	_, err := vs.store.WriteVolumeNeedle(vid, n, false, false)
+	return err
+}
+
+// maybeRedirectToAsyncSource sends a read missing in a lagging replica to the replica it follows,
+// since the needle may not have been replicated yet.
+func (vs *VolumeServer) maybeRedirectToAsyncSource(w http.ResponseWriter, r *http.Request, volumeId needle.VolumeId) bool {
+	v := vs.store.GetVolume(volumeId)
+	if v == nil {
+		return false
+	}
+	source, isLagging := v.AsyncReplicaSource(vs.asyncReplication.MaxLag())
+	if !isLagging {
+		return false
+	}
+	target, err := url.Parse(util.NormalizeUrl(pb.ServerAddress(source).ToHttpAddress()))
+	if err != nil {
+		return false
+	}
+	u := *r.URL
+	u.Scheme, u.Host = target.Scheme, target.Host
+	glog.V(2).Infof("volume %d lags behind, redirect %s to %s", volumeId, r.URL.Path, source)
+	http.Redirect(w, r, u.String(), http.StatusTemporaryRedirect)
+	return true
+}
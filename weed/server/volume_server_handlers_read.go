@@ -26,6 +26,7 @@ import (
 	"github.com/gateway-dao/seaweedfs/weed/stats"
 	"github.com/gateway-dao/seaweedfs/weed/storage"
 	"github.com/gateway-dao/seaweedfs/weed/storage/needle"
+	"github.com/gateway-dao/seaweedfs/weed/storage/qos"
 	"github.com/gateway-dao/seaweedfs/weed/util"
 )
 
@@ -139,11 +140,15 @@ func (vs *VolumeServer) GetOrHeadHandler(w http.ResponseWriter, r *http.Request)
 	var memoryCost types.Size
 	readOption.AttemptMetaOnly, readOption.MustMetaOnly = shouldAttemptStreamWrite(hasVolume, ext, r)
 	onReadSizeFn := func(size types.Size) {
+		// the needle can be read again after read repair
+		atomic.AddInt64(&vs.inFlightDownloadDataSize, int64(size)-int64(memoryCost))
 		memoryCost = size
-		atomic.AddInt64(&vs.inFlightDownloadDataSize, int64(memoryCost))
 	}
 	if hasVolume {
 		count, err = vs.store.ReadVolumeNeedle(volumeId, n, readOption, onReadSizeFn)
+		if vs.maybeReadRepair(volumeId, vid+","+fid, n, err) {
+			count, err = vs.store.ReadVolumeNeedle(volumeId, n, readOption, onReadSizeFn)
+		}
 	} else if hasEcVolume {
 		count, err = vs.store.ReadEcShardNeedle(volumeId, n, onReadSizeFn)
 	}
@@ -152,13 +157,12 @@ func (vs *VolumeServer) GetOrHeadHandler(w http.ResponseWriter, r *http.Request)
 		vs.inFlightDownloadDataLimitCond.Signal()
 	}()
 
-	if err != nil && err != storage.ErrorDeleted && hasVolume {
-		glog.V(4).Infof("read needle: %v", err)
-		// start to fix it from other replicas, if not deleted and hasVolume and is not a replicated request
-	}
 	// glog.V(4).Infoln("read bytes", count, "error", err)
 	if err != nil || count < 0 {
 		glog.V(3).Infof("read %s isNormalVolume %v error: %v", r.URL.Path, hasVolume, err)
+		if err == storage.ErrorNotFound && hasVolume && vs.maybeRedirectToAsyncSource(w, r, volumeId) {
+			return
+		}
 		if err == storage.ErrorNotFound || err == storage.ErrorDeleted {
 			NotFound(w)
 		} else {
@@ -171,6 +175,11 @@ func (vs *VolumeServer) GetOrHeadHandler(w http.ResponseWriter, r *http.Request)
 		NotFound(w)
 		return
 	}
+	if err = vs.ioQos.Wait(r.Context(), vs.ioBudget(volumeId, qos.TrafficClient), qos.Read, int64(n.Size)); err != nil {
+		glog.V(3).Infof("read %s wait for io budget: %v", r.URL.Path, err)
+		w.WriteHeader(http.StatusTooManyRequests)
+		return
+	}
 	if n.LastModified != 0 {
 		w.Header().Set("Last-Modified", time.Unix(int64(n.LastModified), 0).UTC().Format(http.TimeFormat))
 		if r.Header.Get("If-Modified-Since") != "" {
@@ -0,0 +1,42 @@
+package weed_server
+
+import (
+	"github.com/gateway-dao/seaweedfs/weed/event"
+	"github.com/gateway-dao/seaweedfs/weed/glog"
+	"github.com/gateway-dao/seaweedfs/weed/stats"
+	"github.com/gateway-dao/seaweedfs/weed/storage"
+	"github.com/gateway-dao/seaweedfs/weed/storage/needle"
+)
+
+// maybeReadRepair fetches a needle that failed the CRC or size check locally, or that is missing
+// from the local index, from another replica and rewrites the local copy. Needles deleted or expired
+// in the local index are never repaired. It returns true if the needle can be read again.
+func (vs *VolumeServer) maybeReadRepair(volumeId needle.VolumeId, fid string, n *needle.Needle, readErr error) bool {
+	if !vs.readRepair {
+		return false
+	}
+	if !needle.IsCorrupted(readErr) && readErr != storage.ErrorNotFound {
+		return false
+	}
+	v := vs.store.GetVolume(volumeId)
+	if v == nil || !v.NeedToReplicate() {
+		return false
+	}
+	if readErr == storage.ErrorNotFound && v.HasNeedleEntry(n.Id) {
+		return false
+	}
+
+	if err := vs.store.RepairVolumeNeedle(volumeId, n.Id); err != nil {
+		if err == storage.ErrorDeleted {
+			return false
+		}
+		glog.V(1).Infof("read repair %s: %v", fid, err)
+		stats.VolumeServerReadRepairCounter.WithLabelValues(v.Collection, "failed").Inc()
+		return false
+	}
+
+	glog.V(0).Infof("read repair %s from other replicas", fid)
+	stats.VolumeServerReadRepairCounter.WithLabelValues(v.Collection, "repaired").Inc()
+	go registerEvent(event.REPAIR, &fid, vs, &volumeId, n)
+	return true
+}
@@ -1,6 +1,7 @@
 package weed_server
 
 import (
+	"bytes"
 	"errors"
 	"fmt"
 	"net/http"
@@ -11,7 +12,9 @@ import (
 	"github.com/gateway-dao/seaweedfs/weed/event"
 	"github.com/gateway-dao/seaweedfs/weed/glog"
 	"github.com/gateway-dao/seaweedfs/weed/operation"
+	"github.com/gateway-dao/seaweedfs/weed/storage"
 	"github.com/gateway-dao/seaweedfs/weed/storage/needle"
+	"github.com/gateway-dao/seaweedfs/weed/storage/qos"
 	"github.com/gateway-dao/seaweedfs/weed/topology"
 	"github.com/gateway-dao/seaweedfs/weed/util/buffer_pool"
 )
@@ -39,15 +42,29 @@ func (vs *VolumeServer) PostHandler(w http.ResponseWriter, r *http.Request) {
 	bytesBuffer := buffer_pool.SyncPoolGetBuffer()
 	defer buffer_pool.SyncPoolPutBuffer(bytesBuffer)
 
+	if r.FormValue("op") == "append" {
+		vs.appendHandler(w, r, volumeId, fid, bytesBuffer)
+		return
+	}
+
 	// Create needle for new upload request
 	// - mechanism to parse upload chunks in a storage's context
 	// - storage replication enforced by configured topology
-	reqNeedle, originalSize, contentMd5, contentHash, ne := needle.CreateNeedleFromRequest(r, vs.FixJpgOrientation, vs.fileSizeLimitBytes, bytesBuffer)
+	reqNeedle, originalSize, contentMd5, contentHash, ne := needle.CreateNeedleFromRequest(r, vs.FixJpgOrientation, vs.fileSizeLimitBytes, bytesBuffer, vs.needleCompressor(volumeId))
 	if ne != nil {
 		writeJsonError(w, r, http.StatusBadRequest, ne)
 		return
 	}
 
+	traffic := qos.TrafficClient
+	if r.URL.Query().Get("type") == "replicate" {
+		traffic = qos.TrafficReplication
+	}
+	if err := vs.ioQos.Wait(r.Context(), vs.ioBudget(volumeId, traffic), qos.Write, int64(len(reqNeedle.Data))); err != nil {
+		writeJsonError(w, r, http.StatusTooManyRequests, fmt.Errorf("wait for io budget: %v", err))
+		return
+	}
+
 	ret := operation.UploadResult{}
 	isUnchanged, writeError := topology.ReplicatedWrite(vs.GetMaster, vs.grpcDialOption, vs.store, volumeId, reqNeedle, r, contentMd5)
 	if writeError != nil {
@@ -79,6 +96,55 @@ func (vs *VolumeServer) PostHandler(w http.ResponseWriter, r *http.Request) {
 	writeJsonQuiet(w, r, httpStatus, ret)
 }
 
+// appendHandler appends the uploaded data to the existing file id,
+// keeping its name, mime type and pairs. The offset parameter is the current file size,
+// and the append is rejected with 409 Conflict if the file has changed since.
+func (vs *VolumeServer) appendHandler(w http.ResponseWriter, r *http.Request, volumeId needle.VolumeId, fid string, bytesBuffer *bytes.Buffer) {
+	fileOffset, parseErr := strconv.ParseUint(r.FormValue("offset"), 10, 64)
+	if parseErr != nil {
+		writeJsonError(w, r, http.StatusBadRequest, fmt.Errorf("op=append requires the current file size as offset: %v", parseErr))
+		return
+	}
+
+	reqNeedle, contentMd5, ne := needle.CreateAppendNeedleFromRequest(r, vs.fileSizeLimitBytes, bytesBuffer)
+	if ne != nil {
+		writeJsonError(w, r, http.StatusBadRequest, ne)
+		return
+	}
+
+	traffic := qos.TrafficClient
+	if r.URL.Query().Get("type") == "replicate" {
+		traffic = qos.TrafficReplication
+	}
+	if err := vs.ioQos.Wait(r.Context(), vs.ioBudget(volumeId, traffic), qos.Write, int64(len(reqNeedle.Data))); err != nil {
+		writeJsonError(w, r, http.StatusTooManyRequests, fmt.Errorf("wait for io budget: %v", err))
+		return
+	}
+
+	size, appendError := topology.ReplicatedAppend(vs.GetMaster, vs.grpcDialOption, vs.store, volumeId, reqNeedle, fileOffset, r, contentMd5)
+	if appendError != nil {
+		if errors.Is(appendError, storage.ErrorAppendConflict) {
+			writeJsonError(w, r, http.StatusConflict, appendError)
+			return
+		}
+		if errors.Is(appendError, storage.ErrorNotFound) || errors.Is(appendError, storage.ErrorDeleted) {
+			writeJsonError(w, r, http.StatusNotFound, appendError)
+			return
+		}
+		writeJsonError(w, r, http.StatusInternalServerError, appendError)
+		return
+	}
+
+	ret := operation.UploadResult{
+		Size: uint32(size),
+	}
+	w.Header().Set("Content-MD5", contentMd5)
+
+	go registerEvent(event.APPEND, &fid, vs, &volumeId, reqNeedle)
+
+	writeJsonQuiet(w, r, http.StatusCreated, ret)
+}
+
 func (vs *VolumeServer) DeleteHandler(w http.ResponseWriter, r *http.Request) {
 	n := new(needle.Needle)
 	vid, fid, _, _, _ := parseURLPath(r.URL.Path)
@@ -0,0 +1,17 @@
+package weed_server
+
+import (
+	"github.com/gateway-dao/seaweedfs/weed/storage/needle"
+	"github.com/gateway-dao/seaweedfs/weed/storage/qos"
+)
+
+// ioBudget finds the i/o budget for the traffic to a volume or an ec volume.
+func (vs *VolumeServer) ioBudget(vid needle.VolumeId, traffic string) *qos.Budget {
+	if v := vs.store.GetVolume(vid); v != nil {
+		return vs.ioQos.Classify(v.Collection, v.DiskType(), traffic)
+	}
+	if ev, found := vs.store.FindEcVolume(vid); found {
+		return vs.ioQos.Classify(ev.Collection, ev.DiskType(), traffic)
+	}
+	return vs.ioQos.Classify("", "", traffic)
+}
@@ -0,0 +1,115 @@
+package weed_server
+
+import (
+	"sync"
+	"time"
+
+	"github.com/gateway-dao/seaweedfs/weed/glog"
+	"github.com/gateway-dao/seaweedfs/weed/storage"
+	"github.com/gateway-dao/seaweedfs/weed/storage/needle"
+	"github.com/gateway-dao/seaweedfs/weed/util"
+)
+
+// volumeScrubber periodically reads through all local volumes and ec shards,
+// verifying needle checksums, quarantining and repairing corrupted needles.
+type volumeScrubber struct {
+	interval        time.Duration
+	bytesPerSecond  int64
+	lock            sync.RWMutex
+	isRunning       bool
+	currentVolumeId needle.VolumeId
+	results         map[needle.VolumeId]*storage.ScrubResult
+}
+
+func newVolumeScrubber(interval time.Duration, bytesPerSecond int64) *volumeScrubber {
+	return &volumeScrubber{
+		interval:       interval,
+		bytesPerSecond: bytesPerSecond,
+		results:        make(map[needle.VolumeId]*storage.ScrubResult),
+	}
+}
+
+func (vs *VolumeServer) loopScrubbing() {
+	glog.V(0).Infof("scrub volumes every %v at %d bytes/second", vs.scrubber.interval, vs.scrubber.bytesPerSecond)
+	timer := time.NewTimer(vs.scrubber.interval)
+	defer timer.Stop()
+	for {
+		select {
+		case <-vs.stopChan:
+			return
+		case <-timer.C:
+		}
+		vs.scrubAllVolumes()
+		timer.Reset(vs.scrubber.interval)
+	}
+}
+
+func (vs *VolumeServer) scrubAllVolumes() {
+	scrubber := vs.scrubber
+	scrubber.lock.Lock()
+	scrubber.isRunning = true
+	scrubber.lock.Unlock()
+	defer func() {
+		scrubber.lock.Lock()
+		scrubber.isRunning = false
+		scrubber.currentVolumeId = 0
+		scrubber.lock.Unlock()
+	}()
+
+	throttler := util.NewWriteThrottler(scrubber.bytesPerSecond)
+
+	for _, volumeInfo := range vs.store.VolumeInfos() {
+		if vs.isStopping() {
+			return
+		}
+		scrubber.setCurrentVolume(volumeInfo.Id)
+		scrubber.addResult(vs.store.ScrubVolume(volumeInfo.Id, throttler))
+	}
+
+	for _, ecVolume := range vs.store.EcVolumes() {
+		if vs.isStopping() {
+			return
+		}
+		scrubber.setCurrentVolume(ecVolume.VolumeId)
+		scrubber.addResult(vs.store.ScrubEcVolume(ecVolume.VolumeId, throttler))
+	}
+}
+
+func (vs *VolumeServer) isStopping() bool {
+	select {
+	case <-vs.stopChan:
+		return true
+	default:
+		return false
+	}
+}
+
+func (scrubber *volumeScrubber) setCurrentVolume(vid needle.VolumeId) {
+	scrubber.lock.Lock()
+	defer scrubber.lock.Unlock()
+	scrubber.currentVolumeId = vid
+}
+
+func (scrubber *volumeScrubber) addResult(result *storage.ScrubResult) {
+	if result.Error != nil {
+		glog.Warningf("scrub volume %d: %v", result.VolumeId, result.Error)
+	} else if result.CorruptedNeedles > 0 {
+		glog.Warningf("scrub volume %d: %d needles scanned, %d corrupted, %d repaired",
+			result.VolumeId, result.ScannedNeedles, result.CorruptedNeedles, result.RepairedNeedles)
+	} else {
+		glog.V(1).Infof("scrub volume %d: %d needles scanned", result.VolumeId, result.ScannedNeedles)
+	}
+
+	scrubber.lock.Lock()
+	defer scrubber.lock.Unlock()
+	scrubber.results[result.VolumeId] = result
+}
+
+func (scrubber *volumeScrubber) status() (isRunning bool, currentVolumeId needle.VolumeId, results []*storage.ScrubResult) {
+	scrubber.lock.RLock()
+	defer scrubber.lock.RUnlock()
+	for _, result := range scrubber.results {
+		results = append(results, result)
+	}
+	return scrubber.isRunning, scrubber.currentVolumeId, results
+}
@@ -4,6 +4,7 @@ import (
 	"context"
 	"fmt"
 	"io"
+	"net/http"
 	"os"
 	"path"
 	"strings"
@@ -75,12 +76,60 @@ func NewWebDavServer(option *WebDavOption) (ws *WebDavServer, err error) {
 		Handler: &webdav.Handler{
 			FileSystem: fs,
 			LockSystem: webdav.NewMemLS(),
+			Logger: func(r *http.Request, err error) {
+				if handlerErr, ok := r.Context().Value(webDavErrorKey{}).(*error); ok {
+					*handlerErr = err
+				}
+			},
 		},
 	}
 
 	return ws, nil
 }
 
+type webDavErrorKey struct{}
+
+// webDavResponseWriter holds back the response until the request is handled.
+type webDavResponseWriter struct {
+	http.ResponseWriter
+	status int
+	body   []byte
+}
+
+func (w *webDavResponseWriter) WriteHeader(status int) {
+	w.status = status
+}
+
+func (w *webDavResponseWriter) Write(b []byte) (int, error) {
+	w.body = append(w.body, b...)
+	return len(b), nil
+}
+
+// ServeHTTP replies the quota errors as 507 Insufficient Storage,
+// which webdav.Handler replies as 405 Method Not Allowed.
+func (ws *WebDavServer) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	switch r.Method {
+	case http.MethodPut, "MKCOL", "COPY", "MOVE":
+	default:
+		ws.Handler.ServeHTTP(w, r)
+		return
+	}
+
+	var handlerErr error
+	r = r.WithContext(context.WithValue(r.Context(), webDavErrorKey{}, &handlerErr))
+	rw := &webDavResponseWriter{ResponseWriter: w}
+	ws.Handler.ServeHTTP(rw, r)
+
+	if handlerErr != nil && strings.Contains(handlerErr.Error(), filer.MsgQuotaExceeded) {
+		http.Error(w, handlerErr.Error(), http.StatusInsufficientStorage)
+		return
+	}
+	if rw.status != 0 {
+		w.WriteHeader(rw.status)
+	}
+	w.Write(rw.body)
+}
+
 // adapted from https://github.com/mattn/davfs/blob/master/plugin/mysql/mysql.go
 
 type WebDavFileSystem struct {
@@ -0,0 +1,202 @@
+package shell
+
+import (
+	"context"
+	"flag"
+	"fmt"
+	"io"
+	"os"
+
+	"github.com/klauspost/compress/dict"
+
+	"github.com/gateway-dao/seaweedfs/weed/operation"
+	"github.com/gateway-dao/seaweedfs/weed/pb"
+	"github.com/gateway-dao/seaweedfs/weed/pb/master_pb"
+	"github.com/gateway-dao/seaweedfs/weed/pb/volume_server_pb"
+	"github.com/gateway-dao/seaweedfs/weed/util"
+)
+
+func init() {
+	Commands = append(Commands, &commandCollectionCompressionTrain{})
+}
+
+type commandCollectionCompressionTrain struct {
+}
+
+func (c *commandCollectionCompressionTrain) Name() string {
+	return "collection.compression.train"
+}
+
+func (c *commandCollectionCompressionTrain) Help() string {
+	return `train a zstd dictionary from sampled needles of a collection
+
+	collection.compression.train -collection <collection name> [-sampleCount 10000] [-dictSize 65536] [-apply]
+	collection.compression.train -collection <collection name> -dictionaryFile <file> -apply
+
+	This command reads up to sampleCount needles smaller than maxSampleSize from the volumes of the
+	collection, trains a zstd dictionary, and prints how well the samples compress with it.
+
+	With -apply, the dictionary is sent to all volume servers. Volume servers started with
+	"-compression=zstd" compress new compressible uploads of the collection with it.
+	Needles compressed by an older dictionary can still be read.
+
+	Volume servers added later need the dictionaries too. Save the dictionary with -o, and send it
+	again with -dictionaryFile.
+
+`
+}
+
+func (c *commandCollectionCompressionTrain) Do(args []string, commandEnv *CommandEnv, writer io.Writer) (err error) {
+
+	trainCommand := flag.NewFlagSet(c.Name(), flag.ContinueOnError)
+	collection := trainCommand.String("collection", "", "the collection name")
+	sampleCount := trainCommand.Int("sampleCount", 10000, "the max number of needles to sample")
+	maxSampleSize := trainCommand.Int("maxSampleSize", 128*1024, "skip needles larger than this, in bytes")
+	dictSize := trainCommand.Int("dictSize", 64*1024, "the max dictionary size, in bytes")
+	dictionaryFile := trainCommand.String("dictionaryFile", "", "send this dictionary file instead of training a new one")
+	outputFile := trainCommand.String("o", "", "save the dictionary to this file")
+	apply := trainCommand.Bool("apply", false, "send the dictionary to all volume servers")
+	if err = trainCommand.Parse(args); err != nil {
+		return nil
+	}
+	infoAboutSimulationMode(writer, *apply, "-apply")
+
+	if *apply {
+		if err = commandEnv.confirmIsLocked(args); err != nil {
+			return
+		}
+	}
+
+	topologyInfo, _, err := collectTopologyInfo(commandEnv, 0)
+	if err != nil {
+		return err
+	}
+
+	var dictionary []byte
+	if *dictionaryFile != "" {
+		if dictionary, err = os.ReadFile(*dictionaryFile); err != nil {
+			return err
+		}
+	} else {
+		samples, sampleErr := sampleCollectionNeedles(commandEnv, topologyInfo, *collection, *sampleCount, *maxSampleSize)
+		if sampleErr != nil {
+			return sampleErr
+		}
+		if len(samples) == 0 {
+			return fmt.Errorf("no needles found in collection %q", *collection)
+		}
+		if dictionary, err = dict.BuildZstdDict(samples, dict.Options{MaxDictSize: *dictSize, HashBytes: 6}); err != nil {
+			return fmt.Errorf("train dictionary: %v", err)
+		}
+		if err = printDictionaryRatio(writer, samples, dictionary); err != nil {
+			return err
+		}
+	}
+
+	if *outputFile != "" {
+		if err = os.WriteFile(*outputFile, dictionary, 0644); err != nil {
+			return err
+		}
+		fmt.Fprintf(writer, "saved dictionary to %s\n", *outputFile)
+	}
+
+	if !*apply {
+		return nil
+	}
+
+	eachDataNode(topologyInfo, func(dc string, rack RackId, dn *master_pb.DataNodeInfo) {
+		server := pb.NewServerAddressFromDataNode(dn)
+		addErr := operation.WithVolumeServerClient(false, server, commandEnv.option.GrpcDialOption, func(volumeServerClient volume_server_pb.VolumeServerClient) error {
+			resp, addErr := volumeServerClient.VolumeAddCompressionDictionary(context.Background(), &volume_server_pb.VolumeAddCompressionDictionaryRequest{
+				Collection: *collection,
+				Dictionary: dictionary,
+			})
+			if addErr == nil {
+				fmt.Fprintf(writer, "%s: collection %q uses dictionary %d\n", server, *collection, resp.DictionaryId)
+			}
+			return addErr
+		})
+		if addErr != nil {
+			fmt.Fprintf(writer, "%s: %v\n", server, addErr)
+			err = addErr
+		}
+	})
+
+	return err
+}
+
+// sampleCollectionNeedles reads the needles of each volume from one of its replicas
+func sampleCollectionNeedles(commandEnv *CommandEnv, topologyInfo *master_pb.TopologyInfo, collection string, sampleCount int, maxSampleSize int) (samples [][]byte, err error) {
+
+	volumeServers := make(map[uint32]pb.ServerAddress)
+	eachDataNode(topologyInfo, func(dc string, rack RackId, dn *master_pb.DataNodeInfo) {
+		for _, diskInfo := range dn.DiskInfos {
+			for _, v := range diskInfo.VolumeInfos {
+				if v.Collection == collection {
+					volumeServers[v.Id] = pb.NewServerAddressFromDataNode(dn)
+				}
+			}
+		}
+	})
+
+	for vid, server := range volumeServers {
+		if len(samples) >= sampleCount {
+			break
+		}
+		err = operation.WithVolumeServerClient(true, server, commandEnv.option.GrpcDialOption, func(volumeServerClient volume_server_pb.VolumeServerClient) error {
+			ctx, cancel := context.WithCancel(context.Background())
+			defer cancel()
+			stream, readErr := volumeServerClient.ReadAllNeedles(ctx, &volume_server_pb.ReadAllNeedlesRequest{
+				VolumeIds: []uint32{vid},
+			})
+			if readErr != nil {
+				return readErr
+			}
+			for len(samples) < sampleCount {
+				resp, recvErr := stream.Recv()
+				if recvErr == io.EOF {
+					return nil
+				}
+				if recvErr != nil {
+					return recvErr
+				}
+				data := resp.NeedleBlob
+				if resp.NeedleBlobCompressed {
+					if data, readErr = util.DecompressData(data); readErr != nil {
+						continue
+					}
+				}
+				if len(data) == 0 || len(data) > maxSampleSize {
+					continue
+				}
+				samples = append(samples, data)
+			}
+			return nil
+		})
+		if err != nil {
+			return nil, fmt.Errorf("read volume %d from %s: %v", vid, server, err)
+		}
+	}
+
+	return samples, nil
+}
+
+func printDictionaryRatio(writer io.Writer, samples [][]byte, dictionary []byte) error {
+	dictId, err := util.AddZstdDictionary(dictionary)
+	if err != nil {
+		return err
+	}
+	var originalSize, gzipSize, zstdSize, dictSize int
+	for _, sample := range samples {
+		originalSize += len(sample)
+		gzipped, _ := util.GzipData(sample)
+		gzipSize += len(gzipped)
+		compressed, _ := util.ZstdData(sample)
+		zstdSize += len(compressed)
+		compressed, _ = util.ZstdDataWithDictionary(sample, dictId)
+		dictSize += len(compressed)
+	}
+	fmt.Fprintf(writer, "trained dictionary %d of %d bytes from %d samples of %d bytes\n", dictId, len(dictionary), len(samples), originalSize)
+	fmt.Fprintf(writer, "compressed sizes: gzip %d, zstd %d, zstd with dictionary %d\n", gzipSize, zstdSize, dictSize)
+	return nil
+}
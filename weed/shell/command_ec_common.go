@@ -167,7 +167,17 @@ func countShards(ecShardInfos []*master_pb.VolumeEcShardInformationMessage) (cou
 	return
 }
 
-func countFreeShardSlots(dn *master_pb.DataNodeInfo, diskType types.DiskType) (count int) {
+// ecShardVolumeSlots is the share of volume slots taken by the ec shards, each shard holding 1/DataShards of its volume.
+func ecShardVolumeSlots(ecShardInfos []*master_pb.VolumeEcShardInformationMessage) (slots float64) {
+	for _, ecShardInfo := range ecShardInfos {
+		scheme := erasure_coding.EcSchemeOf(ecShardInfo.DataShards, ecShardInfo.ParityShards)
+		slots += scheme.VolumeSlots(erasure_coding.ShardBits(ecShardInfo.EcIndexBits).ShardIdCount())
+	}
+	return
+}
+
+// countFreeShardSlots is the number of shards of an ec volume encoded with the scheme that still fit on the disk.
+func countFreeShardSlots(dn *master_pb.DataNodeInfo, diskType types.DiskType, scheme erasure_coding.EcScheme) (count int) {
 	if dn.DiskInfos == nil {
 		return 0
 	}
@@ -175,7 +185,8 @@ func countFreeShardSlots(dn *master_pb.DataNodeInfo, diskType types.DiskType) (c
 	if diskInfo == nil {
 		return 0
 	}
-	return int(diskInfo.MaxVolumeCount-diskInfo.VolumeCount)*erasure_coding.DataShardsCount - countShards(diskInfo.EcShardInfos)
+	freeVolumeSlots := float64(diskInfo.MaxVolumeCount-diskInfo.VolumeCount) - ecShardVolumeSlots(diskInfo.EcShardInfos)
+	return int(math.Floor(freeVolumeSlots * float64(scheme.DataShards)))
 }
 
 type RackId string
@@ -205,7 +216,7 @@ type EcRack struct {
 	freeEcSlot int
 }
 
-func collectEcNodes(commandEnv *CommandEnv, selectedDataCenter string) (ecNodes []*EcNode, totalFreeEcSlots int, err error) {
+func collectEcNodes(commandEnv *CommandEnv, selectedDataCenter string, scheme erasure_coding.EcScheme) (ecNodes []*EcNode, totalFreeEcSlots int, err error) {
 
 	// list all possible locations
 	// collect topology information
@@ -215,20 +226,21 @@ func collectEcNodes(commandEnv *CommandEnv, selectedDataCenter string) (ecNodes
 	}
 
 	// find out all volume servers with one slot left.
-	ecNodes, totalFreeEcSlots = collectEcVolumeServersByDc(topologyInfo, selectedDataCenter)
+	ecNodes, totalFreeEcSlots = collectEcVolumeServersByDc(topologyInfo, selectedDataCenter, scheme)
 
 	sortEcNodesByFreeslotsDescending(ecNodes)
 
 	return
 }
 
-func collectEcVolumeServersByDc(topo *master_pb.TopologyInfo, selectedDataCenter string) (ecNodes []*EcNode, totalFreeEcSlots int) {
+// collectEcVolumeServersByDc counts the free ec slots in shards of an ec volume encoded with the scheme.
+func collectEcVolumeServersByDc(topo *master_pb.TopologyInfo, selectedDataCenter string, scheme erasure_coding.EcScheme) (ecNodes []*EcNode, totalFreeEcSlots int) {
 	eachDataNode(topo, func(dc string, rack RackId, dn *master_pb.DataNodeInfo) {
 		if selectedDataCenter != "" && selectedDataCenter != dc {
 			return
 		}
 
-		freeEcSlots := countFreeShardSlots(dn, types.HardDriveType)
+		freeEcSlots := countFreeShardSlots(dn, types.HardDriveType, scheme)
 		ecNodes = append(ecNodes, &EcNode{
 			info:       dn,
 			dc:         dc,
@@ -299,6 +311,22 @@ func findEcVolumeShards(ecNode *EcNode, vid needle.VolumeId) erasure_coding.Shar
 	return 0
 }
 
+// findEcVolumeScheme returns the ec scheme the volume is encoded with, as reported by any of the nodes holding its shards.
+func findEcVolumeScheme(ecNodes []*EcNode, vid needle.VolumeId) erasure_coding.EcScheme {
+
+	for _, ecNode := range ecNodes {
+		for _, diskInfo := range ecNode.info.DiskInfos {
+			for _, shardInfo := range diskInfo.EcShardInfos {
+				if needle.VolumeId(shardInfo.Id) == vid && shardInfo.DataShards > 0 {
+					return erasure_coding.EcSchemeOf(shardInfo.DataShards, shardInfo.ParityShards)
+				}
+			}
+		}
+	}
+
+	return erasure_coding.DefaultEcScheme
+}
+
 func (ecNode *EcNode) addEcVolumeShards(vid needle.VolumeId, collection string, shardIds []uint32) *EcNode {
 
 	foundVolume := false
@@ -35,17 +35,22 @@ func (c *commandEcEncode) Name() string {
 func (c *commandEcEncode) Help() string {
 	return `apply erasure coding to a volume
 
-	ec.encode [-collection=""] [-fullPercent=95 -quietFor=1h]
-	ec.encode [-collection=""] [-volumeId=<volume_id>]
+	ec.encode [-collection=""] [-fullPercent=95 -quietFor=1h] [-scheme=6+3]
+	ec.encode [-collection=""] [-volumeId=<volume_id>] [-scheme=6+3]
 
 	This command will:
 	1. freeze one volume
 	2. apply erasure coding to the volume
 	3. move the encoded shards to multiple volume servers
 
-	The erasure coding is 10.4. So ideally you have more than 14 volume servers, and you can afford
+	The erasure coding is 10.4 by default. So ideally you have more than 14 volume servers, and you can afford
 	to lose 4 volume servers.
 
+	Use -scheme to pick the data and parity shard counts for the collection, e.g. 4+2, 6+3 or 12+4.
+	A smaller scheme needs fewer volume servers to spread all shards. The scheme is stored
+	on the master as the collection's ec scheme, so later runs without -scheme keep using it.
+	Each ec volume also records its own scheme, and later ec.rebuild, ec.balance and ec.decode follow it.
+
 	If the number of volumes are not high, the worst case is that you only have 4 volume servers,
 	and the shards are spread as 4,4,3,3, respectively. You can afford to lose one volume server.
 
@@ -64,14 +69,26 @@ func (c *commandEcEncode) Do(args []string, commandEnv *CommandEnv, writer io.Wr
 	quietPeriod := encodeCommand.Duration("quietFor", time.Hour, "select volumes without no writes for this period")
 	parallelCopy := encodeCommand.Bool("parallelCopy", true, "copy shards in parallel")
 	forceChanges := encodeCommand.Bool("force", false, "force the encoding even if the cluster has less than recommended 4 nodes")
+	schemeString := encodeCommand.String("scheme", "", "erasure coding scheme as data+parity shards, e.g. 4+2, 6+3, 10+4 or 12+4, stored for the collection. Empty to use the collection's scheme")
 	if err = encodeCommand.Parse(args); err != nil {
 		return nil
 	}
+	if *schemeString != "" {
+		if _, err = erasure_coding.ParseEcScheme(*schemeString); err != nil {
+			return err
+		}
+	}
 
 	if err = commandEnv.confirmIsLocked(args); err != nil {
 		return
 	}
 
+	scheme, err := resolveCollectionEcScheme(commandEnv, *collection, *schemeString)
+	if err != nil {
+		return err
+	}
+	fmt.Fprintf(writer, "collection %q is erasure coded with %s\n", *collection, scheme)
+
 	// collect topology information
 	topologyInfo, _, err := collectTopologyInfo(commandEnv, 0)
 	if err != nil {
@@ -83,8 +100,8 @@ func (c *commandEcEncode) Do(args []string, commandEnv *CommandEnv, writer io.Wr
 		eachDataNode(topologyInfo, func(dc string, rack RackId, dn *master_pb.DataNodeInfo) {
 			nodeCount++
 		})
-		if nodeCount < erasure_coding.ParityShardsCount {
-			glog.V(0).Infof("skip erasure coding with %d nodes, less than recommended %d nodes", nodeCount, erasure_coding.ParityShardsCount)
+		if nodeCount < scheme.ParityShards {
+			glog.V(0).Infof("skip erasure coding with %d nodes, less than recommended %d nodes", nodeCount, scheme.ParityShards)
 			return nil
 		}
 	}
@@ -93,7 +110,7 @@ func (c *commandEcEncode) Do(args []string, commandEnv *CommandEnv, writer io.Wr
 
 	// volumeId is provided
 	if vid != 0 {
-		return doEcEncode(commandEnv, *collection, vid, *parallelCopy)
+		return doEcEncode(commandEnv, *collection, vid, scheme, *parallelCopy)
 	}
 
 	// apply to all volumes in the collection
@@ -103,7 +120,7 @@ func (c *commandEcEncode) Do(args []string, commandEnv *CommandEnv, writer io.Wr
 	}
 	fmt.Printf("ec encode volumes: %v\n", volumeIds)
 	for _, vid := range volumeIds {
-		if err = doEcEncode(commandEnv, *collection, vid, *parallelCopy); err != nil {
+		if err = doEcEncode(commandEnv, *collection, vid, scheme, *parallelCopy); err != nil {
 			return err
 		}
 	}
@@ -111,7 +128,31 @@ func (c *commandEcEncode) Do(args []string, commandEnv *CommandEnv, writer io.Wr
 	return nil
 }
 
-func doEcEncode(commandEnv *CommandEnv, collection string, vid needle.VolumeId, parallelCopy bool) (err error) {
+// resolveCollectionEcScheme stores the scheme as the collection's ec scheme on the master,
+// or returns the stored scheme if schemeString is empty.
+func resolveCollectionEcScheme(commandEnv *CommandEnv, collection string, schemeString string) (scheme erasure_coding.EcScheme, err error) {
+	err = commandEnv.MasterClient.WithClient(false, func(client master_pb.SeaweedClient) error {
+		if schemeString != "" {
+			if _, setErr := client.SetCollectionEcScheme(context.Background(), &master_pb.SetCollectionEcSchemeRequest{
+				Collection: collection,
+				EcScheme:   schemeString,
+			}); setErr != nil {
+				return setErr
+			}
+		}
+		resp, getErr := client.GetCollectionEcScheme(context.Background(), &master_pb.GetCollectionEcSchemeRequest{
+			Collection: collection,
+		})
+		if getErr != nil {
+			return getErr
+		}
+		scheme, getErr = erasure_coding.ParseEcScheme(resp.EcScheme)
+		return getErr
+	})
+	return
+}
+
+func doEcEncode(commandEnv *CommandEnv, collection string, vid needle.VolumeId, scheme erasure_coding.EcScheme, parallelCopy bool) (err error) {
 	if !commandEnv.isLocked() {
 		return fmt.Errorf("lock is lost")
 	}
@@ -131,13 +172,13 @@ func doEcEncode(commandEnv *CommandEnv, collection string, vid needle.VolumeId,
 	}
 
 	// generate ec shards
-	err = generateEcShards(commandEnv.option.GrpcDialOption, vid, collection, locations[0].ServerAddress())
+	err = generateEcShards(commandEnv.option.GrpcDialOption, vid, collection, locations[0].ServerAddress(), scheme)
 	if err != nil {
 		return fmt.Errorf("generate ec shards for volume %d on %s: %v", vid, locations[0].Url, err)
 	}
 
 	// balance the ec shards to current cluster
-	err = spreadEcShards(commandEnv, vid, collection, locations, parallelCopy)
+	err = spreadEcShards(commandEnv, vid, collection, locations, scheme, parallelCopy)
 	if err != nil {
 		return fmt.Errorf("spread ec shards for volume %d from %s: %v", vid, locations[0].Url, err)
 	}
@@ -145,15 +186,19 @@ func doEcEncode(commandEnv *CommandEnv, collection string, vid needle.VolumeId,
 	return nil
 }
 
-func generateEcShards(grpcDialOption grpc.DialOption, volumeId needle.VolumeId, collection string, sourceVolumeServer pb.ServerAddress) error {
+func generateEcShards(grpcDialOption grpc.DialOption, volumeId needle.VolumeId, collection string, sourceVolumeServer pb.ServerAddress, scheme erasure_coding.EcScheme) error {
 
-	fmt.Printf("generateEcShards %s %d on %s ...\n", collection, volumeId, sourceVolumeServer)
+	fmt.Printf("generateEcShards %s %d on %s with scheme %s ...\n", collection, volumeId, sourceVolumeServer, scheme)
 
 	err := operation.WithVolumeServerClient(false, sourceVolumeServer, grpcDialOption, func(volumeServerClient volume_server_pb.VolumeServerClient) error {
-		_, genErr := volumeServerClient.VolumeEcShardsGenerate(context.Background(), &volume_server_pb.VolumeEcShardsGenerateRequest{
+		req := &volume_server_pb.VolumeEcShardsGenerateRequest{
 			VolumeId:   uint32(volumeId),
 			Collection: collection,
-		})
+		}
+		if !scheme.IsDefault() {
+			req.EcShardConfig = scheme.ToConfig()
+		}
+		_, genErr := volumeServerClient.VolumeEcShardsGenerate(context.Background(), req)
 		return genErr
 	})
 
@@ -161,23 +206,20 @@ func generateEcShards(grpcDialOption grpc.DialOption, volumeId needle.VolumeId,
 
 }
 
-func spreadEcShards(commandEnv *CommandEnv, volumeId needle.VolumeId, collection string, existingLocations []wdclient.Location, parallelCopy bool) (err error) {
+func spreadEcShards(commandEnv *CommandEnv, volumeId needle.VolumeId, collection string, existingLocations []wdclient.Location, scheme erasure_coding.EcScheme, parallelCopy bool) (err error) {
 
-	allEcNodes, totalFreeEcSlots, err := collectEcNodes(commandEnv, "")
+	allEcNodes, totalFreeEcSlots, err := collectEcNodes(commandEnv, "", scheme)
 	if err != nil {
 		return err
 	}
 
-	if totalFreeEcSlots < erasure_coding.TotalShardsCount {
+	if totalFreeEcSlots < scheme.TotalShards() {
 		return fmt.Errorf("not enough free ec shard slots. only %d left", totalFreeEcSlots)
 	}
-	allocatedDataNodes := allEcNodes
-	if len(allocatedDataNodes) > erasure_coding.TotalShardsCount {
-		allocatedDataNodes = allocatedDataNodes[:erasure_coding.TotalShardsCount]
-	}
+	allocatedDataNodes := pickEcNodesAcrossRacks(allEcNodes, scheme.TotalShards())
 
 	// calculate how many shards to allocate for these servers
-	allocatedEcIds := balancedEcDistribution(allocatedDataNodes)
+	allocatedEcIds := balancedEcDistribution(allocatedDataNodes, scheme.TotalShards())
 
 	// ask the data nodes to copy from the source volume server
 	copiedShardIds, err := parallelCopyEcShardsFromSource(commandEnv.option.GrpcDialOption, allocatedDataNodes, allocatedEcIds, volumeId, collection, existingLocations[0], parallelCopy)
@@ -269,11 +311,45 @@ func parallelCopyEcShardsFromSource(grpcDialOption grpc.DialOption, targetServer
 	return
 }
 
-func balancedEcDistribution(servers []*EcNode) (allocated [][]uint32) {
+// pickEcNodesAcrossRacks picks up to count nodes with free slots, taking the nodes with the most free slots
+// from each rack in turn, so the shards of one volume are spread over as many racks as possible.
+// The ecNodes are expected to be sorted by free slots descending.
+func pickEcNodesAcrossRacks(ecNodes []*EcNode, count int) (picked []*EcNode) {
+	var rackIds []RackId
+	rackNodes := make(map[RackId][]*EcNode)
+	for _, ecNode := range ecNodes {
+		if ecNode.freeEcSlot <= 0 {
+			continue
+		}
+		if _, found := rackNodes[ecNode.rack]; !found {
+			rackIds = append(rackIds, ecNode.rack)
+		}
+		rackNodes[ecNode.rack] = append(rackNodes[ecNode.rack], ecNode)
+	}
+	for len(picked) < count {
+		pickedAny := false
+		for _, rackId := range rackIds {
+			if len(picked) >= count {
+				break
+			}
+			if nodes := rackNodes[rackId]; len(nodes) > 0 {
+				picked = append(picked, nodes[0])
+				rackNodes[rackId] = nodes[1:]
+				pickedAny = true
+			}
+		}
+		if !pickedAny {
+			break
+		}
+	}
+	return
+}
+
+func balancedEcDistribution(servers []*EcNode, totalShards int) (allocated [][]uint32) {
 	allocated = make([][]uint32, len(servers))
 	allocatedShardIdIndex := uint32(0)
 	serverIndex := rand.Intn(len(servers))
-	for allocatedShardIdIndex < erasure_coding.TotalShardsCount {
+	for allocatedShardIdIndex < uint32(totalShards) {
 		if servers[serverIndex].freeEcSlot > 0 {
 			allocated[serverIndex] = append(allocated[serverIndex], allocatedShardIdIndex)
 			allocatedShardIdIndex++
@@ -11,7 +11,7 @@ func TestEcDistribution(t *testing.T) {
 	topologyInfo := parseOutput(topoData)
 
 	// find out all volume servers with one slot left.
-	ecNodes, totalFreeEcSlots := collectEcVolumeServersByDc(topologyInfo, "")
+	ecNodes, totalFreeEcSlots := collectEcVolumeServersByDc(topologyInfo, "", erasure_coding.DefaultEcScheme)
 
 	sortEcNodesByFreeslotsDescending(ecNodes)
 
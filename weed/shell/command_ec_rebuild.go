@@ -1,16 +1,21 @@
 package shell
 
 import (
+	"cmp"
 	"context"
 	"flag"
 	"fmt"
 	"github.com/gateway-dao/seaweedfs/weed/pb"
 	"io"
 
+	"golang.org/x/exp/slices"
+
 	"github.com/gateway-dao/seaweedfs/weed/operation"
+	"github.com/gateway-dao/seaweedfs/weed/pb/master_pb"
 	"github.com/gateway-dao/seaweedfs/weed/pb/volume_server_pb"
 	"github.com/gateway-dao/seaweedfs/weed/storage/erasure_coding"
 	"github.com/gateway-dao/seaweedfs/weed/storage/needle"
+	"github.com/gateway-dao/seaweedfs/weed/storage/types"
 	"google.golang.org/grpc"
 )
 
@@ -28,7 +33,7 @@ func (c *commandEcRebuild) Name() string {
 func (c *commandEcRebuild) Help() string {
 	return `find and rebuild missing ec shards among volume servers
 
-	ec.rebuild [-c EACH_COLLECTION|<collection_name>] [-force]
+	ec.rebuild [-c EACH_COLLECTION|<collection_name>] [-volumeId=<volume id>] [-force]
 
 	Algorithm:
 
@@ -60,6 +65,7 @@ func (c *commandEcRebuild) Do(args []string, commandEnv *CommandEnv, writer io.W
 	fixCommand := flag.NewFlagSet(c.Name(), flag.ContinueOnError)
 	collection := fixCommand.String("collection", "EACH_COLLECTION", "collection name, or \"EACH_COLLECTION\" for each collection")
 	applyChanges := fixCommand.Bool("force", false, "apply the changes")
+	volumeId := fixCommand.Uint("volumeId", 0, "only rebuild this ec volume")
 	if err = fixCommand.Parse(args); err != nil {
 		return nil
 	}
@@ -70,7 +76,7 @@ func (c *commandEcRebuild) Do(args []string, commandEnv *CommandEnv, writer io.W
 	}
 
 	// collect all ec nodes
-	allEcNodes, _, err := collectEcNodes(commandEnv, "")
+	allEcNodes, _, err := collectEcNodes(commandEnv, "", erasure_coding.DefaultEcScheme)
 	if err != nil {
 		return err
 	}
@@ -83,12 +89,12 @@ func (c *commandEcRebuild) Do(args []string, commandEnv *CommandEnv, writer io.W
 		fmt.Printf("rebuildEcVolumes collections %+v\n", len(collections))
 		for _, c := range collections {
 			fmt.Printf("rebuildEcVolumes collection %+v\n", c)
-			if err = rebuildEcVolumes(commandEnv, allEcNodes, c, writer, *applyChanges); err != nil {
+			if err = rebuildEcVolumes(commandEnv, allEcNodes, c, needle.VolumeId(*volumeId), writer, *applyChanges); err != nil {
 				return err
 			}
 		}
 	} else {
-		if err = rebuildEcVolumes(commandEnv, allEcNodes, *collection, writer, *applyChanges); err != nil {
+		if err = rebuildEcVolumes(commandEnv, allEcNodes, *collection, needle.VolumeId(*volumeId), writer, *applyChanges); err != nil {
 			return err
 		}
 	}
@@ -96,7 +102,7 @@ func (c *commandEcRebuild) Do(args []string, commandEnv *CommandEnv, writer io.W
 	return nil
 }
 
-func rebuildEcVolumes(commandEnv *CommandEnv, allEcNodes []*EcNode, collection string, writer io.Writer, applyChanges bool) error {
+func rebuildEcVolumes(commandEnv *CommandEnv, allEcNodes []*EcNode, collection string, volumeId needle.VolumeId, writer io.Writer, applyChanges bool) error {
 
 	fmt.Printf("rebuildEcVolumes %s\n", collection)
 
@@ -107,21 +113,26 @@ func rebuildEcVolumes(commandEnv *CommandEnv, allEcNodes []*EcNode, collection s
 	}
 
 	for vid, locations := range ecShardMap {
+		if volumeId != 0 && vid != volumeId {
+			continue
+		}
+		scheme := findEcVolumeScheme(allEcNodes, vid)
+		locations = locations[:scheme.TotalShards()]
 		shardCount := locations.shardCount()
-		if shardCount == erasure_coding.TotalShardsCount {
+		if shardCount == scheme.TotalShards() {
 			continue
 		}
-		if shardCount < erasure_coding.DataShardsCount {
+		if shardCount < scheme.DataShards {
 			return fmt.Errorf("ec volume %d is unrepairable with %d shards\n", vid, shardCount)
 		}
 
 		sortEcNodesByFreeslotsDescending(allEcNodes)
 
-		if allEcNodes[0].freeEcSlot < erasure_coding.TotalShardsCount {
+		if countFreeShardSlots(allEcNodes[0].info, types.HardDriveType, scheme) < scheme.TotalShards() {
 			return fmt.Errorf("disk space is not enough")
 		}
 
-		if err := rebuildOneEcVolume(commandEnv, allEcNodes[0], collection, vid, locations, writer, applyChanges); err != nil {
+		if err := rebuildOneEcVolume(commandEnv, allEcNodes[0], collection, vid, scheme, locations, writer, applyChanges); err != nil {
 			return err
 		}
 	}
@@ -129,7 +140,7 @@ func rebuildEcVolumes(commandEnv *CommandEnv, allEcNodes []*EcNode, collection s
 	return nil
 }
 
-func rebuildOneEcVolume(commandEnv *CommandEnv, rebuilder *EcNode, collection string, volumeId needle.VolumeId, locations EcShardLocations, writer io.Writer, applyChanges bool) error {
+func rebuildOneEcVolume(commandEnv *CommandEnv, rebuilder *EcNode, collection string, volumeId needle.VolumeId, scheme erasure_coding.EcScheme, locations EcShardLocations, writer io.Writer, applyChanges bool) error {
 
 	if !commandEnv.isLocked() {
 		return fmt.Errorf("lock is lost")
@@ -139,7 +150,7 @@ func rebuildOneEcVolume(commandEnv *CommandEnv, rebuilder *EcNode, collection st
 
 	// collect shard files to rebuilder local disk
 	var generatedShardIds []uint32
-	copiedShardIds, _, err := prepareDataToRecover(commandEnv, rebuilder, collection, volumeId, locations, writer, applyChanges)
+	copiedShardIds, _, err := prepareDataToRecover(commandEnv, rebuilder, collection, volumeId, scheme, locations, writer, applyChanges)
 	if err != nil {
 		return err
 	}
@@ -190,7 +201,7 @@ func generateMissingShards(grpcDialOption grpc.DialOption, collection string, vo
 	return
 }
 
-func prepareDataToRecover(commandEnv *CommandEnv, rebuilder *EcNode, collection string, volumeId needle.VolumeId, locations EcShardLocations, writer io.Writer, applyBalancing bool) (copiedShardIds []uint32, localShardIds []uint32, err error) {
+func prepareDataToRecover(commandEnv *CommandEnv, rebuilder *EcNode, collection string, volumeId needle.VolumeId, scheme erasure_coding.EcScheme, locations EcShardLocations, writer io.Writer, applyBalancing bool) (copiedShardIds []uint32, localShardIds []uint32, err error) {
 
 	needEcxFile := true
 	var localShardBits erasure_coding.ShardBits
@@ -243,7 +254,7 @@ func prepareDataToRecover(commandEnv *CommandEnv, rebuilder *EcNode, collection
 
 	}
 
-	if len(copiedShardIds)+len(localShardIds) >= erasure_coding.DataShardsCount {
+	if len(copiedShardIds)+len(localShardIds) >= scheme.DataShards {
 		return copiedShardIds, localShardIds, nil
 	}
 
@@ -251,6 +262,52 @@ func prepareDataToRecover(commandEnv *CommandEnv, rebuilder *EcNode, collection
 
 }
 
+// EcVolumeRepair is an ec volume missing some shards.
+type EcVolumeRepair struct {
+	VolumeId     needle.VolumeId
+	Collection   string
+	ShardCount   int
+	TotalShards  int
+	Unrepairable bool
+}
+
+// PlanEcRebuild finds the ec volumes ec.rebuild would rebuild, without changing anything.
+func PlanEcRebuild(topologyInfo *master_pb.TopologyInfo) (repairs []EcVolumeRepair) {
+	allEcNodes, _ := collectEcVolumeServersByDc(topologyInfo, "", erasure_coding.DefaultEcScheme)
+	collections := make(map[string]bool)
+	for _, ecNode := range allEcNodes {
+		for _, diskInfo := range ecNode.info.DiskInfos {
+			for _, shardInfo := range diskInfo.EcShardInfos {
+				collections[shardInfo.Collection] = true
+			}
+		}
+	}
+	for collection := range collections {
+		ecShardMap := make(EcShardMap)
+		for _, ecNode := range allEcNodes {
+			ecShardMap.registerEcNode(ecNode, collection)
+		}
+		for vid, locations := range ecShardMap {
+			scheme := findEcVolumeScheme(allEcNodes, vid)
+			shardCount := locations[:scheme.TotalShards()].shardCount()
+			if shardCount == scheme.TotalShards() {
+				continue
+			}
+			repairs = append(repairs, EcVolumeRepair{
+				VolumeId:     vid,
+				Collection:   collection,
+				ShardCount:   shardCount,
+				TotalShards:  scheme.TotalShards(),
+				Unrepairable: shardCount < scheme.DataShards,
+			})
+		}
+	}
+	slices.SortFunc(repairs, func(a, b EcVolumeRepair) int {
+		return cmp.Compare(a.VolumeId, b.VolumeId)
+	})
+	return
+}
+
 type EcShardMap map[needle.VolumeId]EcShardLocations
 type EcShardLocations [][]*EcNode
 
@@ -260,7 +317,7 @@ func (ecShardMap EcShardMap) registerEcNode(ecNode *EcNode, collection string) {
 			if shardInfo.Collection == collection {
 				existing, found := ecShardMap[needle.VolumeId(shardInfo.Id)]
 				if !found {
-					existing = make([][]*EcNode, erasure_coding.TotalShardsCount)
+					existing = make([][]*EcNode, erasure_coding.MaxShardCount)
 					ecShardMap[needle.VolumeId(shardInfo.Id)] = existing
 				}
 				for _, shardId := range erasure_coding.ShardBits(shardInfo.EcIndexBits).ShardIds() {
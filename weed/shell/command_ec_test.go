@@ -5,6 +5,7 @@ import (
 	"testing"
 
 	"github.com/gateway-dao/seaweedfs/weed/pb/master_pb"
+	"github.com/gateway-dao/seaweedfs/weed/storage/erasure_coding"
 	"github.com/gateway-dao/seaweedfs/weed/storage/needle"
 )
 
@@ -15,11 +16,60 @@ func TestCommandEcDistribution(t *testing.T) {
 		newEcNode("dc1", "rack2", "dn2", 100),
 	}
 
-	allocated := balancedEcDistribution(allEcNodes)
+	allocated := balancedEcDistribution(allEcNodes, erasure_coding.TotalShardsCount)
 
 	fmt.Printf("allocated: %+v", allocated)
 }
 
+func TestCommandEcPickNodesAcrossRacks(t *testing.T) {
+
+	allEcNodes := []*EcNode{
+		newEcNode("dc1", "rack1", "dn1", 100),
+		newEcNode("dc1", "rack1", "dn2", 90),
+		newEcNode("dc1", "rack1", "dn3", 80),
+		newEcNode("dc1", "rack2", "dn4", 70),
+		newEcNode("dc1", "rack3", "dn5", 60),
+		newEcNode("dc1", "rack3", "dn6", 0),
+	}
+
+	picked := pickEcNodesAcrossRacks(allEcNodes, 4)
+	var ids []string
+	for _, ecNode := range picked {
+		ids = append(ids, ecNode.info.Id)
+	}
+	if fmt.Sprint(ids) != "[dn1 dn4 dn5 dn2]" {
+		t.Errorf("picked %v", ids)
+	}
+
+	if picked = pickEcNodesAcrossRacks(allEcNodes, 9); len(picked) != 5 {
+		t.Errorf("picked %d nodes, expected the 5 nodes with free slots", len(picked))
+	}
+}
+
+func TestCommandEcFreeShardSlotsByScheme(t *testing.T) {
+
+	dn := &master_pb.DataNodeInfo{
+		DiskInfos: map[string]*master_pb.DiskInfo{
+			"": {
+				MaxVolumeCount: 3,
+				VolumeCount:    1,
+				EcShardInfos: []*master_pb.VolumeEcShardInformationMessage{
+					// 3 shards of a 6+3 volume take half a volume slot
+					{Id: 1, EcIndexBits: 0x7, DataShards: 6, ParityShards: 3},
+				},
+			},
+		},
+	}
+
+	if free := countFreeShardSlots(dn, "", erasure_coding.DefaultEcScheme); free != 15 {
+		t.Errorf("free 10+4 shard slots %d, expected 15", free)
+	}
+	scheme, _ := erasure_coding.NewEcScheme(4, 2)
+	if free := countFreeShardSlots(dn, "", scheme); free != 6 {
+		t.Errorf("free 4+2 shard slots %d, expected 6", free)
+	}
+}
+
 func TestCommandEcBalanceSmall(t *testing.T) {
 
 	allEcNodes := []*EcNode{
@@ -37,6 +37,12 @@ func (c *commandFsConfigure) Help() string {
 	# example: configure adding only 1 physical volume for each bucket collection
 	fs.configure -locationPrefix=/buckets/ -volumeGrowthCount=1
 
+	# move the deleted files to /.trash, and purge them after 30 days
+	fs.configure -locationPrefix=/my/folder -trash -trashRetentionDays=30
+
+	# keep the last 5 versions of the overwritten files, for up to 90 days
+	fs.configure -locationPrefix=/my/folder -versioning -maxVersions=5 -versionRetentionDays=90
+
 	# apply the changes
 	fs.configure -locationPrefix=/my/folder -collection=abc -apply
 
@@ -61,6 +67,11 @@ func (c *commandFsConfigure) Do(args []string, commandEnv *CommandEnv, writer io
 	rack := fsConfigureCommand.String("rack", "", "assign writes to this rack")
 	dataNode := fsConfigureCommand.String("dataNode", "", "assign writes to this dataNode")
 	volumeGrowthCount := fsConfigureCommand.Int("volumeGrowthCount", 0, "the number of physical volumes to add if no writable volumes")
+	trash := fsConfigureCommand.Bool("trash", false, "move the deleted files to /.trash instead of deleting them")
+	trashRetentionDays := fsConfigureCommand.Uint("trashRetentionDays", 0, fmt.Sprintf("days to keep the deleted files in /.trash, default %d", filer.DefaultTrashRetentionDays))
+	versioning := fsConfigureCommand.Bool("versioning", false, "keep the previous versions of the overwritten files in /.versions")
+	maxVersions := fsConfigureCommand.Uint("maxVersions", 0, fmt.Sprintf("the number of versions to keep for each file, default %d", filer.DefaultMaxVersions))
+	versionRetentionDays := fsConfigureCommand.Uint("versionRetentionDays", 0, "days to keep the versions, 0 to keep them until pruned by maxVersions")
 	isDelete := fsConfigureCommand.Bool("delete", false, "delete the configuration by locationPrefix")
 	apply := fsConfigureCommand.Bool("apply", false, "update and apply filer configuration")
 	if err = fsConfigureCommand.Parse(args); err != nil {
@@ -75,18 +86,23 @@ func (c *commandFsConfigure) Do(args []string, commandEnv *CommandEnv, writer io
 	if *locationPrefix != "" {
 		infoAboutSimulationMode(writer, *apply, "-apply")
 		locConf := &filer_pb.FilerConf_PathConf{
-			LocationPrefix:    *locationPrefix,
-			Collection:        *collection,
-			Replication:       *replication,
-			Ttl:               *ttl,
-			Fsync:             *fsync,
-			MaxFileNameLength: uint32(*maxFileNameLength),
-			DiskType:          *diskType,
-			VolumeGrowthCount: uint32(*volumeGrowthCount),
-			ReadOnly:          *isReadOnly,
-			DataCenter:        *dataCenter,
-			Rack:              *rack,
-			DataNode:          *dataNode,
+			LocationPrefix:       *locationPrefix,
+			Collection:           *collection,
+			Replication:          *replication,
+			Ttl:                  *ttl,
+			Fsync:                *fsync,
+			MaxFileNameLength:    uint32(*maxFileNameLength),
+			DiskType:             *diskType,
+			VolumeGrowthCount:    uint32(*volumeGrowthCount),
+			ReadOnly:             *isReadOnly,
+			DataCenter:           *dataCenter,
+			Rack:                 *rack,
+			DataNode:             *dataNode,
+			Trash:                *trash,
+			TrashRetentionDays:   uint32(*trashRetentionDays),
+			Versioning:           *versioning,
+			MaxVersions:          uint32(*maxVersions),
+			VersionRetentionDays: uint32(*versionRetentionDays),
 		}
 
 		// check collection
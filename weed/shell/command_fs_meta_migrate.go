@@ -0,0 +1,104 @@
+package shell
+
+import (
+	"context"
+	"flag"
+	"fmt"
+	"io"
+	"time"
+
+	"github.com/gateway-dao/seaweedfs/weed/pb/filer_pb"
+)
+
+func init() {
+	Commands = append(Commands, &commandFsMetaMigrate{})
+}
+
+type commandFsMetaMigrate struct {
+}
+
+func (c *commandFsMetaMigrate) Name() string {
+	return "fs.meta.migrate"
+}
+
+func (c *commandFsMetaMigrate) Help() string {
+	return `migrate the filer store online, to the store configured in [migration.<store name>] of filer.toml
+
+	fs.meta.migrate                       # show the progress
+	fs.meta.migrate -start                # copy the old store to the new store, and verify it
+	fs.meta.migrate -verify -sample=0.1   # verify 10% of the entries again
+	fs.meta.migrate -switch               # read from the new store, still writing to both stores
+	fs.meta.migrate -finish               # stop writing to the old store
+
+	All filers sharing the store need the same [migration.<store name>] configuration,
+	to write to both stores until the migration is finished.
+	The backfill and the verification run on the connected filer, and resume from their checkpoints.
+	Switching waits until no filer has writes missing in one of the stores,
+	and finishing until every live filer reads from the new store.
+	After finishing, configure the new store as the filer store, and remove the migration configuration.
+`
+}
+
+func (c *commandFsMetaMigrate) Do(args []string, commandEnv *CommandEnv, writer io.Writer) (err error) {
+
+	migrateCommand := flag.NewFlagSet(c.Name(), flag.ContinueOnError)
+	start := migrateCommand.Bool("start", false, "copy the old store to the new store, and verify it, or resume them")
+	verify := migrateCommand.Bool("verify", false, "verify the new store again")
+	switchReads := migrateCommand.Bool("switch", false, "read from the new store")
+	finish := migrateCommand.Bool("finish", false, "stop writing to the old store")
+	sampleRate := migrateCommand.Float64("sample", 0, "the ratio of entries to verify, default 0.01")
+	if err = migrateCommand.Parse(args); err != nil {
+		return nil
+	}
+
+	action := ""
+	for name, isSet := range map[string]bool{"start": *start, "verify": *verify, "switch": *switchReads, "finish": *finish} {
+		if !isSet {
+			continue
+		}
+		if action != "" {
+			return fmt.Errorf("only one of -start, -verify, -switch and -finish is allowed")
+		}
+		action = name
+	}
+
+	return commandEnv.WithFilerClient(false, func(client filer_pb.SeaweedFilerClient) error {
+		resp, err := client.MigrateStore(context.Background(), &filer_pb.MigrateStoreRequest{
+			Action:     action,
+			SampleRate: *sampleRate,
+		})
+		if err != nil {
+			return err
+		}
+		printStoreMigration(writer, resp.Status)
+		return nil
+	})
+}
+
+func printStoreMigration(writer io.Writer, status *filer_pb.StoreMigrationStatus) {
+	fmt.Fprintf(writer, "migrate %s => %s\n", status.OldStore, status.NewStore)
+	fmt.Fprintf(writer, "phase:      %s\n", status.Phase)
+	if status.Owner != "" {
+		fmt.Fprintf(writer, "running on: %s\n", status.Owner)
+	}
+	if status.Checkpoint != "" {
+		fmt.Fprintf(writer, "checkpoint: %s\n", status.Checkpoint)
+	}
+	fmt.Fprintf(writer, "copied:     %d entries\n", status.CopiedEntries)
+	fmt.Fprintf(writer, "verified:   %d entries, %.2f%% sampled, %d repaired\n",
+		status.VerifiedEntries, status.SampleRate*100, status.MismatchedEntries)
+	fmt.Fprintf(writer, "dual-write errors on this filer: %d\n", status.DualWriteErrors)
+	fmt.Fprintf(writer, "started:    %s\n", time.Unix(0, status.StartedAtNs).Format(time.RFC3339))
+	fmt.Fprintf(writer, "updated:    %s\n", time.Unix(0, status.UpdatedAtNs).Format(time.RFC3339))
+	if status.Error != "" {
+		fmt.Fprintf(writer, "error:      %s\n", status.Error)
+	}
+	for _, filer := range status.Filers {
+		if filer.Phase == "" {
+			fmt.Fprintf(writer, "filer %s: not reported\n", filer.Address)
+			continue
+		}
+		fmt.Fprintf(writer, "filer %s: phase %s, %d dual-write errors, %d writes to retry\n", filer.Address, filer.Phase,
+			filer.DualWriteErrors, len(filer.FailedPaths)+len(filer.FailedFolders)+len(filer.FailedKeys))
+	}
+}
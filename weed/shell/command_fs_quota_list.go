@@ -0,0 +1,58 @@
+package shell
+
+import (
+	"context"
+	"fmt"
+	"io"
+
+	"github.com/gateway-dao/seaweedfs/weed/pb/filer_pb"
+	"github.com/gateway-dao/seaweedfs/weed/util"
+)
+
+func init() {
+	Commands = append(Commands, &commandFsQuotaList{})
+}
+
+type commandFsQuotaList struct {
+}
+
+func (c *commandFsQuotaList) Name() string {
+	return "fs.quota.list"
+}
+
+func (c *commandFsQuotaList) Help() string {
+	return `list the directory quotas with their usage
+
+	fs.quota.list
+`
+}
+
+func (c *commandFsQuotaList) Do(args []string, commandEnv *CommandEnv, writer io.Writer) (err error) {
+
+	return commandEnv.WithFilerClient(false, func(client filer_pb.SeaweedFilerClient) error {
+		resp, err := client.ListDirectoryQuotas(context.Background(), &filer_pb.ListDirectoryQuotasRequest{})
+		if err != nil {
+			return err
+		}
+		for _, quota := range resp.Quotas {
+			printDirectoryQuota(writer, quota)
+		}
+		return nil
+	})
+}
+
+func printDirectoryQuota(writer io.Writer, quota *filer_pb.DirectoryQuota) {
+	if quota.MaxBytes == 0 && quota.MaxEntries == 0 {
+		fmt.Fprintf(writer, "%s\tno quota\n", quota.Directory)
+		return
+	}
+	maxBytes, maxEntries := "unlimited", "unlimited"
+	if quota.MaxBytes > 0 {
+		maxBytes = util.BytesToHumanReadable(uint64(quota.MaxBytes))
+	}
+	if quota.MaxEntries > 0 {
+		maxEntries = fmt.Sprintf("%d", quota.MaxEntries)
+	}
+	fmt.Fprintf(writer, "%s\t%s of %s\t%d of %s entries\n", quota.Directory,
+		util.BytesToHumanReadable(uint64(quota.UsedBytes)), maxBytes, quota.UsedEntries, maxEntries)
+}
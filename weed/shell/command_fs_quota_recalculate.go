@@ -0,0 +1,65 @@
+package shell
+
+import (
+	"context"
+	"flag"
+	"io"
+
+	"github.com/gateway-dao/seaweedfs/weed/pb/filer_pb"
+)
+
+func init() {
+	Commands = append(Commands, &commandFsQuotaRecalculate{})
+}
+
+type commandFsQuotaRecalculate struct {
+}
+
+func (c *commandFsQuotaRecalculate) Name() string {
+	return "fs.quota.recalculate"
+}
+
+func (c *commandFsQuotaRecalculate) Help() string {
+	return `count the usage of the directory quotas again
+
+	fs.quota.recalculate               # all directories with quotas
+	fs.quota.recalculate /home/user1   # one directory
+
+	The usage is kept up to date incrementally, but can drift, e.g. by expired ttl entries,
+	or concurrent writes from filers sharing the same filer store.
+	This walks the directory trees to fix it. Writes are not blocked while walking.
+	To run it periodically, add it to master.maintenance.scripts in master.toml, e.g.
+
+	  lock
+	  fs.quota.recalculate
+	  unlock
+`
+}
+
+func (c *commandFsQuotaRecalculate) Do(args []string, commandEnv *CommandEnv, writer io.Writer) (err error) {
+
+	quotaCommand := flag.NewFlagSet(c.Name(), flag.ContinueOnError)
+	if err = quotaCommand.Parse(args); err != nil {
+		return nil
+	}
+
+	var path string
+	if quotaCommand.NArg() > 0 {
+		if path, err = commandEnv.parseUrl(quotaCommand.Arg(0)); err != nil {
+			return err
+		}
+	}
+
+	return commandEnv.WithFilerClient(false, func(client filer_pb.SeaweedFilerClient) error {
+		resp, err := client.RecalculateDirectoryQuotas(context.Background(), &filer_pb.RecalculateDirectoryQuotasRequest{
+			Directory: path,
+		})
+		if err != nil {
+			return err
+		}
+		for _, quota := range resp.Quotas {
+			printDirectoryQuota(writer, quota)
+		}
+		return nil
+	})
+}
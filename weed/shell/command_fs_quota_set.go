@@ -0,0 +1,63 @@
+package shell
+
+import (
+	"context"
+	"flag"
+	"io"
+
+	"github.com/gateway-dao/seaweedfs/weed/pb/filer_pb"
+)
+
+func init() {
+	Commands = append(Commands, &commandFsQuotaSet{})
+}
+
+type commandFsQuotaSet struct {
+}
+
+func (c *commandFsQuotaSet) Name() string {
+	return "fs.quota.set"
+}
+
+func (c *commandFsQuotaSet) Help() string {
+	return `set the quota of a directory tree
+
+	fs.quota.set -sizeMB=1024 -entries=100000 /home/user1
+	fs.quota.set -sizeMB=0 -entries=0 /home/user1     # remove the quota
+
+	The quota limits the bytes and the entry count of everything under the directory.
+	Writes exceeding it are rejected by the filer, while deleting is always allowed.
+	0 is no limit, and the quota is removed if both limits are 0.
+
+	The usage is counted when the quota is set, and kept up to date incrementally.
+	See fs.quota.list and fs.quota.recalculate.
+`
+}
+
+func (c *commandFsQuotaSet) Do(args []string, commandEnv *CommandEnv, writer io.Writer) (err error) {
+
+	quotaCommand := flag.NewFlagSet(c.Name(), flag.ContinueOnError)
+	sizeMB := quotaCommand.Int64("sizeMB", 0, "the quota size in MiB, 0 for no limit")
+	entries := quotaCommand.Int64("entries", 0, "the quota of entry count, 0 for no limit")
+	if err = quotaCommand.Parse(args); err != nil {
+		return nil
+	}
+
+	path, err := commandEnv.parseUrl(findInputDirectory(quotaCommand.Args()))
+	if err != nil {
+		return err
+	}
+
+	return commandEnv.WithFilerClient(false, func(client filer_pb.SeaweedFilerClient) error {
+		resp, err := client.SetDirectoryQuota(context.Background(), &filer_pb.SetDirectoryQuotaRequest{
+			Directory:  path,
+			MaxBytes:   *sizeMB * 1024 * 1024,
+			MaxEntries: *entries,
+		})
+		if err != nil {
+			return err
+		}
+		printDirectoryQuota(writer, resp.Quota)
+		return nil
+	})
+}
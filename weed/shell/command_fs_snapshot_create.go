@@ -0,0 +1,64 @@
+package shell
+
+import (
+	"context"
+	"flag"
+	"fmt"
+	"io"
+
+	"github.com/gateway-dao/seaweedfs/weed/pb/filer_pb"
+)
+
+func init() {
+	Commands = append(Commands, &commandFsSnapshotCreate{})
+}
+
+type commandFsSnapshotCreate struct {
+}
+
+func (c *commandFsSnapshotCreate) Name() string {
+	return "fs.snapshot.create"
+}
+
+func (c *commandFsSnapshotCreate) Help() string {
+	return `snapshot a directory tree
+
+	fs.snapshot.create -name=before_migration /buckets/dataset
+
+	The snapshot copies only the metadata, and shares the file chunks with the directory.
+	It can be read under the read only /.snapshots/<name>/ directory.
+	The chunks are kept until no file and no snapshot uses them.
+	Files with a ttl are not in the snapshot.
+
+	See fs.snapshot.list, fs.snapshot.restore and fs.snapshot.delete.
+`
+}
+
+func (c *commandFsSnapshotCreate) Do(args []string, commandEnv *CommandEnv, writer io.Writer) (err error) {
+
+	snapshotCommand := flag.NewFlagSet(c.Name(), flag.ContinueOnError)
+	name := snapshotCommand.String("name", "", "the snapshot name")
+	if err = snapshotCommand.Parse(args); err != nil {
+		return nil
+	}
+	if *name == "" {
+		return fmt.Errorf("need a snapshot name with -name")
+	}
+
+	path, err := commandEnv.parseUrl(findInputDirectory(snapshotCommand.Args()))
+	if err != nil {
+		return err
+	}
+
+	return commandEnv.WithFilerClient(false, func(client filer_pb.SeaweedFilerClient) error {
+		resp, err := client.CreateSnapshot(context.Background(), &filer_pb.CreateSnapshotRequest{
+			Name:      *name,
+			Directory: path,
+		})
+		if err != nil {
+			return err
+		}
+		printSnapshot(writer, resp.Snapshot)
+		return nil
+	})
+}
@@ -0,0 +1,53 @@
+package shell
+
+import (
+	"context"
+	"flag"
+	"fmt"
+	"io"
+
+	"github.com/gateway-dao/seaweedfs/weed/pb/filer_pb"
+)
+
+func init() {
+	Commands = append(Commands, &commandFsSnapshotDelete{})
+}
+
+type commandFsSnapshotDelete struct {
+}
+
+func (c *commandFsSnapshotDelete) Name() string {
+	return "fs.snapshot.delete"
+}
+
+func (c *commandFsSnapshotDelete) Help() string {
+	return `delete a directory snapshot
+
+	fs.snapshot.delete -name=before_migration
+
+	The file chunks not used by any file or other snapshot are deleted.
+`
+}
+
+func (c *commandFsSnapshotDelete) Do(args []string, commandEnv *CommandEnv, writer io.Writer) (err error) {
+
+	snapshotCommand := flag.NewFlagSet(c.Name(), flag.ContinueOnError)
+	name := snapshotCommand.String("name", "", "the snapshot name")
+	if err = snapshotCommand.Parse(args); err != nil {
+		return nil
+	}
+	if *name == "" {
+		return fmt.Errorf("need a snapshot name with -name")
+	}
+
+	return commandEnv.WithFilerClient(false, func(client filer_pb.SeaweedFilerClient) error {
+		resp, err := client.DeleteSnapshot(context.Background(), &filer_pb.DeleteSnapshotRequest{
+			Name: *name,
+		})
+		if err != nil {
+			return err
+		}
+		fmt.Fprintf(writer, "deleted snapshot %s, freed %d chunks\n", *name, resp.FreedChunkCount)
+		return nil
+	})
+}
@@ -0,0 +1,47 @@
+package shell
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"time"
+
+	"github.com/gateway-dao/seaweedfs/weed/pb/filer_pb"
+)
+
+func init() {
+	Commands = append(Commands, &commandFsSnapshotList{})
+}
+
+type commandFsSnapshotList struct {
+}
+
+func (c *commandFsSnapshotList) Name() string {
+	return "fs.snapshot.list"
+}
+
+func (c *commandFsSnapshotList) Help() string {
+	return `list the directory snapshots
+
+	fs.snapshot.list
+`
+}
+
+func (c *commandFsSnapshotList) Do(args []string, commandEnv *CommandEnv, writer io.Writer) (err error) {
+
+	return commandEnv.WithFilerClient(false, func(client filer_pb.SeaweedFilerClient) error {
+		resp, err := client.ListSnapshots(context.Background(), &filer_pb.ListSnapshotsRequest{})
+		if err != nil {
+			return err
+		}
+		for _, snapshot := range resp.Snapshots {
+			printSnapshot(writer, snapshot)
+		}
+		return nil
+	})
+}
+
+func printSnapshot(writer io.Writer, snapshot *filer_pb.Snapshot) {
+	fmt.Fprintf(writer, "%s\t%s\t%s\t%d entries\t%d chunks\n", snapshot.Name, snapshot.Directory,
+		time.Unix(0, snapshot.CreatedAtNs).Format(time.RFC3339), snapshot.EntryCount, snapshot.ChunkCount)
+}
@@ -0,0 +1,64 @@
+package shell
+
+import (
+	"context"
+	"flag"
+	"fmt"
+	"io"
+
+	"github.com/gateway-dao/seaweedfs/weed/pb/filer_pb"
+)
+
+func init() {
+	Commands = append(Commands, &commandFsSnapshotRestore{})
+}
+
+type commandFsSnapshotRestore struct {
+}
+
+func (c *commandFsSnapshotRestore) Name() string {
+	return "fs.snapshot.restore"
+}
+
+func (c *commandFsSnapshotRestore) Help() string {
+	return `restore a directory snapshot
+
+	fs.snapshot.restore -name=before_migration                       # restore to the snapshot directory
+	fs.snapshot.restore -name=before_migration -to=/buckets/restored  # restore to another directory
+
+	The files in the snapshot overwrite the existing files. Files created after the snapshot are kept.
+	Only the metadata is copied, so restoring is fast.
+`
+}
+
+func (c *commandFsSnapshotRestore) Do(args []string, commandEnv *CommandEnv, writer io.Writer) (err error) {
+
+	snapshotCommand := flag.NewFlagSet(c.Name(), flag.ContinueOnError)
+	name := snapshotCommand.String("name", "", "the snapshot name")
+	to := snapshotCommand.String("to", "", "the directory to restore to, default to the snapshot directory")
+	if err = snapshotCommand.Parse(args); err != nil {
+		return nil
+	}
+	if *name == "" {
+		return fmt.Errorf("need a snapshot name with -name")
+	}
+
+	var directory string
+	if *to != "" {
+		if directory, err = commandEnv.parseUrl(*to); err != nil {
+			return err
+		}
+	}
+
+	return commandEnv.WithFilerClient(false, func(client filer_pb.SeaweedFilerClient) error {
+		resp, err := client.RestoreSnapshot(context.Background(), &filer_pb.RestoreSnapshotRequest{
+			Name:      *name,
+			Directory: directory,
+		})
+		if err != nil {
+			return err
+		}
+		fmt.Fprintf(writer, "restored %d entries from snapshot %s\n", resp.EntryCount, *name)
+		return nil
+	})
+}
@@ -0,0 +1,92 @@
+package shell
+
+import (
+	"context"
+	"flag"
+	"fmt"
+	"io"
+
+	"github.com/gateway-dao/seaweedfs/weed/filer"
+	"github.com/gateway-dao/seaweedfs/weed/pb/filer_pb"
+	"github.com/gateway-dao/seaweedfs/weed/util"
+)
+
+func init() {
+	Commands = append(Commands, &commandFsTrashRestore{})
+}
+
+type commandFsTrashRestore struct {
+}
+
+func (c *commandFsTrashRestore) Name() string {
+	return "fs.trash.restore"
+}
+
+func (c *commandFsTrashRestore) Help() string {
+	return `restore a deleted file or directory from the trash
+
+	fs.ls /.trash/                                                  # list the deletions
+	fs.trash.restore /.trash/20240102-150405.000000000/my/folder/file.txt   # restore to /my/folder/file.txt
+	fs.trash.restore -to=/my/restored /.trash/20240102-150405.000000000/my/folder
+
+	The trash is enabled for a path prefix by "fs.configure -trash".
+	Deleted files are kept in /.trash/<deletion time>/<original path> with their chunks,
+	and purged by the filers after the retention days.
+	Restoring moves the entry back, and fails if the destination exists.
+`
+}
+
+func (c *commandFsTrashRestore) Do(args []string, commandEnv *CommandEnv, writer io.Writer) (err error) {
+
+	trashCommand := flag.NewFlagSet(c.Name(), flag.ContinueOnError)
+	to := trashCommand.String("to", "", "the path to restore to, default to the original path")
+	if err = trashCommand.Parse(args); err != nil {
+		return nil
+	}
+	if trashCommand.NArg() != 1 {
+		return fmt.Errorf("need a path in %s", filer.TrashDir)
+	}
+
+	sourcePath, err := commandEnv.parseUrl(trashCommand.Arg(0))
+	if err != nil {
+		return err
+	}
+	destinationPath, found := filer.TrashOriginalPath(util.FullPath(sourcePath))
+	if !found {
+		return fmt.Errorf("%s is not a deleted entry in %s", sourcePath, filer.TrashDir)
+	}
+	if *to != "" {
+		path, err := commandEnv.parseUrl(*to)
+		if err != nil {
+			return err
+		}
+		destinationPath = util.FullPath(path)
+	}
+
+	sourceDir, sourceName := util.FullPath(sourcePath).DirAndName()
+	destinationDir, destinationName := destinationPath.DirAndName()
+
+	return commandEnv.WithFilerClient(false, func(client filer_pb.SeaweedFilerClient) error {
+
+		if _, err := filer_pb.LookupEntry(client, &filer_pb.LookupDirectoryEntryRequest{
+			Directory: destinationDir,
+			Name:      destinationName,
+		}); err == nil {
+			return fmt.Errorf("%s already exists", destinationPath)
+		} else if err != filer_pb.ErrNotFound {
+			return fmt.Errorf("lookup %s: %v", destinationPath, err)
+		}
+
+		if _, err := client.AtomicRenameEntry(context.Background(), &filer_pb.AtomicRenameEntryRequest{
+			OldDirectory: sourceDir,
+			OldName:      sourceName,
+			NewDirectory: destinationDir,
+			NewName:      destinationName,
+		}); err != nil {
+			return fmt.Errorf("restore %s: %v", sourcePath, err)
+		}
+
+		fmt.Fprintf(writer, "restore: %s => %s\n", sourcePath, destinationPath)
+		return nil
+	})
+}
@@ -0,0 +1,61 @@
+package shell
+
+import (
+	"context"
+	"flag"
+	"fmt"
+	"io"
+
+	"github.com/gateway-dao/seaweedfs/weed/pb/filer_pb"
+	"github.com/gateway-dao/seaweedfs/weed/util"
+)
+
+func init() {
+	Commands = append(Commands, &commandFsVersionsDelete{})
+}
+
+type commandFsVersionsDelete struct {
+}
+
+func (c *commandFsVersionsDelete) Name() string {
+	return "fs.versions.delete"
+}
+
+func (c *commandFsVersionsDelete) Help() string {
+	return `delete one previous version of a file
+
+	fs.versions.delete -versionId=1700000000000000000 /my/folder/file.txt
+
+	The chunks of the version are deleted unless the file or other versions still use them.
+`
+}
+
+func (c *commandFsVersionsDelete) Do(args []string, commandEnv *CommandEnv, writer io.Writer) (err error) {
+
+	versionsCommand := flag.NewFlagSet(c.Name(), flag.ContinueOnError)
+	versionId := versionsCommand.String("versionId", "", "the version to delete")
+	if err = versionsCommand.Parse(args); err != nil {
+		return nil
+	}
+	if versionsCommand.NArg() != 1 || *versionId == "" {
+		return fmt.Errorf("need a file path and -versionId")
+	}
+
+	path, err := commandEnv.parseUrl(versionsCommand.Arg(0))
+	if err != nil {
+		return err
+	}
+	dir, name := util.FullPath(path).DirAndName()
+
+	return commandEnv.WithFilerClient(false, func(client filer_pb.SeaweedFilerClient) error {
+		if _, err := client.DeleteEntryVersion(context.Background(), &filer_pb.DeleteEntryVersionRequest{
+			Directory: dir,
+			Name:      name,
+			VersionId: *versionId,
+		}); err != nil {
+			return fmt.Errorf("delete %s version %s: %v", path, *versionId, err)
+		}
+		fmt.Fprintf(writer, "deleted %s version %s\n", path, *versionId)
+		return nil
+	})
+}
@@ -0,0 +1,79 @@
+package shell
+
+import (
+	"context"
+	"flag"
+	"fmt"
+	"io"
+	"strconv"
+	"time"
+
+	"github.com/gateway-dao/seaweedfs/weed/filer"
+	"github.com/gateway-dao/seaweedfs/weed/pb/filer_pb"
+	"github.com/gateway-dao/seaweedfs/weed/util"
+)
+
+func init() {
+	Commands = append(Commands, &commandFsVersionsList{})
+}
+
+type commandFsVersionsList struct {
+}
+
+func (c *commandFsVersionsList) Name() string {
+	return "fs.versions.list"
+}
+
+func (c *commandFsVersionsList) Help() string {
+	return `list the previous versions of a file, oldest first
+
+	fs.versions.list /my/folder/file.txt
+
+	The versioning is enabled for a path prefix by "fs.configure -versioning".
+	Overwritten files are kept in /.versions/<path>/<version id> with their chunks.
+`
+}
+
+func (c *commandFsVersionsList) Do(args []string, commandEnv *CommandEnv, writer io.Writer) (err error) {
+
+	versionsCommand := flag.NewFlagSet(c.Name(), flag.ContinueOnError)
+	if err = versionsCommand.Parse(args); err != nil {
+		return nil
+	}
+	if versionsCommand.NArg() != 1 {
+		return fmt.Errorf("need a file path")
+	}
+
+	path, err := commandEnv.parseUrl(versionsCommand.Arg(0))
+	if err != nil {
+		return err
+	}
+	dir, name := util.FullPath(path).DirAndName()
+
+	return commandEnv.WithFilerClient(false, func(client filer_pb.SeaweedFilerClient) error {
+		resp, err := client.ListEntryVersions(context.Background(), &filer_pb.ListEntryVersionsRequest{
+			Directory: dir,
+			Name:      name,
+		})
+		if err != nil {
+			return err
+		}
+		for _, version := range resp.Versions {
+			printEntryVersion(writer, version)
+		}
+		return nil
+	})
+}
+
+func printEntryVersion(writer io.Writer, version *filer_pb.EntryVersion) {
+	replacedAt := version.VersionId
+	if versionNs, err := strconv.ParseInt(version.VersionId, 10, 64); err == nil {
+		replacedAt = time.Unix(0, versionNs).Format(time.RFC3339)
+	}
+	if version.IsDeleteMarker {
+		fmt.Fprintf(writer, "%s\tdeleted %s\n", version.VersionId, replacedAt)
+		return
+	}
+	fmt.Fprintf(writer, "%s\treplaced %s\t%d bytes\t%d chunks\n", version.VersionId, replacedAt,
+		filer.FileSize(version.Entry), len(version.Entry.GetChunks()))
+}
@@ -0,0 +1,62 @@
+package shell
+
+import (
+	"context"
+	"flag"
+	"fmt"
+	"io"
+
+	"github.com/gateway-dao/seaweedfs/weed/pb/filer_pb"
+	"github.com/gateway-dao/seaweedfs/weed/util"
+)
+
+func init() {
+	Commands = append(Commands, &commandFsVersionsRestore{})
+}
+
+type commandFsVersionsRestore struct {
+}
+
+func (c *commandFsVersionsRestore) Name() string {
+	return "fs.versions.restore"
+}
+
+func (c *commandFsVersionsRestore) Help() string {
+	return `restore a file to one of its previous versions
+
+	fs.versions.list /my/folder/file.txt                                  # find the version id
+	fs.versions.restore -versionId=1700000000000000000 /my/folder/file.txt
+
+	The replaced content is kept as a new version.
+`
+}
+
+func (c *commandFsVersionsRestore) Do(args []string, commandEnv *CommandEnv, writer io.Writer) (err error) {
+
+	versionsCommand := flag.NewFlagSet(c.Name(), flag.ContinueOnError)
+	versionId := versionsCommand.String("versionId", "", "the version to restore")
+	if err = versionsCommand.Parse(args); err != nil {
+		return nil
+	}
+	if versionsCommand.NArg() != 1 || *versionId == "" {
+		return fmt.Errorf("need a file path and -versionId")
+	}
+
+	path, err := commandEnv.parseUrl(versionsCommand.Arg(0))
+	if err != nil {
+		return err
+	}
+	dir, name := util.FullPath(path).DirAndName()
+
+	return commandEnv.WithFilerClient(false, func(client filer_pb.SeaweedFilerClient) error {
+		if _, err := client.RestoreEntryVersion(context.Background(), &filer_pb.RestoreEntryVersionRequest{
+			Directory: dir,
+			Name:      name,
+			VersionId: *versionId,
+		}); err != nil {
+			return fmt.Errorf("restore %s version %s: %v", path, *versionId, err)
+		}
+		fmt.Fprintf(writer, "restore: %s => version %s\n", path, *versionId)
+		return nil
+	})
+}
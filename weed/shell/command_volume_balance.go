@@ -9,7 +9,6 @@ import (
 	"time"
 
 	"github.com/gateway-dao/seaweedfs/weed/pb"
-	"github.com/gateway-dao/seaweedfs/weed/storage/erasure_coding"
 	"github.com/gateway-dao/seaweedfs/weed/storage/super_block"
 	"github.com/gateway-dao/seaweedfs/weed/storage/types"
 	"golang.org/x/exp/slices"
@@ -85,7 +84,7 @@ func (c *commandVolumeBalance) Do(args []string, commandEnv *CommandEnv, writer
 		return err
 	}
 
-	volumeServers := collectVolumeServersByDc(topologyInfo, *dc)
+	volumeServers := activeVolumeServers(collectVolumeServersByDc(topologyInfo, *dc))
 	volumeReplicas, _ := collectVolumeReplicaLocations(topologyInfo)
 	diskTypes := collectVolumeDiskTypes(topologyInfo)
 
@@ -138,6 +137,71 @@ func balanceVolumeServersByDiskType(commandEnv *CommandEnv, diskType types.DiskT
 	return nil
 }
 
+// VolumeMove is a volume replica move planned by volume.balance.
+type VolumeMove struct {
+	VolumeId   uint32
+	Collection string
+	DiskType   string
+	Source     string
+	Target     string
+}
+
+// PlanVolumeBalance simulates volume.balance across all collections, and returns the planned moves.
+// The simulation changes the volume counts in the topology info.
+func PlanVolumeBalance(topologyInfo *master_pb.TopologyInfo) (moves []VolumeMove, err error) {
+	nodes := activeVolumeServers(collectVolumeServersByDc(topologyInfo, ""))
+	volumeReplicas, _ := collectVolumeReplicaLocations(topologyInfo)
+	for _, diskType := range collectVolumeDiskTypes(topologyInfo) {
+		if err = balanceVolumeServersByDiskType(nil, diskType, volumeReplicas, nodes, "ALL_COLLECTIONS", false); err != nil {
+			return nil, err
+		}
+		moves = append(moves, collectVolumeMoves(nodes, diskType)...)
+	}
+	return
+}
+
+// collectVolumeMoves compares the volumes selected after the simulation with the volumes on the data nodes.
+func collectVolumeMoves(nodes []*Node, diskType types.DiskType) (moves []VolumeMove) {
+	sources := make(map[uint32][]*Node)
+	targets := make(map[uint32][]*Node)
+	volumes := make(map[uint32]*master_pb.VolumeInformationMessage)
+	for _, n := range nodes {
+		existing := make(map[uint32]bool)
+		for _, diskInfo := range n.info.DiskInfos {
+			for _, v := range diskInfo.VolumeInfos {
+				if v.DiskType != string(diskType) {
+					continue
+				}
+				existing[v.Id] = true
+				if _, found := n.selectedVolumes[v.Id]; !found {
+					sources[v.Id] = append(sources[v.Id], n)
+					volumes[v.Id] = v
+				}
+			}
+		}
+		for vid := range n.selectedVolumes {
+			if !existing[vid] {
+				targets[vid] = append(targets[vid], n)
+			}
+		}
+	}
+	for vid, v := range volumes {
+		for i := 0; i < len(sources[vid]) && i < len(targets[vid]); i++ {
+			moves = append(moves, VolumeMove{
+				VolumeId:   vid,
+				Collection: v.Collection,
+				DiskType:   v.DiskType,
+				Source:     string(pb.NewServerAddressFromDataNode(sources[vid][i].info)),
+				Target:     string(pb.NewServerAddressFromDataNode(targets[vid][i].info)),
+			})
+		}
+	}
+	slices.SortFunc(moves, func(a, b VolumeMove) int {
+		return cmp.Compare(a.VolumeId, b.VolumeId)
+	})
+	return
+}
+
 func collectVolumeServersByDc(t *master_pb.TopologyInfo, selectedDataCenter string) (nodes []*Node) {
 	for _, dc := range t.DataCenterInfos {
 		if selectedDataCenter != "" && dc.Id != selectedDataCenter {
@@ -156,6 +220,16 @@ func collectVolumeServersByDc(t *master_pb.TopologyInfo, selectedDataCenter stri
 	return
 }
 
+// activeVolumeServers skips the draining and maintenance volume servers.
+func activeVolumeServers(nodes []*Node) (activeNodes []*Node) {
+	for _, n := range nodes {
+		if n.info.State == "" {
+			activeNodes = append(activeNodes, n)
+		}
+	}
+	return
+}
+
 func collectVolumeDiskTypes(t *master_pb.TopologyInfo) (diskTypes []types.DiskType) {
 	knownTypes := make(map[string]bool)
 	for _, dc := range t.DataCenterInfos {
@@ -200,11 +274,7 @@ func capacityByFreeVolumeCount(diskType types.DiskType) CapacityFunc {
 		if !found {
 			return 0
 		}
-		var ecShardCount int
-		for _, ecShardInfo := range diskInfo.EcShardInfos {
-			ecShardCount += erasure_coding.ShardBits(ecShardInfo.EcIndexBits).ShardIdCount()
-		}
-		return float64(diskInfo.MaxVolumeCount-diskInfo.VolumeCount) - float64(ecShardCount)/erasure_coding.DataShardsCount
+		return float64(diskInfo.MaxVolumeCount-diskInfo.VolumeCount) - ecShardVolumeSlots(diskInfo.EcShardInfos)
 	}
 }
 
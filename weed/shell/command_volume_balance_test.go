@@ -6,6 +6,7 @@ import (
 	"github.com/stretchr/testify/assert"
 	"testing"
 
+	"github.com/gateway-dao/seaweedfs/weed/pb"
 	"github.com/gateway-dao/seaweedfs/weed/pb/master_pb"
 	"github.com/gateway-dao/seaweedfs/weed/storage/super_block"
 )
@@ -261,6 +262,33 @@ func TestBalance(t *testing.T) {
 
 }
 
+func TestPlanVolumeBalance(t *testing.T) {
+	moves, err := PlanVolumeBalance(parseOutput(topoData))
+	if err != nil {
+		t.Fatalf("plan balance: %v", err)
+	}
+	if len(moves) == 0 {
+		t.Fatalf("no moves planned")
+	}
+
+	hosts := make(map[uint32]map[string]bool)
+	eachDataNode(parseOutput(topoData), func(dc string, rack RackId, dn *master_pb.DataNodeInfo) {
+		for _, diskInfo := range dn.DiskInfos {
+			for _, v := range diskInfo.VolumeInfos {
+				if hosts[v.Id] == nil {
+					hosts[v.Id] = make(map[string]bool)
+				}
+				hosts[v.Id][string(pb.NewServerAddressFromDataNode(dn))] = true
+			}
+		}
+	})
+	for _, move := range moves {
+		if !hosts[move.VolumeId][move.Source] || hosts[move.VolumeId][move.Target] {
+			t.Errorf("volume %d can not move from %s to %s", move.VolumeId, move.Source, move.Target)
+		}
+	}
+}
+
 func TestVolumeSelection(t *testing.T) {
 	topologyInfo := parseOutput(topoData)
 
@@ -0,0 +1,81 @@
+package shell
+
+import (
+	"context"
+	"flag"
+	"fmt"
+	"io"
+
+	"github.com/gateway-dao/seaweedfs/weed/operation"
+	"github.com/gateway-dao/seaweedfs/weed/pb"
+	"github.com/gateway-dao/seaweedfs/weed/pb/master_pb"
+	"github.com/gateway-dao/seaweedfs/weed/pb/volume_server_pb"
+)
+
+func init() {
+	Commands = append(Commands, &commandVolumeEncryptionRotate{})
+}
+
+type commandVolumeEncryptionRotate struct {
+}
+
+func (c *commandVolumeEncryptionRotate) Name() string {
+	return "volume.encryption.rotate"
+}
+
+func (c *commandVolumeEncryptionRotate) Help() string {
+	return `re-wrap the data keys of encrypted volumes with the current master key
+
+	volume.encryption.rotate [-node <volume server host:port>]
+
+	Each volume server reloads its keyring, given by its -encryption.keyring option,
+	and re-wraps the data keys of all its volumes and ec volumes that are not
+	wrapped by the primary key yet. The needle data is not rewritten.
+
+	To rotate the master key:
+	1. add a new key to the keyring file of all volume servers, and make it the primary key.
+	2. run this command.
+	3. remove the old key from the keyring files.
+
+`
+}
+
+func (c *commandVolumeEncryptionRotate) Do(args []string, commandEnv *CommandEnv, writer io.Writer) (err error) {
+
+	rotateCommand := flag.NewFlagSet(c.Name(), flag.ContinueOnError)
+	volumeServer := rotateCommand.String("node", "", "<host>:<port> of the volume server, default to all volume servers")
+	if err = rotateCommand.Parse(args); err != nil {
+		return nil
+	}
+
+	var volumeServers []pb.ServerAddress
+	if *volumeServer != "" {
+		volumeServers = append(volumeServers, pb.ServerAddress(*volumeServer))
+	} else {
+		topologyInfo, _, err := collectTopologyInfo(commandEnv, 0)
+		if err != nil {
+			return err
+		}
+		eachDataNode(topologyInfo, func(dc string, rack RackId, dn *master_pb.DataNodeInfo) {
+			volumeServers = append(volumeServers, pb.NewServerAddressFromDataNode(dn))
+		})
+	}
+
+	for _, server := range volumeServers {
+		rotateErr := operation.WithVolumeServerClient(false, server, commandEnv.option.GrpcDialOption, func(volumeServerClient volume_server_pb.VolumeServerClient) error {
+			resp, rotateErr := volumeServerClient.VolumeRotateEncryptionKeys(context.Background(), &volume_server_pb.VolumeRotateEncryptionKeysRequest{})
+			if rotateErr != nil {
+				return rotateErr
+			}
+			fmt.Fprintf(writer, "%s: re-wrapped %d volumes and %d ec volumes with master key %s\n",
+				server, resp.RotatedVolumeCount, resp.RotatedEcVolumeCount, resp.KeyId)
+			return nil
+		})
+		if rotateErr != nil {
+			fmt.Fprintf(writer, "%s: %v\n", server, rotateErr)
+			err = rotateErr
+		}
+	}
+
+	return err
+}
@@ -45,6 +45,7 @@ func (c *commandVolumeFixReplication) Help() string {
 	volume.fix.replication -n                             # do not take action
 	volume.fix.replication                                # actually deleting or copying the volume files and mount the volume
 	volume.fix.replication -collectionPattern=important*  # fix any collections with prefix "important"
+	volume.fix.replication -volumeId=7                    # only fix volume 7
 
 	Note:
 		* each time this will only add back one replica for each volume id that is under replicated.
@@ -64,6 +65,7 @@ func (c *commandVolumeFixReplication) Do(args []string, commandEnv *CommandEnv,
 	doCheck := volFixReplicationCommand.Bool("doCheck", true, "Also check synchronization before deleting")
 	retryCount := volFixReplicationCommand.Int("retry", 5, "how many times to retry")
 	volumesPerStep := volFixReplicationCommand.Int("volumesPerStep", 0, "how many volumes to fix in one cycle")
+	volumeId := volFixReplicationCommand.Uint("volumeId", 0, "only fix this volume")
 
 	if err = volFixReplicationCommand.Parse(args); err != nil {
 		return nil
@@ -94,21 +96,7 @@ func (c *commandVolumeFixReplication) Do(args []string, commandEnv *CommandEnv,
 		}
 
 		// find all under replicated volumes
-		var underReplicatedVolumeIds, overReplicatedVolumeIds, misplacedVolumeIds []uint32
-		for vid, replicas := range volumeReplicas {
-			replica := replicas[0]
-			replicaPlacement, _ := super_block.NewReplicaPlacementFromByte(byte(replica.info.ReplicaPlacement))
-			switch {
-			case replicaPlacement.GetCopyCount() > len(replicas):
-				underReplicatedVolumeIds = append(underReplicatedVolumeIds, vid)
-			case isMisplaced(replicas, replicaPlacement):
-				misplacedVolumeIds = append(misplacedVolumeIds, vid)
-				fmt.Fprintf(writer, "volume %d replication %s is not well placed %s\n", replica.info.Id, replicaPlacement, replica.location.dataNode.Id)
-			case replicaPlacement.GetCopyCount() < len(replicas):
-				overReplicatedVolumeIds = append(overReplicatedVolumeIds, vid)
-				fmt.Fprintf(writer, "volume %d replication %s, but over replicated %+d\n", replica.info.Id, replicaPlacement, len(replicas))
-			}
-		}
+		underReplicatedVolumeIds, overReplicatedVolumeIds, misplacedVolumeIds := classifyVolumeReplicas(volumeReplicas, uint32(*volumeId), writer)
 
 		if !commandEnv.isLocked() {
 			return fmt.Errorf("lock is lost")
@@ -172,6 +160,39 @@ func (c *commandVolumeFixReplication) Do(args []string, commandEnv *CommandEnv,
 	return nil
 }
 
+// classifyVolumeReplicas finds the volumes with too few, misplaced, or too many replicas.
+// A non zero volumeId only checks that volume.
+func classifyVolumeReplicas(volumeReplicas map[uint32][]*VolumeReplica, volumeId uint32, writer io.Writer) (underReplicatedVolumeIds, overReplicatedVolumeIds, misplacedVolumeIds []uint32) {
+	for vid, replicas := range volumeReplicas {
+		if volumeId != 0 && vid != volumeId {
+			continue
+		}
+		replica := replicas[0]
+		replicaPlacement, _ := super_block.NewReplicaPlacementFromByte(byte(replica.info.ReplicaPlacement))
+		switch {
+		case replicaPlacement.GetCopyCount() > len(replicas):
+			underReplicatedVolumeIds = append(underReplicatedVolumeIds, vid)
+		case isMisplaced(replicas, replicaPlacement):
+			misplacedVolumeIds = append(misplacedVolumeIds, vid)
+			fmt.Fprintf(writer, "volume %d replication %s is not well placed %s\n", replica.info.Id, replicaPlacement, replica.location.dataNode.Id)
+		case replicaPlacement.GetCopyCount() < len(replicas):
+			overReplicatedVolumeIds = append(overReplicatedVolumeIds, vid)
+			fmt.Fprintf(writer, "volume %d replication %s, but over replicated %+d\n", replica.info.Id, replicaPlacement, len(replicas))
+		}
+	}
+	return
+}
+
+// PlanVolumeFixReplication finds the volumes volume.fix.replication would fix, without changing anything.
+func PlanVolumeFixReplication(topologyInfo *master_pb.TopologyInfo) (underReplicatedVolumeIds, overReplicatedVolumeIds, misplacedVolumeIds []uint32) {
+	volumeReplicas, _ := collectVolumeReplicaLocations(topologyInfo)
+	underReplicatedVolumeIds, overReplicatedVolumeIds, misplacedVolumeIds = classifyVolumeReplicas(volumeReplicas, 0, io.Discard)
+	for _, vids := range [][]uint32{underReplicatedVolumeIds, overReplicatedVolumeIds, misplacedVolumeIds} {
+		slices.Sort(vids)
+	}
+	return
+}
+
 func collectVolumeReplicaLocations(topologyInfo *master_pb.TopologyInfo) (map[uint32][]*VolumeReplica, []location) {
 	volumeReplicas := make(map[uint32][]*VolumeReplica)
 	var allLocations []location
@@ -298,7 +319,7 @@ func (c *commandVolumeFixReplication) fixOneUnderReplicatedVolume(commandEnv *Co
 	fn := capacityByFreeVolumeCount(types.ToDiskType(replica.info.DiskType))
 	for _, dst := range allLocations {
 		// check whether data nodes satisfy the constraints
-		if fn(dst.dataNode) > 0 && satisfyReplicaPlacement(replicaPlacement, replicas, dst) {
+		if fn(dst.dataNode) > 0 && dst.dataNode.State == "" && satisfyReplicaPlacement(replicaPlacement, replicas, dst) {
 			// check collection name pattern
 			if *c.collectionPattern != "" {
 				matched, err := filepath.Match(*c.collectionPattern, replica.info.Collection)
@@ -13,6 +13,7 @@ import (
 	"github.com/gateway-dao/seaweedfs/weed/operation"
 	"github.com/gateway-dao/seaweedfs/weed/pb/volume_server_pb"
 	"github.com/gateway-dao/seaweedfs/weed/storage/needle"
+	"github.com/gateway-dao/seaweedfs/weed/util"
 	"google.golang.org/grpc"
 )
 
@@ -141,33 +142,38 @@ func copyVolume(grpcDialOption grpc.DialOption, writer io.Writer, volumeId needl
 		return
 	}
 
-	err = operation.WithVolumeServerClient(true, targetVolumeServer, grpcDialOption, func(volumeServerClient volume_server_pb.VolumeServerClient) error {
-		stream, replicateErr := volumeServerClient.VolumeCopy(context.Background(), &volume_server_pb.VolumeCopyRequest{
-			VolumeId:        uint32(volumeId),
-			SourceDataNode:  string(sourceVolumeServer),
-			DiskType:        diskType,
-			IoBytePerSecond: ioBytePerSecond,
-		})
-		if replicateErr != nil {
-			return replicateErr
-		}
-		for {
-			resp, recvErr := stream.Recv()
-			if recvErr != nil {
-				if recvErr == io.EOF {
-					break
+	// an interrupted copy resumes from where the target volume server left off
+	err = util.Retry(fmt.Sprintf("copy volume %d from %s to %s", volumeId, sourceVolumeServer, targetVolumeServer), func() error {
+		return operation.WithVolumeServerClient(true, targetVolumeServer, grpcDialOption, func(volumeServerClient volume_server_pb.VolumeServerClient) error {
+			stream, replicateErr := volumeServerClient.VolumeCopy(context.Background(), &volume_server_pb.VolumeCopyRequest{
+				VolumeId:        uint32(volumeId),
+				SourceDataNode:  string(sourceVolumeServer),
+				DiskType:        diskType,
+				IoBytePerSecond: ioBytePerSecond,
+			})
+			if replicateErr != nil {
+				return replicateErr
+			}
+			for {
+				resp, recvErr := stream.Recv()
+				if recvErr != nil {
+					if recvErr == io.EOF {
+						break
+					} else {
+						return recvErr
+					}
+				}
+				if resp.LastAppendAtNs != 0 {
+					lastAppendAtNs = resp.LastAppendAtNs
+				} else if resp.ResumedBytes > 0 && resp.ProcessedBytes == resp.ResumedBytes {
+					fmt.Fprintf(writer, "volume %d resumed copying at %d of %d bytes\n", volumeId, resp.ResumedBytes, resp.TotalBytes)
 				} else {
-					return recvErr
+					fmt.Fprintf(writer, "volume %d processed %d of %d bytes\n", volumeId, resp.ProcessedBytes, resp.TotalBytes)
 				}
 			}
-			if resp.LastAppendAtNs != 0 {
-				lastAppendAtNs = resp.LastAppendAtNs
-			} else {
-				fmt.Fprintf(writer, "volume %d processed %d bytes\n", volumeId, resp.ProcessedBytes)
-			}
-		}
 
-		return nil
+			return nil
+		})
 	})
 
 	return
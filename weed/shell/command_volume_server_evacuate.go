@@ -149,7 +149,7 @@ func (c *commandVolumeServerEvacuate) evacuateNormalVolumes(commandEnv *CommandE
 
 func (c *commandVolumeServerEvacuate) evacuateEcVolumes(commandEnv *CommandEnv, volumeServer string, skipNonMoveable, applyChange bool, writer io.Writer) error {
 	// find this ec volume server
-	ecNodes, _ := collectEcVolumeServersByDc(c.topologyInfo, "")
+	ecNodes, _ := collectEcVolumeServersByDc(c.topologyInfo, "", erasure_coding.DefaultEcScheme)
 	thisNodes, otherNodes := c.ecNodesOtherThan(ecNodes, volumeServer)
 	if len(thisNodes) == 0 {
 		return fmt.Errorf("%s is not found in this cluster\n", volumeServer)
@@ -245,6 +245,10 @@ func (c *commandVolumeServerEvacuate) nodesOtherThan(volumeServers []*Node, this
 		if *c.targetServer != "" && *c.targetServer != node.info.Id {
 			continue
 		}
+		if node.info.State != "" {
+			// draining or maintenance
+			continue
+		}
 		otherNodes = append(otherNodes, node)
 	}
 	return
@@ -262,6 +266,10 @@ func (c *commandVolumeServerEvacuate) ecNodesOtherThan(volumeServers []*EcNode,
 		if *c.targetServer != "" && *c.targetServer != node.info.Id {
 			continue
 		}
+		if node.info.State != "" {
+			// draining or maintenance
+			continue
+		}
 		otherNodes = append(otherNodes, node)
 	}
 	return
@@ -0,0 +1,85 @@
+package shell
+
+import (
+	"context"
+	"flag"
+	"fmt"
+	"io"
+
+	"github.com/gateway-dao/seaweedfs/weed/pb/master_pb"
+)
+
+func init() {
+	Commands = append(Commands, &commandVolumeServerState{})
+}
+
+type commandVolumeServerState struct {
+}
+
+func (c *commandVolumeServerState) Name() string {
+	return "volumeServer.state"
+}
+
+func (c *commandVolumeServerState) Help() string {
+	return `show or change the state of volume servers
+
+	volumeServer.state                                         # list the volume servers not active
+	volumeServer.state -node <host:port> -state draining       # move all data away, and stop writes and new volumes
+	volumeServer.state -node <host:port> -state maintenance    # stop writes and new volumes, and keep the data
+	volumeServer.state -node <host:port> -state active         # return the volume server to service
+
+	The state is kept by the masters. The leader master evacuates draining volume servers
+	in the background, like "volumeServer.evacuate -skipNonMoveable -force".
+
+`
+}
+
+func (c *commandVolumeServerState) Do(args []string, commandEnv *CommandEnv, writer io.Writer) (err error) {
+
+	stateCommand := flag.NewFlagSet(c.Name(), flag.ContinueOnError)
+	node := stateCommand.String("node", "", "<host>:<port> of the volume server")
+	state := stateCommand.String("state", "", "active, draining or maintenance")
+	if err = stateCommand.Parse(args); err != nil {
+		return nil
+	}
+
+	return commandEnv.MasterClient.WithClient(false, func(client master_pb.SeaweedClient) error {
+		if *node != "" && *state != "" {
+			resp, err := client.SetVolumeServerState(context.Background(), &master_pb.SetVolumeServerStateRequest{
+				Node:  *node,
+				State: *state,
+			})
+			if err != nil {
+				return fmt.Errorf("set volume server state: %v", err)
+			}
+			printVolumeServerState(writer, resp.State)
+			return nil
+		}
+		if *node != "" || *state != "" {
+			return fmt.Errorf("need both -node and -state to change the state")
+		}
+
+		resp, err := client.ListVolumeServerStates(context.Background(), &master_pb.ListVolumeServerStatesRequest{})
+		if err != nil {
+			return fmt.Errorf("list volume server states: %v", err)
+		}
+		if len(resp.States) == 0 {
+			fmt.Fprintf(writer, "all volume servers are active\n")
+		}
+		for _, s := range resp.States {
+			printVolumeServerState(writer, s)
+		}
+		return nil
+	})
+}
+
+func printVolumeServerState(writer io.Writer, s *master_pb.VolumeServerState) {
+	fmt.Fprintf(writer, "%s %s", s.Node, s.State)
+	if s.Evacuation != "" {
+		fmt.Fprintf(writer, ", evacuation %s, %d of %d volumes and ec shards left", s.Evacuation, s.RemainingCount, s.InitialCount)
+	}
+	if s.Message != "" {
+		fmt.Fprintf(writer, ": %s", s.Message)
+	}
+	fmt.Fprintln(writer)
+}
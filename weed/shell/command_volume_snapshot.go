@@ -0,0 +1,272 @@
+package shell
+
+import (
+	"context"
+	"flag"
+	"fmt"
+	"io"
+	"sort"
+	"time"
+
+	"github.com/gateway-dao/seaweedfs/weed/operation"
+	"github.com/gateway-dao/seaweedfs/weed/pb"
+	"github.com/gateway-dao/seaweedfs/weed/pb/master_pb"
+	"github.com/gateway-dao/seaweedfs/weed/pb/volume_server_pb"
+	"github.com/gateway-dao/seaweedfs/weed/storage/needle"
+)
+
+func init() {
+	Commands = append(Commands, &commandVolumeSnapshot{})
+}
+
+type commandVolumeSnapshot struct {
+}
+
+func (c *commandVolumeSnapshot) Name() string {
+	return "volume.snapshot"
+}
+
+func (c *commandVolumeSnapshot) Help() string {
+	return `take, list, restore or delete point in time snapshots of volumes
+
+	volume.snapshot -name <name> [-volumeId <volume id> | -collection <collection>] [-hardLink]
+	volume.snapshot -list [-name <name>] [-volumeId <volume id> | -collection <collection>]
+	volume.snapshot -restore -name <name> -volumeId <volume id> -newVolumeId <new volume id>
+	volume.snapshot -delete -name <name> [-volumeId <volume id> | -collection <collection>]
+
+	A snapshot is taken on one replica of each volume, while writes continue, and is kept
+	in the snapshots/<name> directory next to the volume files. It is a self-contained
+	copy of the volume files, or with -hardLink, shares the .dat file with the volume if
+	the file system allows it. Volumes with hard linked snapshots are not vacuumed in place.
+
+	All volumes of a collection are cut at the same point in time, as given by the clocks
+	of the volume servers.
+
+	-restore copies the snapshot into a new volume on the volume server holding the
+	snapshot, and mounts it. The new volume id must not be used yet.
+
+`
+}
+
+func (c *commandVolumeSnapshot) Do(args []string, commandEnv *CommandEnv, writer io.Writer) (err error) {
+
+	snapshotCommand := flag.NewFlagSet(c.Name(), flag.ContinueOnError)
+	name := snapshotCommand.String("name", "", "the snapshot name")
+	volumeId := snapshotCommand.Int("volumeId", 0, "the volume id")
+	collection := snapshotCommand.String("collection", "", "the collection name")
+	hardLink := snapshotCommand.Bool("hardLink", false, "hard link the .dat files instead of copying them")
+	list := snapshotCommand.Bool("list", false, "list the snapshots")
+	restore := snapshotCommand.Bool("restore", false, "restore the snapshot of a volume as a new volume")
+	newVolumeId := snapshotCommand.Int("newVolumeId", 0, "the volume id to restore the snapshot as")
+	deleteSnapshot := snapshotCommand.Bool("delete", false, "delete the snapshots")
+	if err = snapshotCommand.Parse(args); err != nil {
+		return nil
+	}
+
+	if *list {
+		return c.listSnapshots(commandEnv, *name, needle.VolumeId(*volumeId), *collection, writer)
+	}
+	if *name == "" {
+		return fmt.Errorf("missing snapshot name")
+	}
+	if err = commandEnv.confirmIsLocked(args); err != nil {
+		return
+	}
+
+	switch {
+	case *restore:
+		if *volumeId == 0 || *newVolumeId == 0 {
+			return fmt.Errorf("-restore needs -volumeId and -newVolumeId")
+		}
+		return c.restoreSnapshot(commandEnv, *name, needle.VolumeId(*volumeId), needle.VolumeId(*newVolumeId), writer)
+	case *deleteSnapshot:
+		return c.deleteSnapshots(commandEnv, *name, needle.VolumeId(*volumeId), *collection, writer)
+	}
+
+	if *volumeId == 0 && *collection == "" {
+		return fmt.Errorf("missing -volumeId or -collection")
+	}
+	return c.takeSnapshots(commandEnv, *name, needle.VolumeId(*volumeId), *collection, *hardLink, writer)
+}
+
+func (c *commandVolumeSnapshot) takeSnapshots(commandEnv *CommandEnv, name string, volumeId needle.VolumeId, collection string, hardLink bool, writer io.Writer) error {
+
+	topologyInfo, _, err := collectTopologyInfo(commandEnv, 0)
+	if err != nil {
+		return err
+	}
+
+	// one replica of each volume
+	locations := make(map[needle.VolumeId]pb.ServerAddress)
+	eachDataNode(topologyInfo, func(dc string, rack RackId, dn *master_pb.DataNodeInfo) {
+		for _, diskInfo := range dn.DiskInfos {
+			for _, vi := range diskInfo.VolumeInfos {
+				vid := needle.VolumeId(vi.Id)
+				if volumeId != 0 && vid != volumeId || volumeId == 0 && vi.Collection != collection {
+					continue
+				}
+				if _, found := locations[vid]; !found {
+					locations[vid] = pb.NewServerAddressFromDataNode(dn)
+				}
+			}
+		}
+	})
+	if len(locations) == 0 {
+		return fmt.Errorf("no volumes found")
+	}
+	var vids []needle.VolumeId
+	for vid := range locations {
+		vids = append(vids, vid)
+	}
+	sort.Slice(vids, func(i, j int) bool { return vids[i] < vids[j] })
+
+	var asOfNs uint64
+	if volumeId == 0 {
+		asOfNs = uint64(time.Now().UnixNano())
+	}
+	for _, vid := range vids {
+		err = operation.WithVolumeServerClient(false, locations[vid], commandEnv.option.GrpcDialOption, func(volumeServerClient volume_server_pb.VolumeServerClient) error {
+			resp, snapshotErr := volumeServerClient.VolumeSnapshot(context.Background(), &volume_server_pb.VolumeSnapshotRequest{
+				VolumeId: uint32(vid),
+				Name:     name,
+				HardLink: hardLink,
+				AsOfNs:   asOfNs,
+			})
+			if snapshotErr != nil {
+				return snapshotErr
+			}
+			printVolumeSnapshot(writer, locations[vid], resp.Snapshot)
+			return nil
+		})
+		if err != nil {
+			return fmt.Errorf("snapshot volume %d on %s: %v", vid, locations[vid], err)
+		}
+	}
+	return nil
+}
+
+type volumeSnapshotLocation struct {
+	server   pb.ServerAddress
+	snapshot *volume_server_pb.VolumeSnapshot
+}
+
+func collectVolumeSnapshots(commandEnv *CommandEnv, name string, volumeId needle.VolumeId, collection string) (snapshots []volumeSnapshotLocation, err error) {
+
+	topologyInfo, _, err := collectTopologyInfo(commandEnv, 0)
+	if err != nil {
+		return nil, err
+	}
+	var volumeIds []uint32
+	if volumeId != 0 {
+		volumeIds = append(volumeIds, uint32(volumeId))
+	}
+
+	var servers []pb.ServerAddress
+	eachDataNode(topologyInfo, func(dc string, rack RackId, dn *master_pb.DataNodeInfo) {
+		servers = append(servers, pb.NewServerAddressFromDataNode(dn))
+	})
+	for _, server := range servers {
+		err = operation.WithVolumeServerClient(false, server, commandEnv.option.GrpcDialOption, func(volumeServerClient volume_server_pb.VolumeServerClient) error {
+			resp, listErr := volumeServerClient.VolumeSnapshotList(context.Background(), &volume_server_pb.VolumeSnapshotListRequest{
+				VolumeIds: volumeIds,
+				Name:      name,
+			})
+			if listErr != nil {
+				return listErr
+			}
+			for _, snapshot := range resp.Snapshots {
+				if collection != "" && snapshot.Collection != collection {
+					continue
+				}
+				snapshots = append(snapshots, volumeSnapshotLocation{server: server, snapshot: snapshot})
+			}
+			return nil
+		})
+		if err != nil {
+			return nil, fmt.Errorf("list snapshots on %s: %v", server, err)
+		}
+	}
+	return snapshots, nil
+}
+
+func (c *commandVolumeSnapshot) listSnapshots(commandEnv *CommandEnv, name string, volumeId needle.VolumeId, collection string, writer io.Writer) error {
+	snapshots, err := collectVolumeSnapshots(commandEnv, name, volumeId, collection)
+	if err != nil {
+		return err
+	}
+	sort.Slice(snapshots, func(i, j int) bool {
+		if snapshots[i].snapshot.Name != snapshots[j].snapshot.Name {
+			return snapshots[i].snapshot.Name < snapshots[j].snapshot.Name
+		}
+		return snapshots[i].snapshot.VolumeId < snapshots[j].snapshot.VolumeId
+	})
+	for _, s := range snapshots {
+		printVolumeSnapshot(writer, s.server, s.snapshot)
+	}
+	return nil
+}
+
+func (c *commandVolumeSnapshot) restoreSnapshot(commandEnv *CommandEnv, name string, volumeId needle.VolumeId, newVolumeId needle.VolumeId, writer io.Writer) error {
+
+	topologyInfo, _, err := collectTopologyInfo(commandEnv, 0)
+	if err != nil {
+		return err
+	}
+	if volumeReplicas, _ := collectVolumeReplicaLocations(topologyInfo); len(volumeReplicas[uint32(newVolumeId)]) > 0 {
+		return fmt.Errorf("volume %d already exists", newVolumeId)
+	}
+
+	snapshots, err := collectVolumeSnapshots(commandEnv, name, volumeId, "")
+	if err != nil {
+		return err
+	}
+	if len(snapshots) == 0 {
+		return fmt.Errorf("snapshot %s of volume %d not found", name, volumeId)
+	}
+	s := snapshots[0]
+
+	err = operation.WithVolumeServerClient(false, s.server, commandEnv.option.GrpcDialOption, func(volumeServerClient volume_server_pb.VolumeServerClient) error {
+		_, restoreErr := volumeServerClient.VolumeSnapshotRestore(context.Background(), &volume_server_pb.VolumeSnapshotRestoreRequest{
+			VolumeId:    uint32(volumeId),
+			Name:        name,
+			NewVolumeId: uint32(newVolumeId),
+		})
+		return restoreErr
+	})
+	if err != nil {
+		return fmt.Errorf("restore snapshot %s of volume %d on %s: %v", name, volumeId, s.server, err)
+	}
+	fmt.Fprintf(writer, "restored snapshot %s of volume %d as volume %d on %s\n", name, volumeId, newVolumeId, s.server)
+	return nil
+}
+
+func (c *commandVolumeSnapshot) deleteSnapshots(commandEnv *CommandEnv, name string, volumeId needle.VolumeId, collection string, writer io.Writer) error {
+	snapshots, err := collectVolumeSnapshots(commandEnv, name, volumeId, collection)
+	if err != nil {
+		return err
+	}
+	for _, s := range snapshots {
+		err = operation.WithVolumeServerClient(false, s.server, commandEnv.option.GrpcDialOption, func(volumeServerClient volume_server_pb.VolumeServerClient) error {
+			_, deleteErr := volumeServerClient.VolumeSnapshotDelete(context.Background(), &volume_server_pb.VolumeSnapshotDeleteRequest{
+				VolumeId: s.snapshot.VolumeId,
+				Name:     s.snapshot.Name,
+			})
+			return deleteErr
+		})
+		if err != nil {
+			return fmt.Errorf("delete snapshot %s of volume %d on %s: %v", s.snapshot.Name, s.snapshot.VolumeId, s.server, err)
+		}
+		fmt.Fprintf(writer, "deleted snapshot %s of volume %d on %s\n", s.snapshot.Name, s.snapshot.VolumeId, s.server)
+	}
+	return nil
+}
+
+func printVolumeSnapshot(writer io.Writer, server pb.ServerAddress, snapshot *volume_server_pb.VolumeSnapshot) {
+	linked := ""
+	if snapshot.IsHardLinked {
+		linked = " hard linked"
+	}
+	fmt.Fprintf(writer, "snapshot %s volume %d collection %q on %s: %d bytes, %d index bytes, taken %v%s\n",
+		snapshot.Name, snapshot.VolumeId, snapshot.Collection, server, snapshot.DatSize, snapshot.IdxSize,
+		time.Unix(0, snapshot.CreatedAtNs).UTC().Format(time.RFC3339), linked)
+}
@@ -32,7 +32,6 @@ type volumeTierMoveJob struct {
 
 type commandVolumeTierMove struct {
 	activeServers sync.Map
-	queues        map[pb.ServerAddress]chan volumeTierMoveJob
 	//activeServers     map[pb.ServerAddress]struct{}
 	//activeServersLock sync.Mutex
 	//activeServersCond *sync.Cond
@@ -46,6 +45,7 @@ func (c *commandVolumeTierMove) Help() string {
 	return `change a volume from one disk type to another
 
 	volume.tier.move -fromDiskType=hdd -toDiskType=ssd [-collectionPattern=""] [-fullPercent=95] [-quietFor=1h] [-parallelLimit=4] [-toReplication=XYZ]
+	volume.tier.move -fromDiskType=hdd -toDiskType=ssd -volumeId=<volume_id>
 
 	Even if the volume is replicated, only one replica will be changed and the rest replicas will be dropped.
 	So "volume.fix.replication" and "volume.balance" should be followed.
@@ -56,6 +56,7 @@ func (c *commandVolumeTierMove) Help() string {
 func (c *commandVolumeTierMove) Do(args []string, commandEnv *CommandEnv, writer io.Writer) (err error) {
 
 	tierCommand := flag.NewFlagSet(c.Name(), flag.ContinueOnError)
+	volumeId := tierCommand.Int("volumeId", 0, "only move this volume, regardless of the other selection options")
 	collectionPattern := tierCommand.String("collectionPattern", "", "match with wildcard characters '*' and '?'")
 	fullPercentage := tierCommand.Float64("fullPercent", 95, "the volume reaches the percentage of max volume size")
 	quietPeriod := tierCommand.Duration("quietFor", 24*time.Hour, "select volumes without no writes for this period")
@@ -89,8 +90,10 @@ func (c *commandVolumeTierMove) Do(args []string, commandEnv *CommandEnv, writer
 	}
 
 	// collect all volumes that should change
-	volumeIds, err := collectVolumeIdsForTierChange(topologyInfo, volumeSizeLimitMb, fromDiskType, *collectionPattern, *fullPercentage, *quietPeriod)
-	if err != nil {
+	var volumeIds []needle.VolumeId
+	if *volumeId != 0 {
+		volumeIds = append(volumeIds, needle.VolumeId(*volumeId))
+	} else if volumeIds, err = collectVolumeIdsForTierChange(topologyInfo, volumeSizeLimitMb, fromDiskType, *collectionPattern, *fullPercentage, *quietPeriod); err != nil {
 		return err
 	}
 	fmt.Printf("tier move volumes: %v\n", volumeIds)
@@ -105,11 +108,15 @@ func (c *commandVolumeTierMove) Do(args []string, commandEnv *CommandEnv, writer
 
 	wg := sync.WaitGroup{}
 	bufferLen := len(allLocations)
-	c.queues = make(map[pb.ServerAddress]chan volumeTierMoveJob)
+	queues := make(map[pb.ServerAddress]chan volumeTierMoveJob)
+
+	// the error of moving the only volume is returned
+	var moveErr error
+	var moveErrLock sync.Mutex
 
 	for _, dst := range allLocations {
 		destServerAddress := pb.NewServerAddressFromDataNode(dst.dataNode)
-		c.queues[destServerAddress] = make(chan volumeTierMoveJob, bufferLen)
+		queues[destServerAddress] = make(chan volumeTierMoveJob, bufferLen)
 
 		wg.Add(1)
 		go func(dst location, jobs <-chan volumeTierMoveJob, applyChanges bool) {
@@ -128,25 +135,34 @@ func (c *commandVolumeTierMove) Do(args []string, commandEnv *CommandEnv, writer
 				if applyChanges {
 					if err := c.doMoveOneVolume(commandEnv, writer, job.vid, toDiskType, locations, job.src, dst, *ioBytePerSecond, replicationString); err != nil {
 						fmt.Fprintf(writer, "move volume %d %s => %s: %v\n", job.vid, job.src, dst.dataNode.Id, err)
+						moveErrLock.Lock()
+						moveErr = err
+						moveErrLock.Unlock()
 					}
 				}
 				unlock()
 			}
-		}(dst, c.queues[destServerAddress], *applyChange)
+		}(dst, queues[destServerAddress], *applyChange)
 	}
 
 	for _, vid := range volumeIds {
-		if err = c.doVolumeTierMove(commandEnv, writer, vid, toDiskType, allLocations); err != nil {
+		if err = c.doVolumeTierMove(commandEnv, writer, vid, toDiskType, allLocations, queues); err != nil {
 			fmt.Printf("tier move volume %d: %v\n", vid, err)
+			moveErrLock.Lock()
+			moveErr = err
+			moveErrLock.Unlock()
 		}
 		allLocations = rotateDataNodes(allLocations)
 	}
-	for key, _ := range c.queues {
-		close(c.queues[key])
+	for key, _ := range queues {
+		close(queues[key])
 	}
 
 	wg.Wait()
 
+	if *volumeId != 0 {
+		return moveErr
+	}
 	return nil
 }
 
@@ -185,7 +201,7 @@ func isOneOf(server string, locations []wdclient.Location) bool {
 	return false
 }
 
-func (c *commandVolumeTierMove) doVolumeTierMove(commandEnv *CommandEnv, writer io.Writer, vid needle.VolumeId, toDiskType types.DiskType, allLocations []location) (err error) {
+func (c *commandVolumeTierMove) doVolumeTierMove(commandEnv *CommandEnv, writer io.Writer, vid needle.VolumeId, toDiskType types.DiskType, allLocations []location, queues map[pb.ServerAddress]chan volumeTierMoveJob) (err error) {
 	// find volume location
 	locations, found := commandEnv.MasterClient.GetLocationsClone(uint32(vid))
 	if !found {
@@ -216,12 +232,13 @@ func (c *commandVolumeTierMove) doVolumeTierMove(commandEnv *CommandEnv, writer
 			addVolumeCount(dst.dataNode.DiskInfos[string(toDiskType)], 1)
 
 			destServerAddress := pb.NewServerAddressFromDataNode(dst.dataNode)
-			c.queues[destServerAddress] <- volumeTierMoveJob{sourceVolumeServer, vid}
+			queues[destServerAddress] <- volumeTierMoveJob{sourceVolumeServer, vid}
 		}
 	}
 
 	if !hasFoundTarget {
 		fmt.Fprintf(writer, "can not find disk type %s for volume %d\n", toDiskType.ReadableString(), vid)
+		return fmt.Errorf("no target server with disk type %s", toDiskType.ReadableString())
 	}
 
 	return nil
@@ -241,6 +241,78 @@ var (
 		Help:      "Volume Server Checksum Duration",
 	})
 
+	VolumeServerScrubNeedleCounter = prometheus.NewCounterVec(
+		prometheus.CounterOpts{
+			Namespace: Namespace,
+			Subsystem: "volumeServer",
+			Name:      "scrub_needles_total",
+			Help:      "Counter of needles scanned, found corrupted and repaired by the scrubber.",
+		}, []string{"collection", "type"})
+
+	VolumeServerScrubBytesCounter = prometheus.NewCounterVec(
+		prometheus.CounterOpts{
+			Namespace: Namespace,
+			Subsystem: "volumeServer",
+			Name:      "scrub_bytes_total",
+			Help:      "Counter of bytes verified by the scrubber.",
+		}, []string{"collection"})
+
+	VolumeServerReadRepairCounter = prometheus.NewCounterVec(
+		prometheus.CounterOpts{
+			Namespace: Namespace,
+			Subsystem: "volumeServer",
+			Name:      "read_repair_total",
+			Help:      "Counter of needles repaired from other replicas when a read fails.",
+		}, []string{"collection", "type"})
+
+	VolumeServerQuarantinedNeedleGauge = prometheus.NewGaugeVec(
+		prometheus.GaugeOpts{
+			Namespace: Namespace,
+			Subsystem: "volumeServer",
+			Name:      "quarantined_needles",
+			Help:      "Number of corrupted needles not repaired yet.",
+		}, []string{"collection"})
+
+	VolumeServerReplicationLagGauge = prometheus.NewGaugeVec(
+		prometheus.GaugeOpts{
+			Namespace: Namespace,
+			Subsystem: "volumeServer",
+			Name:      "replication_lag_seconds",
+			Help:      "Max seconds the replicas fed asynchronously from another data center are behind.",
+		}, []string{"collection"})
+
+	VolumeServerNeedleCacheCounter = prometheus.NewCounterVec(
+		prometheus.CounterOpts{
+			Namespace: Namespace,
+			Subsystem: "volumeServer",
+			Name:      "needle_cache_total",
+			Help:      "Counter of needle cache lookups by result, and of needles admitted or rejected by the cache.",
+		}, []string{"type"})
+
+	VolumeServerNeedleCacheGauge = prometheus.NewGauge(
+		prometheus.GaugeOpts{
+			Namespace: Namespace,
+			Subsystem: "volumeServer",
+			Name:      "needle_cache_entries",
+			Help:      "Number of needles in the in-memory needle cache.",
+		})
+
+	VolumeServerIoQosBytesCounter = prometheus.NewCounterVec(
+		prometheus.CounterOpts{
+			Namespace: Namespace,
+			Subsystem: "volumeServer",
+			Name:      "io_qos_bytes",
+			Help:      "Counter of bytes read or written by I/O budget.",
+		}, []string{"budget", "type"})
+
+	VolumeServerIoQosWaitCounter = prometheus.NewCounterVec(
+		prometheus.CounterOpts{
+			Namespace: Namespace,
+			Subsystem: "volumeServer",
+			Name:      "io_qos_throttled_seconds",
+			Help:      "Counter of seconds requests waited for their I/O budget.",
+		}, []string{"budget", "type"})
+
 	S3RequestCounter = prometheus.NewCounterVec(
 		prometheus.CounterOpts{
 			Namespace: Namespace,
@@ -304,6 +376,15 @@ func init() {
 	Gather.MustRegister(VolumeServerDiskSizeGauge)
 	Gather.MustRegister(VolumeServerResourceGauge)
 	Gather.MustRegister(VolumeServerChecksumDuration)
+	Gather.MustRegister(VolumeServerScrubNeedleCounter)
+	Gather.MustRegister(VolumeServerScrubBytesCounter)
+	Gather.MustRegister(VolumeServerQuarantinedNeedleGauge)
+	Gather.MustRegister(VolumeServerReplicationLagGauge)
+	Gather.MustRegister(VolumeServerReadRepairCounter)
+	Gather.MustRegister(VolumeServerNeedleCacheCounter)
+	Gather.MustRegister(VolumeServerNeedleCacheGauge)
+	Gather.MustRegister(VolumeServerIoQosBytesCounter)
+	Gather.MustRegister(VolumeServerIoQosWaitCounter)
 
 	Gather.MustRegister(S3RequestCounter)
 	Gather.MustRegister(S3HandlerCounter)
@@ -363,4 +444,6 @@ func DeleteCollectionMetrics(collection string) {
 		VolumeServerReadOnlyVolumeGauge.DeleteLabelValues(collection, volume_type)
 	}
 	VolumeServerVolumeGauge.DeleteLabelValues(collection, "volume")
+	VolumeServerQuarantinedNeedleGauge.DeleteLabelValues(collection)
+	VolumeServerReplicationLagGauge.DeleteLabelValues(collection)
 }
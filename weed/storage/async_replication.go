@@ -0,0 +1,119 @@
+package storage
+
+import (
+	"path/filepath"
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/gateway-dao/seaweedfs/weed/pb/master_pb"
+	"github.com/gateway-dao/seaweedfs/weed/util"
+)
+
+const (
+	AsyncReplicationPrefix = "master.replication.async"
+
+	DefaultAsyncReplicationMaxLag = 60 * time.Second
+)
+
+// LoadAsyncReplicationConfiguration reads the asynchronously replicated collections from the master configuration.
+// It returns nil if asynchronous replication is not enabled.
+func LoadAsyncReplicationConfiguration(config *util.ViperProxy) *master_pb.AsyncReplicationConfiguration {
+	collections := config.GetStringSlice(AsyncReplicationPrefix + ".collections")
+	if len(collections) == 0 {
+		return nil
+	}
+	return &master_pb.AsyncReplicationConfiguration{
+		Collections:       collections,
+		PrimaryDataCenter: config.GetString(AsyncReplicationPrefix + ".primary_data_center"),
+		MaxLagSeconds:     uint32(config.GetInt(AsyncReplicationPrefix + ".max_lag_seconds")),
+	}
+}
+
+// AsyncReplication decides which volumes are replicated asynchronously across data centers.
+// The replicas in the primary data center accept the writes, and are written synchronously.
+// The replicas in the other data centers follow them by tailing a replica in the primary data center.
+type AsyncReplication struct {
+	sync.RWMutex
+	collections       []string
+	primaryDataCenter string
+	maxLag            time.Duration
+}
+
+func NewAsyncReplication(conf *master_pb.AsyncReplicationConfiguration) *AsyncReplication {
+	r := &AsyncReplication{}
+	r.Configure(conf)
+	return r
+}
+
+func (r *AsyncReplication) Configure(conf *master_pb.AsyncReplicationConfiguration) {
+	maxLag := time.Duration(conf.GetMaxLagSeconds()) * time.Second
+	if maxLag <= 0 {
+		maxLag = DefaultAsyncReplicationMaxLag
+	}
+	r.Lock()
+	defer r.Unlock()
+	r.collections = conf.GetCollections()
+	r.primaryDataCenter = conf.GetPrimaryDataCenter()
+	r.maxLag = maxLag
+}
+
+func (r *AsyncReplication) IsAsync(collection string) bool {
+	if r == nil {
+		return false
+	}
+	r.RLock()
+	defer r.RUnlock()
+	for _, pattern := range r.collections {
+		if matched, _ := filepath.Match(pattern, collection); matched {
+			return true
+		}
+	}
+	return false
+}
+
+// MaxLag is how long a replica may be behind the primary data center, and still serve reads.
+func (r *AsyncReplication) MaxLag() time.Duration {
+	if r == nil {
+		return DefaultAsyncReplicationMaxLag
+	}
+	r.RLock()
+	defer r.RUnlock()
+	return r.maxLag
+}
+
+// PrimaryDataCenter picks the data center accepting the writes of a volume, from the data centers of its replicas.
+// It is the configured primary data center if it holds a replica, otherwise the first one by name.
+func (r *AsyncReplication) PrimaryDataCenter(dataCenters []string) string {
+	if len(dataCenters) == 0 {
+		return ""
+	}
+	var primary string
+	if r != nil {
+		r.RLock()
+		primary = r.primaryDataCenter
+		r.RUnlock()
+	}
+	for _, dc := range dataCenters {
+		if dc == primary {
+			return primary
+		}
+	}
+	sorted := append([]string{}, dataCenters...)
+	sort.Strings(sorted)
+	return sorted[0]
+}
+
+// AsyncReplicatedVolumes lists the volumes with replicas in other data centers fed asynchronously.
+func (s *Store) AsyncReplicatedVolumes() (volumes []*Volume) {
+	for _, location := range s.Locations {
+		location.volumesLock.RLock()
+		for _, v := range location.volumes {
+			if v.ReplicaPlacement != nil && v.ReplicaPlacement.DiffDataCenterCount > 0 && s.AsyncReplication.IsAsync(v.Collection) {
+				volumes = append(volumes, v)
+			}
+		}
+		location.volumesLock.RUnlock()
+	}
+	return
+}
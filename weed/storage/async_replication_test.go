@@ -0,0 +1,80 @@
+package storage
+
+import (
+	"testing"
+	"time"
+
+	"github.com/gateway-dao/seaweedfs/weed/pb/master_pb"
+	"github.com/gateway-dao/seaweedfs/weed/storage/needle"
+	"github.com/gateway-dao/seaweedfs/weed/storage/super_block"
+)
+
+func TestAsyncReplication(t *testing.T) {
+	var disabled *AsyncReplication
+	if disabled.IsAsync("media") || disabled.MaxLag() != DefaultAsyncReplicationMaxLag {
+		t.Fatalf("nil async replication should be disabled")
+	}
+
+	r := NewAsyncReplication(&master_pb.AsyncReplicationConfiguration{
+		Collections:       []string{"media*", "logs"},
+		PrimaryDataCenter: "dc2",
+	})
+	if !r.IsAsync("media1") || !r.IsAsync("logs") || r.IsAsync("") || r.IsAsync("logs1") {
+		t.Fatalf("unexpected collection matching")
+	}
+	if r.MaxLag() != DefaultAsyncReplicationMaxLag {
+		t.Fatalf("max lag %v", r.MaxLag())
+	}
+	if primary := r.PrimaryDataCenter([]string{"dc3", "dc2", "dc1"}); primary != "dc2" {
+		t.Fatalf("primary data center %s", primary)
+	}
+	// the configured primary data center holds no replica
+	if primary := r.PrimaryDataCenter([]string{"dc3", "dc1"}); primary != "dc1" {
+		t.Fatalf("primary data center %s", primary)
+	}
+
+	r.Configure(&master_pb.AsyncReplicationConfiguration{MaxLagSeconds: 5})
+	if r.IsAsync("media1") || r.MaxLag() != 5*time.Second {
+		t.Fatalf("configuration is not updated")
+	}
+}
+
+func TestAsyncReplicaPosition(t *testing.T) {
+	dir := t.TempDir()
+	v, err := NewVolume(dir, dir, "", 1, NeedleMapInMemory, &super_block.ReplicaPlacement{}, &needle.TTL{}, 0, 0, 0)
+	if err != nil {
+		t.Fatalf("volume creation: %v", err)
+	}
+	defer v.Close()
+
+	if v.ReplicationLag() != 0 {
+		t.Fatalf("a volume not following another replica has no lag")
+	}
+
+	appendAtNs := uint64(time.Now().Add(-time.Hour).UnixNano())
+	v.StartAsyncReplica("127.0.0.1:8080")
+	v.AdvanceAsyncReplica(appendAtNs)
+	if lag := v.ReplicationLag(); lag < time.Hour {
+		t.Fatalf("lag %v", lag)
+	}
+	if source, isLagging := v.AsyncReplicaSource(time.Minute); !isLagging || source != "127.0.0.1:8080" {
+		t.Fatalf("lagging replica should read from %s", source)
+	}
+	v.StopAsyncReplica()
+
+	// resume from the saved position
+	if sinceNs := v.StartAsyncReplica("127.0.0.1:8080"); sinceNs != appendAtNs {
+		t.Fatalf("resume from %d, expected %d", sinceNs, appendAtNs)
+	}
+	v.AsyncReplicaCaughtUp()
+	if _, isLagging := v.AsyncReplicaSource(time.Minute); isLagging {
+		t.Fatalf("caught up replica is lagging")
+	}
+	v.StopAsyncReplica()
+
+	// rewind when following another source, since the clocks differ
+	if sinceNs := v.StartAsyncReplica("127.0.0.1:8081"); sinceNs != appendAtNs-uint64(asyncReplicaRewind) {
+		t.Fatalf("resume from %d, expected %d", sinceNs, appendAtNs-uint64(asyncReplicaRewind))
+	}
+	v.StopAsyncReplica()
+}
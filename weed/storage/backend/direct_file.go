@@ -0,0 +1,207 @@
+package backend
+
+import (
+	"fmt"
+	"io"
+	"os"
+	"sync"
+	"unsafe"
+
+	"github.com/gateway-dao/seaweedfs/weed/glog"
+)
+
+// DiskIoMode selects how the .dat files of a disk location are read.
+type DiskIoMode string
+
+const (
+	// DiskIoBuffered reads through the page cache.
+	DiskIoBuffered DiskIoMode = "buffered"
+	// DiskIoDirect reads with O_DIRECT, bypassing the page cache.
+	DiskIoDirect DiskIoMode = "direct"
+
+	directIoAlignment  = 4096
+	directIoBufferSize = 64 * 1024
+)
+
+var (
+	_ BackendStorageFile = &DirectFile{}
+
+	directIoBufferPool = sync.Pool{
+		New: func() interface{} {
+			return alignedBuffer(directIoBufferSize)
+		},
+	}
+)
+
+func ParseDiskIoMode(s string) (DiskIoMode, error) {
+	switch DiskIoMode(s) {
+	case "", DiskIoBuffered:
+		return DiskIoBuffered, nil
+	case DiskIoDirect:
+		return DiskIoDirect, nil
+	}
+	return "", fmt.Errorf("unknown disk io mode %q, expecting buffered or direct", s)
+}
+
+// directReader reads aligned blocks from a file opened with O_DIRECT.
+type directReader interface {
+	ReadAt(fd int, p []byte, off int64) (n int, err error)
+}
+
+/*
+DirectFile reads the volume data file with O_DIRECT, so hot volumes do not
+evict each other from the page cache and reads cost the same memory whatever
+the volume size. Reads are widened to aligned blocks, and go through io_uring
+when the kernel supports it.
+
+Writes, truncation and stats still go through the wrapped DiskFile. The kernel
+flushes dirty pages of a range before reading it with O_DIRECT, so reads
+always see the written data. After each sync, the now clean pages are dropped
+from the page cache.
+*/
+type DirectFile struct {
+	*DiskFile
+	directFile  *os.File
+	reader      directReader
+	droppedSize int64 // the page cache is dropped up to this offset
+}
+
+// WithDiskIo wraps a local disk file with the given io mode. If the mode can
+// not be used, for example the file system does not support O_DIRECT, the
+// file is returned as is.
+func WithDiskIo(f BackendStorageFile, mode DiskIoMode) BackendStorageFile {
+	diskFile, ok := f.(*DiskFile)
+	if !ok || mode != DiskIoDirect {
+		return f
+	}
+	directFile, err := NewDirectFile(diskFile)
+	if err != nil {
+		glog.Warningf("fall back to buffered io for %s: %v", diskFile.Name(), err)
+		return f
+	}
+	return directFile
+}
+
+// UnwrapDiskFile returns the local disk file behind the backend storage file.
+func UnwrapDiskFile(f BackendStorageFile) (*DiskFile, bool) {
+	switch t := f.(type) {
+	case *DiskFile:
+		return t, true
+	case *DirectFile:
+		return t.DiskFile, true
+	}
+	return nil, false
+}
+
+func NewDirectFile(diskFile *DiskFile) (*DirectFile, error) {
+	f, err := openDirectFile(diskFile.Name())
+	if err != nil {
+		return nil, err
+	}
+	return &DirectFile{
+		DiskFile:   diskFile,
+		directFile: f,
+		reader:     newDirectReader(),
+	}, nil
+}
+
+func (df *DirectFile) ReadAt(p []byte, off int64) (n int, err error) {
+	if df.File == nil {
+		return 0, os.ErrClosed
+	}
+	if len(p) == 0 {
+		return 0, nil
+	}
+
+	start := off &^ (directIoAlignment - 1)
+	end := alignUp(off + int64(len(p)))
+
+	var buf []byte
+	if start == off && end == off+int64(len(p)) && isAligned(p) {
+		buf = p
+	} else if end-start <= directIoBufferSize {
+		pooled := directIoBufferPool.Get().([]byte)
+		defer directIoBufferPool.Put(pooled)
+		buf = pooled[:end-start]
+	} else {
+		buf = alignedBuffer(int(end - start))
+	}
+
+	read, err := df.readFull(buf, start)
+	skip := int(off - start)
+	if &buf[0] == &p[0] {
+		n = read
+	} else if read > skip {
+		n = copy(p, buf[skip:read])
+	}
+	if n < len(p) && err == nil {
+		err = io.EOF
+	}
+	return n, err
+}
+
+func (df *DirectFile) readFull(buf []byte, off int64) (n int, err error) {
+	fd := int(df.directFile.Fd())
+	for n < len(buf) {
+		var m int
+		m, err = df.reader.ReadAt(fd, buf[n:], off+int64(n))
+		if err != nil {
+			return n, err
+		}
+		if m == 0 {
+			break
+		}
+		n += m
+		if m%directIoAlignment != 0 {
+			// only the last block before the end of file can be partial
+			break
+		}
+	}
+	return n, nil
+}
+
+func (df *DirectFile) Sync() error {
+	if err := df.DiskFile.Sync(); err != nil {
+		return err
+	}
+	if df.fileSize > df.droppedSize {
+		dropPageCache(df.File, df.droppedSize, df.fileSize-df.droppedSize)
+	}
+	df.droppedSize = df.fileSize
+	return nil
+}
+
+func (df *DirectFile) Truncate(off int64) error {
+	err := df.DiskFile.Truncate(off)
+	if err == nil && df.droppedSize > off {
+		df.droppedSize = off
+	}
+	return err
+}
+
+func (df *DirectFile) Close() error {
+	err := df.DiskFile.Close()
+	if df.directFile != nil {
+		df.directFile.Close()
+		df.directFile = nil
+	}
+	return err
+}
+
+func alignUp(off int64) int64 {
+	return (off + directIoAlignment - 1) &^ (directIoAlignment - 1)
+}
+
+func isAligned(p []byte) bool {
+	return uintptr(unsafe.Pointer(&p[0]))%directIoAlignment == 0
+}
+
+// alignedBuffer allocates a buffer whose address is aligned for O_DIRECT.
+func alignedBuffer(size int) []byte {
+	buf := make([]byte, size+directIoAlignment)
+	shift := 0
+	if remainder := int(uintptr(unsafe.Pointer(&buf[0])) % directIoAlignment); remainder != 0 {
+		shift = directIoAlignment - remainder
+	}
+	return buf[shift : shift+size : shift+size]
+}
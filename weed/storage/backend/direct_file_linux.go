@@ -0,0 +1,55 @@
+//go:build linux
+// +build linux
+
+package backend
+
+import (
+	"os"
+	"sync"
+
+	"golang.org/x/sys/unix"
+
+	"github.com/gateway-dao/seaweedfs/weed/glog"
+)
+
+var (
+	sharedDirectReader     directReader
+	sharedDirectReaderOnce sync.Once
+)
+
+func openDirectFile(name string) (*os.File, error) {
+	return os.OpenFile(name, os.O_RDONLY|unix.O_DIRECT, 0)
+}
+
+// newDirectReader returns the io_uring reader shared by all direct files,
+// or plain pread if the kernel does not support io_uring.
+func newDirectReader() directReader {
+	sharedDirectReaderOnce.Do(func() {
+		ring, err := newIoUring(ioUringEntries)
+		if err != nil {
+			glog.V(0).Infof("io_uring is not available, direct io uses pread: %v", err)
+			sharedDirectReader = preadReader{}
+			return
+		}
+		glog.V(0).Infof("direct io uses io_uring")
+		sharedDirectReader = ring
+	})
+	return sharedDirectReader
+}
+
+type preadReader struct{}
+
+func (preadReader) ReadAt(fd int, p []byte, off int64) (n int, err error) {
+	for {
+		n, err = unix.Pread(fd, p, off)
+		if err != unix.EINTR {
+			return n, err
+		}
+	}
+}
+
+func dropPageCache(f *os.File, off int64, length int64) {
+	if err := unix.Fadvise(int(f.Fd()), off, length, unix.FADV_DONTNEED); err != nil {
+		glog.V(1).Infof("drop page cache of %s: %v", f.Name(), err)
+	}
+}
@@ -0,0 +1,21 @@
+//go:build !linux
+// +build !linux
+
+package backend
+
+import (
+	"fmt"
+	"os"
+	"runtime"
+)
+
+func openDirectFile(name string) (*os.File, error) {
+	return nil, fmt.Errorf("direct io is not supported on %s", runtime.GOOS)
+}
+
+func newDirectReader() directReader {
+	return nil
+}
+
+func dropPageCache(f *os.File, off int64, length int64) {
+}
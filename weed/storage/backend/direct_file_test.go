@@ -0,0 +1,78 @@
+package backend
+
+import (
+	"bytes"
+	"math/rand"
+	"os"
+	"path/filepath"
+	"sync"
+	"testing"
+)
+
+func TestDirectFile(t *testing.T) {
+	f, err := os.OpenFile(filepath.Join(t.TempDir(), "1.dat"), os.O_RDWR|os.O_CREATE, 0644)
+	if err != nil {
+		t.Fatalf("create: %v", err)
+	}
+	diskFile := NewDiskFile(f)
+	directFile, err := NewDirectFile(diskFile)
+	if err != nil {
+		diskFile.Close()
+		t.Skipf("direct io is not available: %v", err)
+	}
+	defer directFile.Close()
+
+	// not a multiple of the alignment, and partly synced
+	data := make([]byte, 3*directIoBufferSize+123)
+	rand.Read(data)
+	half := len(data) / 2
+	if _, err = directFile.Write(data[:half]); err != nil {
+		t.Fatalf("write: %v", err)
+	}
+	if err = directFile.Sync(); err != nil {
+		t.Fatalf("sync: %v", err)
+	}
+	if _, err = directFile.Write(data[half:]); err != nil {
+		t.Fatalf("write: %v", err)
+	}
+
+	var wg sync.WaitGroup
+	for i := 0; i < 8; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for j := 0; j < 200; j++ {
+				off := rand.Intn(len(data))
+				size := rand.Intn(2*directIoBufferSize) + 1
+				p := make([]byte, size)
+				n, err := directFile.ReadAt(p, int64(off))
+				expected := data[off:]
+				if len(expected) > size {
+					expected = expected[:size]
+				}
+				if n != len(expected) || !bytes.Equal(p[:n], expected) {
+					t.Errorf("read %d bytes at %d: got %d bytes, err %v", size, off, n, err)
+					return
+				}
+				if n < size && err == nil {
+					t.Errorf("read past the end at %d should return an error", off)
+					return
+				}
+			}
+		}()
+	}
+	wg.Wait()
+
+	// aligned reads go directly into aligned buffers
+	p := alignedBuffer(directIoAlignment)
+	if n, err := directFile.ReadAt(p, directIoAlignment); err != nil || !bytes.Equal(p[:n], data[directIoAlignment:2*directIoAlignment]) {
+		t.Fatalf("aligned read: %d %v", n, err)
+	}
+
+	if unwrapped, ok := UnwrapDiskFile(WithDiskIo(diskFile, DiskIoBuffered)); !ok || unwrapped != diskFile {
+		t.Fatalf("buffered io should keep the disk file")
+	}
+	if unwrapped, ok := UnwrapDiskFile(directFile); !ok || unwrapped != diskFile {
+		t.Fatalf("direct file should unwrap to the disk file")
+	}
+}
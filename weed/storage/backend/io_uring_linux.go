@@ -0,0 +1,227 @@
+//go:build linux
+// +build linux
+
+package backend
+
+import (
+	"fmt"
+	"runtime"
+	"sync/atomic"
+	"syscall"
+	"time"
+	"unsafe"
+
+	"golang.org/x/sys/unix"
+)
+
+// a minimal io_uring, only for reads of direct files
+
+const (
+	sysIoUringSetup = 425
+	sysIoUringEnter = 426
+
+	ioringOffSqRing      = 0
+	ioringOffSqes        = 0x10000000
+	ioringFeatSingleMmap = 1 << 0
+	ioringOpReadv        = 1
+	ioringEnterGetevents = 1 << 0
+
+	ioUringEntries = 128
+)
+
+type ioSqringOffsets struct {
+	head, tail, ringMask, ringEntries, flags, dropped, array, resv1 uint32
+	userAddr                                                        uint64
+}
+
+type ioCqringOffsets struct {
+	head, tail, ringMask, ringEntries, overflow, cqes, flags, resv1 uint32
+	userAddr                                                        uint64
+}
+
+type ioUringParams struct {
+	sqEntries, cqEntries, flags, sqThreadCpu, sqThreadIdle, features, wqFd uint32
+	resv                                                                   [3]uint32
+	sqOff                                                                  ioSqringOffsets
+	cqOff                                                                  ioCqringOffsets
+}
+
+type ioUringSqe struct {
+	opcode      uint8
+	flags       uint8
+	ioprio      uint16
+	fd          int32
+	off         uint64
+	addr        uint64
+	len         uint32
+	rwFlags     uint32
+	userData    uint64
+	bufIndex    uint16
+	personality uint16
+	spliceFdIn  int32
+	addr3       uint64
+	pad         uint64
+}
+
+type ioUringCqe struct {
+	userData uint64
+	res      int32
+	flags    uint32
+}
+
+type ioUringRequest struct {
+	fd     int
+	buf    []byte
+	offset int64
+	iovec  unix.Iovec
+	pinner runtime.Pinner
+	n      int
+	err    error
+	done   chan struct{}
+}
+
+// ioUring submits the reads of all callers from one goroutine. Reads arriving
+// while others are in flight are submitted together with one system call.
+type ioUring struct {
+	fd       int
+	ring     []byte
+	sqeRing  []byte
+	sqTail   *uint32
+	sqMask   uint32
+	sqArray  []uint32
+	sqes     []ioUringSqe
+	cqHead   *uint32
+	cqTail   *uint32
+	cqMask   uint32
+	cqes     []ioUringCqe
+	entries  int
+	requests chan *ioUringRequest
+}
+
+func newIoUring(entries uint32) (*ioUring, error) {
+	var p ioUringParams
+	fd, _, errno := syscall.Syscall(sysIoUringSetup, uintptr(entries), uintptr(unsafe.Pointer(&p)), 0)
+	if errno != 0 {
+		return nil, errno
+	}
+	if p.features&ioringFeatSingleMmap == 0 {
+		unix.Close(int(fd))
+		return nil, fmt.Errorf("kernel io_uring is too old")
+	}
+
+	ringSize := p.sqOff.array + p.sqEntries*4
+	if cqSize := p.cqOff.cqes + p.cqEntries*uint32(unsafe.Sizeof(ioUringCqe{})); cqSize > ringSize {
+		ringSize = cqSize
+	}
+	ring, err := unix.Mmap(int(fd), ioringOffSqRing, int(ringSize), unix.PROT_READ|unix.PROT_WRITE, unix.MAP_SHARED|unix.MAP_POPULATE)
+	if err != nil {
+		unix.Close(int(fd))
+		return nil, fmt.Errorf("mmap io_uring: %v", err)
+	}
+	sqeRing, err := unix.Mmap(int(fd), ioringOffSqes, int(p.sqEntries)*int(unsafe.Sizeof(ioUringSqe{})), unix.PROT_READ|unix.PROT_WRITE, unix.MAP_SHARED|unix.MAP_POPULATE)
+	if err != nil {
+		unix.Munmap(ring)
+		unix.Close(int(fd))
+		return nil, fmt.Errorf("mmap io_uring sqes: %v", err)
+	}
+
+	u := &ioUring{
+		fd:       int(fd),
+		ring:     ring,
+		sqeRing:  sqeRing,
+		sqTail:   (*uint32)(unsafe.Pointer(&ring[p.sqOff.tail])),
+		sqMask:   *(*uint32)(unsafe.Pointer(&ring[p.sqOff.ringMask])),
+		sqArray:  unsafe.Slice((*uint32)(unsafe.Pointer(&ring[p.sqOff.array])), p.sqEntries),
+		sqes:     unsafe.Slice((*ioUringSqe)(unsafe.Pointer(&sqeRing[0])), p.sqEntries),
+		cqHead:   (*uint32)(unsafe.Pointer(&ring[p.cqOff.head])),
+		cqTail:   (*uint32)(unsafe.Pointer(&ring[p.cqOff.tail])),
+		cqMask:   *(*uint32)(unsafe.Pointer(&ring[p.cqOff.ringMask])),
+		cqes:     unsafe.Slice((*ioUringCqe)(unsafe.Pointer(&ring[p.cqOff.cqes])), p.cqEntries),
+		entries:  int(p.sqEntries),
+		requests: make(chan *ioUringRequest, p.sqEntries),
+	}
+	go u.loop()
+	return u, nil
+}
+
+func (u *ioUring) ReadAt(fd int, p []byte, off int64) (n int, err error) {
+	if len(p) == 0 {
+		return 0, nil
+	}
+	req := &ioUringRequest{fd: fd, buf: p, offset: off, done: make(chan struct{})}
+	u.requests <- req
+	<-req.done
+	return req.n, req.err
+}
+
+func (u *ioUring) loop() {
+	inflight := make(map[uint64]*ioUringRequest)
+	var nextId uint64
+	toSubmit := 0
+
+	prepare := func(req *ioUringRequest) {
+		nextId++
+		inflight[nextId] = req
+		// the kernel keeps the addresses until the read completes
+		req.pinner.Pin(req)
+		req.pinner.Pin(&req.buf[0])
+		req.iovec.Base = &req.buf[0]
+		req.iovec.SetLen(len(req.buf))
+
+		tail := atomic.LoadUint32(u.sqTail)
+		index := tail & u.sqMask
+		u.sqes[index] = ioUringSqe{
+			opcode:   ioringOpReadv,
+			fd:       int32(req.fd),
+			off:      uint64(req.offset),
+			addr:     uint64(uintptr(unsafe.Pointer(&req.iovec))),
+			len:      1,
+			userData: nextId,
+		}
+		u.sqArray[index] = index
+		atomic.StoreUint32(u.sqTail, tail+1)
+		toSubmit++
+	}
+
+	for {
+		if len(inflight) == 0 {
+			prepare(<-u.requests)
+		}
+	collect:
+		for len(inflight) < u.entries {
+			select {
+			case req := <-u.requests:
+				prepare(req)
+			default:
+				break collect
+			}
+		}
+
+		submitted, _, errno := syscall.Syscall6(sysIoUringEnter, uintptr(u.fd), uintptr(toSubmit), 1, ioringEnterGetevents, 0, 0)
+		if errno == 0 {
+			toSubmit -= int(submitted)
+		} else if errno != syscall.EINTR {
+			// EAGAIN or EBUSY, retry after the completed reads are reaped
+			time.Sleep(time.Millisecond)
+		}
+
+		head := atomic.LoadUint32(u.cqHead)
+		tail := atomic.LoadUint32(u.cqTail)
+		for ; head != tail; head++ {
+			cqe := u.cqes[head&u.cqMask]
+			req, found := inflight[cqe.userData]
+			if !found {
+				continue
+			}
+			delete(inflight, cqe.userData)
+			req.pinner.Unpin()
+			if cqe.res < 0 {
+				req.err = syscall.Errno(-cqe.res)
+			} else {
+				req.n = int(cqe.res)
+			}
+			close(req.done)
+		}
+		atomic.StoreUint32(u.cqHead, head)
+	}
+}
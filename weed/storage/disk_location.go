@@ -12,7 +12,9 @@ import (
 
 	"github.com/google/uuid"
 	"github.com/gateway-dao/seaweedfs/weed/glog"
+	"github.com/gateway-dao/seaweedfs/weed/security"
 	"github.com/gateway-dao/seaweedfs/weed/stats"
+	"github.com/gateway-dao/seaweedfs/weed/storage/backend"
 	"github.com/gateway-dao/seaweedfs/weed/storage/erasure_coding"
 	"github.com/gateway-dao/seaweedfs/weed/storage/needle"
 	"github.com/gateway-dao/seaweedfs/weed/storage/types"
@@ -24,6 +26,7 @@ type DiskLocation struct {
 	DirectoryUuid          string
 	IdxDirectory           string
 	DiskType               types.DiskType
+	DiskIo                 backend.DiskIoMode
 	MaxVolumeCount         int32
 	OriginalMaxVolumeCount int32
 	MinFreeSpace           util.MinFreeSpace
@@ -36,6 +39,9 @@ type DiskLocation struct {
 
 	isDiskSpaceLow bool
 	closeCh        chan struct{}
+
+	keyWrapper  security.KeyWrapper // unwraps the data keys of encrypted volumes
+	needleCache NeedleCache
 }
 
 func GenerateDirUuid(dir string) (dirUuidString string, err error) {
@@ -59,7 +65,7 @@ func GenerateDirUuid(dir string) (dirUuidString string, err error) {
 	return dirUuidString, nil
 }
 
-func NewDiskLocation(dir string, maxVolumeCount int32, minFreeSpace util.MinFreeSpace, idxDir string, diskType types.DiskType) *DiskLocation {
+func NewDiskLocation(dir string, maxVolumeCount int32, minFreeSpace util.MinFreeSpace, idxDir string, diskType types.DiskType, diskIo backend.DiskIoMode, keyWrapper security.KeyWrapper) *DiskLocation {
 	dir = util.ResolvePath(dir)
 	if idxDir == "" {
 		idxDir = dir
@@ -75,9 +81,11 @@ func NewDiskLocation(dir string, maxVolumeCount int32, minFreeSpace util.MinFree
 		DirectoryUuid:          dirUuid,
 		IdxDirectory:           idxDir,
 		DiskType:               diskType,
+		DiskIo:                 diskIo,
 		MaxVolumeCount:         maxVolumeCount,
 		OriginalMaxVolumeCount: maxVolumeCount,
 		MinFreeSpace:           minFreeSpace,
+		keyWrapper:             keyWrapper,
 	}
 	location.volumes = make(map[needle.VolumeId]*Volume)
 	location.ecVolumes = make(map[needle.VolumeId]*erasure_coding.EcVolume)
@@ -143,6 +151,12 @@ func (l *DiskLocation) loadExistingVolume(dirEntry os.DirEntry, needleMapKind Ne
 		}
 	}
 
+	// keep the files of an interrupted copy for a retry to resume
+	if checkpoint, err := LoadCopyCheckpoint(l.Directory + "/" + volumeName); err == nil && !checkpoint.IsExpired() {
+		glog.V(0).Infof("volume %s is partially copied from %s, skip loading", volumeName, checkpoint.SourceDataNode)
+		return false
+	}
+
 	// check for incomplete volume
 	noteFile := l.Directory + "/" + volumeName + ".note"
 	if util.FileExists(noteFile) {
@@ -175,6 +189,11 @@ func (l *DiskLocation) loadExistingVolume(dirEntry os.DirEntry, needleMapKind Ne
 		glog.V(0).Infof("new volume %s error %s", volumeName, e)
 		return false
 	}
+	if e = v.loadDataKey(l.keyWrapper); e != nil {
+		// keep serving the plain text needles, but refuse new writes
+		glog.Errorf("load data key: %v", e)
+		v.noWriteOrDelete = true
+	}
 
 	l.SetVolume(vid, v)
 
@@ -351,11 +370,14 @@ func (l *DiskLocation) unmountVolumeByCollection(collectionName string) map[need
 }
 
 func (l *DiskLocation) SetVolume(vid needle.VolumeId, volume *Volume) {
+	volume.setDiskIo(l.DiskIo)
+
 	l.volumesLock.Lock()
 	defer l.volumesLock.Unlock()
 
 	l.volumes[vid] = volume
 	volume.location = l
+	volume.needleCache = l.needleCache
 }
 
 func (l *DiskLocation) FindVolume(vid needle.VolumeId) (*Volume, bool) {
@@ -10,6 +10,7 @@ import (
 
 	"golang.org/x/exp/slices"
 
+	"github.com/gateway-dao/seaweedfs/weed/glog"
 	"github.com/gateway-dao/seaweedfs/weed/storage/erasure_coding"
 	"github.com/gateway-dao/seaweedfs/weed/storage/needle"
 )
@@ -89,6 +90,9 @@ func (l *DiskLocation) LoadEcShard(collection string, vid needle.VolumeId, shard
 		if err != nil {
 			return fmt.Errorf("failed to create ec volume %d: %v", vid, err)
 		}
+		if err = loadEcVolumeDataKey(ecVolume, l.keyWrapper); err != nil {
+			glog.Errorf("load data key: %v", err)
+		}
 		l.ecVolumes[vid] = ecVolume
 	}
 	ecVolume.AddEcVolumeShard(ecVolumeShard)
@@ -228,3 +232,14 @@ func (l *DiskLocation) EcShardCount() int {
 	}
 	return shardCount
 }
+
+// EcVolumeSlots is the number of volume slots taken by the ec shards, by the scheme of each ec volume.
+func (l *DiskLocation) EcVolumeSlots() (slots float64) {
+	l.ecVolumesLock.RLock()
+	defer l.ecVolumesLock.RUnlock()
+
+	for _, ecVolume := range l.ecVolumes {
+		slots += ecVolume.Scheme.VolumeSlots(len(ecVolume.Shards))
+	}
+	return
+}
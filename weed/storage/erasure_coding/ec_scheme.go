@@ -0,0 +1,91 @@
+package erasure_coding
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/gateway-dao/seaweedfs/weed/pb/volume_server_pb"
+)
+
+// MaxShardCount is limited by the 32 bits of ShardBits
+const MaxShardCount = 32
+
+// EcScheme is the number of data shards and parity shards an ec volume is encoded with.
+type EcScheme struct {
+	DataShards   int
+	ParityShards int
+}
+
+var DefaultEcScheme = EcScheme{DataShards: DataShardsCount, ParityShards: ParityShardsCount}
+
+func NewEcScheme(dataShards, parityShards int) (EcScheme, error) {
+	scheme := EcScheme{DataShards: dataShards, ParityShards: parityShards}
+	if dataShards < 1 || parityShards < 1 {
+		return scheme, fmt.Errorf("invalid ec scheme %s: need at least 1 data shard and 1 parity shard", scheme)
+	}
+	if scheme.TotalShards() > MaxShardCount {
+		return scheme, fmt.Errorf("invalid ec scheme %s: at most %d shards in total", scheme, MaxShardCount)
+	}
+	return scheme, nil
+}
+
+// ParseEcScheme parses "data+parity", e.g. "6+3". An empty string means the default scheme.
+func ParseEcScheme(s string) (EcScheme, error) {
+	if s == "" {
+		return DefaultEcScheme, nil
+	}
+	parts := strings.Split(s, "+")
+	if len(parts) != 2 {
+		return EcScheme{}, fmt.Errorf("invalid ec scheme %q, expecting data+parity such as 6+3", s)
+	}
+	dataShards, err := strconv.Atoi(strings.TrimSpace(parts[0]))
+	if err != nil {
+		return EcScheme{}, fmt.Errorf("invalid data shards in ec scheme %q: %v", s, err)
+	}
+	parityShards, err := strconv.Atoi(strings.TrimSpace(parts[1]))
+	if err != nil {
+		return EcScheme{}, fmt.Errorf("invalid parity shards in ec scheme %q: %v", s, err)
+	}
+	return NewEcScheme(dataShards, parityShards)
+}
+
+// EcSchemeOf returns the scheme with the given shard counts, or the default scheme if not set,
+// which is the case for volumes encoded before the scheme was configurable.
+func EcSchemeOf(dataShards, parityShards uint32) EcScheme {
+	if dataShards == 0 || parityShards == 0 {
+		return DefaultEcScheme
+	}
+	return EcScheme{DataShards: int(dataShards), ParityShards: int(parityShards)}
+}
+
+func EcSchemeFromConfig(config *volume_server_pb.EcShardConfig) EcScheme {
+	if config == nil {
+		return DefaultEcScheme
+	}
+	return EcSchemeOf(config.DataShards, config.ParityShards)
+}
+
+func (s EcScheme) ToConfig() *volume_server_pb.EcShardConfig {
+	return &volume_server_pb.EcShardConfig{
+		DataShards:   uint32(s.DataShards),
+		ParityShards: uint32(s.ParityShards),
+	}
+}
+
+func (s EcScheme) TotalShards() int {
+	return s.DataShards + s.ParityShards
+}
+
+// VolumeSlots is the share of a volume slot taken by shardCount shards, each holding 1/DataShards of the volume.
+func (s EcScheme) VolumeSlots(shardCount int) float64 {
+	return float64(shardCount) / float64(s.DataShards)
+}
+
+func (s EcScheme) IsDefault() bool {
+	return s == DefaultEcScheme
+}
+
+func (s EcScheme) String() string {
+	return fmt.Sprintf("%d+%d", s.DataShards, s.ParityShards)
+}
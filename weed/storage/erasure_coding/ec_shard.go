@@ -96,3 +96,16 @@ func (shard *EcVolumeShard) ReadAt(buf []byte, offset int64) (int, error) {
 	return shard.ecdFile.ReadAt(buf, offset)
 
 }
+
+// WriteAt overwrites part of the shard file, used to repair corrupted shard data
+func (shard *EcVolumeShard) WriteAt(buf []byte, offset int64) (n int, err error) {
+	f, err := os.OpenFile(shard.FileName()+ToExt(int(shard.ShardId)), os.O_WRONLY, 0644)
+	if err != nil {
+		return 0, err
+	}
+	defer f.Close()
+	if n, err = f.WriteAt(buf, offset); err != nil {
+		return
+	}
+	return n, f.Sync()
+}
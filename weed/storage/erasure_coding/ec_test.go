@@ -22,7 +22,7 @@ func TestEncodingDecoding(t *testing.T) {
 	bufferSize := 50
 	baseFileName := "1"
 
-	err := generateEcFiles(baseFileName, bufferSize, largeBlockSize, smallBlockSize)
+	err := generateEcFiles(baseFileName, DefaultEcScheme, bufferSize, largeBlockSize, smallBlockSize)
 	if err != nil {
 		t.Logf("generateEcFiles: %v", err)
 	}
@@ -59,7 +59,7 @@ func validateFiles(baseFileName string) error {
 		return fmt.Errorf("failed to stat dat file: %v", err)
 	}
 
-	ecFiles, err := openEcFiles(baseFileName, true)
+	ecFiles, err := openEcFiles(baseFileName, DefaultEcScheme, true)
 	if err != nil {
 		return fmt.Errorf("error opening ec files: %w", err)
 	}
@@ -108,7 +108,7 @@ func readDatFile(datFile *os.File, offset types.Offset, size types.Size) ([]byte
 
 func readEcFile(datSize int64, ecFiles []*os.File, offset types.Offset, size types.Size) (data []byte, err error) {
 
-	intervals := LocateData(largeBlockSize, smallBlockSize, datSize, offset.ToActualOffset(), size)
+	intervals := LocateData(DataShardsCount, largeBlockSize, smallBlockSize, datSize, offset.ToActualOffset(), size)
 
 	for i, interval := range intervals {
 		if d, e := readOneInterval(interval, ecFiles); e != nil {
@@ -190,15 +190,15 @@ func removeGeneratedFiles(baseFileName string) {
 }
 
 func TestLocateData(t *testing.T) {
-	intervals := LocateData(largeBlockSize, smallBlockSize, DataShardsCount*largeBlockSize+1, DataShardsCount*largeBlockSize, 1)
+	intervals := LocateData(DataShardsCount, largeBlockSize, smallBlockSize, DataShardsCount*largeBlockSize+1, DataShardsCount*largeBlockSize, 1)
 	if len(intervals) != 1 {
 		t.Errorf("unexpected interval size %d", len(intervals))
 	}
-	if !intervals[0].sameAs(Interval{0, 0, 1, false, 1}) {
+	if !intervals[0].sameAs(Interval{0, 0, 1, false, 1, DataShardsCount}) {
 		t.Errorf("unexpected interval %+v", intervals[0])
 	}
 
-	intervals = LocateData(largeBlockSize, smallBlockSize, DataShardsCount*largeBlockSize+1, DataShardsCount*largeBlockSize/2+100, DataShardsCount*largeBlockSize+1-DataShardsCount*largeBlockSize/2-100)
+	intervals = LocateData(DataShardsCount, largeBlockSize, smallBlockSize, DataShardsCount*largeBlockSize+1, DataShardsCount*largeBlockSize/2+100, DataShardsCount*largeBlockSize+1-DataShardsCount*largeBlockSize/2-100)
 	fmt.Printf("%+v\n", intervals)
 }
 
@@ -208,3 +208,82 @@ func (this Interval) sameAs(that Interval) bool {
 		this.BlockIndex == that.BlockIndex &&
 		this.Size == that.Size
 }
+
+func TestEncodingRebuildingWithEcScheme(t *testing.T) {
+	scheme, err := ParseEcScheme("4+2")
+	if err != nil {
+		t.Fatalf("parse ec scheme: %v", err)
+	}
+	baseFileName := t.TempDir() + "/1"
+
+	datData := make([]byte, 3*4*largeBlockSize+1234)
+	rand.Read(datData)
+	if err := os.WriteFile(baseFileName+".dat", datData, 0644); err != nil {
+		t.Fatalf("write dat file: %v", err)
+	}
+
+	if err := generateEcFiles(baseFileName, scheme, 50, largeBlockSize, smallBlockSize); err != nil {
+		t.Fatalf("generateEcFiles: %v", err)
+	}
+	if _, err := os.Stat(baseFileName + ToExt(scheme.TotalShards())); !os.IsNotExist(err) {
+		t.Fatalf("unexpected shard file %s", ToExt(scheme.TotalShards()))
+	}
+
+	os.Remove(baseFileName + ToExt(1))
+	os.Remove(baseFileName + ToExt(4))
+	generatedShardIds, err := generateMissingEcFiles(baseFileName, scheme, 50, largeBlockSize, smallBlockSize)
+	if err != nil {
+		t.Fatalf("generateMissingEcFiles: %v", err)
+	}
+	if fmt.Sprint(generatedShardIds) != "[1 4]" {
+		t.Fatalf("generated shards %v, expected [1 4]", generatedShardIds)
+	}
+
+	ecFiles, err := openEcFiles(baseFileName, scheme, true)
+	if err != nil {
+		t.Fatalf("open ec files: %v", err)
+	}
+	defer closeEcFiles(ecFiles)
+
+	for i := 0; i < 100; i++ {
+		offset := rand.Int63n(int64(len(datData)))
+		size := types.Size(rand.Int63n(int64(len(datData))-offset) + 1)
+		var ecData []byte
+		for _, interval := range LocateData(scheme.DataShards, largeBlockSize, smallBlockSize, int64(len(datData)), offset, size) {
+			shardId, shardOffset := interval.ToShardIdAndOffset(largeBlockSize, smallBlockSize)
+			data := make([]byte, interval.Size)
+			if err := readFromFile(ecFiles[shardId], data, shardOffset); err != nil {
+				t.Fatalf("read shard %d at %d: %v", shardId, shardOffset, err)
+			}
+			ecData = append(ecData, data...)
+		}
+		if !bytes.Equal(datData[offset:offset+int64(size)], ecData) {
+			t.Fatalf("data mismatch at offset %d size %d", offset, size)
+		}
+	}
+}
+
+func TestParseEcScheme(t *testing.T) {
+	for _, tc := range []struct {
+		input    string
+		expected EcScheme
+		hasError bool
+	}{
+		{"", DefaultEcScheme, false},
+		{"10+4", DefaultEcScheme, false},
+		{"6+3", EcScheme{DataShards: 6, ParityShards: 3}, false},
+		{"12+4", EcScheme{DataShards: 12, ParityShards: 4}, false},
+		{"4", EcScheme{}, true},
+		{"4+0", EcScheme{}, true},
+		{"30+4", EcScheme{}, true},
+	} {
+		scheme, err := ParseEcScheme(tc.input)
+		if (err != nil) != tc.hasError {
+			t.Errorf("ParseEcScheme(%q) error: %v", tc.input, err)
+			continue
+		}
+		if !tc.hasError && scheme != tc.expected {
+			t.Errorf("ParseEcScheme(%q) = %v, expected %v", tc.input, scheme, tc.expected)
+		}
+	}
+}
@@ -36,6 +36,9 @@ type EcVolume struct {
 	ShardLocationsRefreshTime time.Time
 	ShardLocationsLock        sync.RWMutex
 	Version                   needle.Version
+	Scheme                    EcScheme
+	Encryption                *volume_server_pb.VolumeEncryption // wrapped data key, if the volume is encrypted
+	DataKey                   []byte
 	ecjFile                   *os.File
 	ecjFileAccessLock         sync.Mutex
 	diskType                  types.DiskType
@@ -66,8 +69,11 @@ func NewEcVolume(diskType types.DiskType, dir string, dirIdx string, collection
 
 	// read volume info
 	ev.Version = needle.Version3
+	ev.Scheme = DefaultEcScheme
 	if volumeInfo, _, found, _ := volume_info.MaybeLoadVolumeInfo(dataBaseFileName + ".vif"); found {
 		ev.Version = needle.Version(volumeInfo.Version)
+		ev.Scheme = EcSchemeFromConfig(volumeInfo.EcShardConfig)
+		ev.Encryption = volumeInfo.Encryption
 	} else {
 		volume_info.SaveVolumeInfo(dataBaseFileName+".vif", &volume_server_pb.VolumeInfo{Version: uint32(ev.Version)})
 	}
@@ -183,6 +189,10 @@ func (ev *EcVolume) CreatedAt() time.Time {
 	return ev.ecxCreatedAt
 }
 
+func (ev *EcVolume) DiskType() types.DiskType {
+	return ev.diskType
+}
+
 func (ev *EcVolume) ShardIdList() (shardIds []ShardId) {
 	for _, s := range ev.Shards {
 		shardIds = append(shardIds, s.ShardId)
@@ -196,9 +206,11 @@ func (ev *EcVolume) ToVolumeEcShardInformationMessage() (messages []*master_pb.V
 	for _, s := range ev.Shards {
 		if s.VolumeId != prevVolumeId {
 			m = &master_pb.VolumeEcShardInformationMessage{
-				Id:         uint32(s.VolumeId),
-				Collection: s.Collection,
-				DiskType:   string(ev.diskType),
+				Id:           uint32(s.VolumeId),
+				Collection:   s.Collection,
+				DiskType:     string(ev.diskType),
+				DataShards:   uint32(ev.Scheme.DataShards),
+				ParityShards: uint32(ev.Scheme.ParityShards),
 			}
 			messages = append(messages, m)
 		}
@@ -223,11 +235,16 @@ func (ev *EcVolume) LocateEcShardNeedle(needleId types.NeedleId, version needle.
 func (ev *EcVolume) LocateEcShardNeedleInterval(version needle.Version, offset int64, size types.Size) (intervals []Interval) {
 	shard := ev.Shards[0]
 	// calculate the locations in the ec shards
-	intervals = LocateData(ErasureCodingLargeBlockSize, ErasureCodingSmallBlockSize, DataShardsCount*shard.ecdFileSize, offset, types.Size(needle.GetActualSize(size, version)))
+	intervals = LocateData(ev.Scheme.DataShards, ErasureCodingLargeBlockSize, ErasureCodingSmallBlockSize, int64(ev.Scheme.DataShards)*shard.ecdFileSize, offset, types.Size(needle.GetActualSize(size, version)))
 
 	return
 }
 
+// WalkIndex iterates all entries of the sorted .ecx file
+func (ev *EcVolume) WalkIndex(processNeedleFn func(key types.NeedleId, offset types.Offset, size types.Size) error) error {
+	return idx.WalkIndexFile(ev.ecxFile, 0, processNeedleFn)
+}
+
 func (ev *EcVolume) FindNeedleFromEcx(needleId types.NeedleId) (offset types.Offset, size types.Size, err error) {
 	return SearchNeedleFromSortedIndex(ev.ecxFile, ev.ecxFileSize, needleId, nil)
 }
@@ -13,6 +13,7 @@ import (
 	"github.com/gateway-dao/seaweedfs/weed/images"
 	"github.com/gateway-dao/seaweedfs/weed/stats"
 	. "github.com/gateway-dao/seaweedfs/weed/storage/types"
+	"github.com/gateway-dao/seaweedfs/weed/util"
 )
 
 const (
@@ -51,9 +52,9 @@ func (n *Needle) String() (str string) {
 	return
 }
 
-func CreateNeedleFromRequest(r *http.Request, fixJpgOrientation bool, sizeLimit int64, bytesBuffer *bytes.Buffer) (n *Needle, originalSize int, contentMd5 string, contentHash stats.Hash, e error) {
+func CreateNeedleFromRequest(r *http.Request, fixJpgOrientation bool, sizeLimit int64, bytesBuffer *bytes.Buffer, compress util.Compressor) (n *Needle, originalSize int, contentMd5 string, contentHash stats.Hash, e error) {
 	n = new(Needle)
-	pu, e := ParseUpload(r, sizeLimit, bytesBuffer)
+	pu, e := ParseUpload(r, sizeLimit, bytesBuffer, compress)
 	if e != nil {
 		return
 	}
@@ -0,0 +1,149 @@
+package needle
+
+import (
+	"bytes"
+	"fmt"
+	"net/http"
+	"strings"
+	"time"
+
+	. "github.com/gateway-dao/seaweedfs/weed/storage/types"
+	"github.com/gateway-dao/seaweedfs/weed/util"
+)
+
+/*
+Data appended to an existing file id is written as a continuation needle with
+the same id and cookie, linked to the needle it continues, and the needle map
+points to the newest needle of the chain. Needles already written are never
+changed, so a crash while appending only loses the unacknowledged append.
+
+All flag bits are taken, so the link is kept in the pairs of the continuation
+needle. User pairs are always a json object, so a link is told apart by its
+leading zero byte. Reads follow the links back to the head needle, which keeps
+the name, mime type and pairs of the file.
+*/
+
+const (
+	// MaxAppendChainLength limits the continuation needles a read has to follow.
+	// Longer chains are flattened into a single needle.
+	MaxAppendChainLength = 32
+
+	appendLinkMarker = 0x00
+	appendLinkSize   = 1 + 8 + SizeSize + 2 + 8
+)
+
+// AppendLink points a continuation needle to the needle it continues.
+type AppendLink struct {
+	PrevOffset int64  // actual offset of the previous needle in the volume
+	PrevSize   Size   // size of the previous needle
+	Depth      uint16 // continuation needles in the chain, including this one
+	DataOffset uint64 // file offset of the data in this needle, the size of the data before it
+}
+
+func (n *Needle) IsAppendContinuation() bool {
+	return n.HasPairs() && len(n.Pairs) == appendLinkSize && n.Pairs[0] == appendLinkMarker
+}
+
+// GetAppendLink returns the link of a continuation needle.
+func (n *Needle) GetAppendLink() (link AppendLink, ok bool) {
+	if !n.IsAppendContinuation() {
+		return link, false
+	}
+	link.PrevOffset = int64(util.BytesToUint64(n.Pairs[1:9]))
+	link.PrevSize = BytesToSize(n.Pairs[9 : 9+SizeSize])
+	link.Depth = util.BytesToUint16(n.Pairs[9+SizeSize : 11+SizeSize])
+	link.DataOffset = util.BytesToUint64(n.Pairs[11+SizeSize : appendLinkSize])
+	return link, true
+}
+
+// SetAppendLink makes the needle a continuation needle.
+func (n *Needle) SetAppendLink(link AppendLink) {
+	pairs := make([]byte, appendLinkSize)
+	pairs[0] = appendLinkMarker
+	util.Uint64toBytes(pairs[1:9], uint64(link.PrevOffset))
+	SizeToBytes(pairs[9:9+SizeSize], link.PrevSize)
+	util.Uint16toBytes(pairs[9+SizeSize:11+SizeSize], link.Depth)
+	util.Uint64toBytes(pairs[11+SizeSize:appendLinkSize], link.DataOffset)
+	n.Pairs = pairs
+	n.PairsSize = appendLinkSize
+	n.SetHasPairs()
+}
+
+// ResolveAppendChain turns a continuation needle into the whole file: the data of
+// all needles in the chain, with the metadata of the head needle. The previous needles
+// are read by readNeedle, with their data decrypted. Other needles are left unchanged.
+func (n *Needle) ResolveAppendChain(readNeedle func(offset int64, size Size) (*Needle, error)) error {
+	if !n.IsAppendContinuation() {
+		return nil
+	}
+
+	segments := [][]byte{n.Data}
+	dataSize := len(n.Data)
+	current := n
+	for steps := 0; current.IsAppendContinuation(); steps++ {
+		if steps >= MaxAppendChainLength {
+			return fmt.Errorf("needle %s: append chain longer than %d", n.Id, MaxAppendChainLength)
+		}
+		link, _ := current.GetAppendLink()
+		prev, err := readNeedle(link.PrevOffset, link.PrevSize)
+		if err != nil {
+			return fmt.Errorf("needle %s: read appended needle at %d: %v", n.Id, link.PrevOffset, err)
+		}
+		if prev.Id != n.Id || prev.Cookie != n.Cookie {
+			return fmt.Errorf("needle %s: broken append chain at %d, found %s", n.Id, link.PrevOffset, formatNeedleIdCookie(prev.Id, prev.Cookie))
+		}
+		segments = append(segments, prev.Data)
+		dataSize += len(prev.Data)
+		current = prev
+	}
+
+	data := make([]byte, 0, dataSize)
+	for i := len(segments) - 1; i >= 0; i-- {
+		data = append(data, segments[i]...)
+	}
+
+	head := current
+	hasLastModified := n.HasLastModifiedDate()
+	n.Flags = head.Flags
+	n.Name, n.NameSize = head.Name, head.NameSize
+	n.Mime, n.MimeSize = head.Mime, head.MimeSize
+	n.Pairs, n.PairsSize = head.Pairs, head.PairsSize
+	n.Ttl = head.Ttl
+	if hasLastModified {
+		// appending modifies the file
+		n.SetHasLastModifiedDate()
+	}
+	n.Data = data
+	n.DataSize = uint32(len(data))
+	n.Checksum = NewCRC(data)
+	return nil
+}
+
+// CreateAppendNeedleFromRequest parses the data to append to the file id in the request path.
+// Only n.Id, n.Cookie, n.Data and n.LastModified are set.
+func CreateAppendNeedleFromRequest(r *http.Request, sizeLimit int64, bytesBuffer *bytes.Buffer) (n *Needle, contentMd5 string, e error) {
+	pu, e := ParseUpload(r, sizeLimit, bytesBuffer, nil)
+	if e != nil {
+		return
+	}
+
+	// appended data is stored uncompressed, to be concatenated with the file
+	n = new(Needle)
+	n.Data = pu.UncompressedData
+	n.LastModified = pu.ModifiedTime
+	if n.LastModified == 0 {
+		n.LastModified = uint64(time.Now().Unix())
+	}
+	contentMd5 = pu.ContentMd5
+
+	commaSep := strings.LastIndex(r.URL.Path, ",")
+	dotSep := strings.LastIndex(r.URL.Path, ".")
+	fid := r.URL.Path[commaSep+1:]
+	if dotSep > 0 {
+		fid = r.URL.Path[commaSep+1 : dotSep]
+	}
+
+	e = n.ParsePath(fid)
+
+	return
+}
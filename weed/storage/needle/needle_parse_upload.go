@@ -33,7 +33,9 @@ type ParsedUpload struct {
 	ContentMd5       string
 }
 
-func ParseUpload(r *http.Request, sizeLimit int64, bytesBuffer *bytes.Buffer) (pu *ParsedUpload, e error) {
+// ParseUpload parses the uploaded file. Compressible data not compressed by the
+// client is compressed by compress, or gzipped if compress is nil.
+func ParseUpload(r *http.Request, sizeLimit int64, bytesBuffer *bytes.Buffer, compress util.Compressor) (pu *ParsedUpload, e error) {
 	bytesBuffer.Reset()
 	pu = &ParsedUpload{bytesBuffer: bytesBuffer}
 	pu.PairMap = make(map[string]string)
@@ -84,7 +86,10 @@ func ParseUpload(r *http.Request, sizeLimit int64, bytesBuffer *bytes.Buffer) (p
 		}
 		if shouldBeCompressed, iAmSure := util.IsCompressableFileType(ext, mimeType); shouldBeCompressed && iAmSure {
 			// println("ext", ext, "iAmSure", iAmSure, "shouldBeCompressed", shouldBeCompressed, "mimeType", pu.MimeType)
-			if compressedData, err := util.GzipData(pu.Data); err == nil {
+			if compress == nil {
+				compress = util.GzipData
+			}
+			if compressedData, err := compress(pu.Data); err == nil {
 				if len(compressedData)*10 < len(pu.Data)*9 {
 					pu.Data = compressedData
 					pu.IsGzipped = true
@@ -18,6 +18,7 @@ const (
 	FlagHasLastModifiedDate = 0x08
 	FlagHasTtl              = 0x10
 	FlagHasPairs            = 0x20
+	FlagIsEncrypted         = 0x40
 	FlagIsChunkManifest     = 0x80
 	LastModifiedBytesLength = 5
 	TtlBytesLength          = 2
@@ -25,6 +26,12 @@ const (
 
 var ErrorSizeMismatch = errors.New("size mismatch")
 var ErrorSizeInvalid = errors.New("size invalid")
+var ErrorCrcMismatch = errors.New("CRC error! Data On Disk Corrupted")
+
+// IsCorrupted tells whether a read error means the needle bytes on disk do not match the index.
+func IsCorrupted(err error) bool {
+	return errors.Is(err, ErrorCrcMismatch) || errors.Is(err, ErrorSizeMismatch)
+}
 
 func (n *Needle) DiskSize(version Version) int64 {
 	return GetActualSize(n.Size, version)
@@ -59,7 +66,7 @@ func (n *Needle) ReadBytes(bytes []byte, offset int64, size Size, version Versio
 			return ErrorSizeMismatch
 		}
 		stats.VolumeServerHandlerCounter.WithLabelValues(stats.ErrorSizeMismatch).Inc()
-		return fmt.Errorf("entry not found: offset %d found id %x size %d, expected size %d", offset, n.Id, n.Size, size)
+		return fmt.Errorf("entry not found: offset %d found id %x size %d, expected size %d: %w", offset, n.Id, n.Size, size, ErrorSizeMismatch)
 	}
 	switch version {
 	case Version1:
@@ -76,7 +83,7 @@ func (n *Needle) ReadBytes(bytes []byte, offset int64, size Size, version Versio
 		if checksum != newChecksum.Value() && checksum != uint32(newChecksum) {
 			// the crc.Value() function is to be deprecated. this double checking is for backward compatible.
 			stats.VolumeServerHandlerCounter.WithLabelValues(stats.ErrorCRC).Inc()
-			return errors.New("CRC error! Data On Disk Corrupted")
+			return ErrorCrcMismatch
 		}
 		n.Checksum = newChecksum
 	}
@@ -307,6 +314,14 @@ func (n *Needle) SetHasPairs() {
 	n.Flags = n.Flags | FlagHasPairs
 }
 
+func (n *Needle) IsEncrypted() bool {
+	return n.Flags&FlagIsEncrypted != 0
+}
+
+func (n *Needle) SetIsEncrypted() {
+	n.Flags = n.Flags | FlagIsEncrypted
+}
+
 func GetActualSize(size Size, version Version) int64 {
 	return NeedleHeaderSize + NeedleBodyLength(size, version)
 }
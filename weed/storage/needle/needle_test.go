@@ -1,6 +1,8 @@
 package needle
 
 import (
+	"bytes"
+	"errors"
 	"testing"
 
 	"github.com/gateway-dao/seaweedfs/weed/storage/types"
@@ -47,3 +49,29 @@ func BenchmarkParseKeyHash(b *testing.B) {
 		ParseNeedleIdCookie("4ed44ed44ed44ed4c8116e41")
 	}
 }
+
+func TestReadBytesCorrupted(t *testing.T) {
+	n := &Needle{Id: 0x123, Cookie: 0x456, Data: []byte("hello world")}
+	n.Checksum = NewCRC(n.Data)
+	buf := new(bytes.Buffer)
+	if _, _, err := n.prepareWriteBuffer(Version3, buf); err != nil {
+		t.Fatalf("prepare write buffer: %v", err)
+	}
+	size := n.Size
+	blob := buf.Bytes()
+
+	if err := new(Needle).ReadBytes(blob, 0, size, Version3); err != nil {
+		t.Fatalf("read bytes: %v", err)
+	}
+
+	blob[types.NeedleHeaderSize+5] ^= 0xff
+	if err := new(Needle).ReadBytes(blob, 0, size, Version3); !IsCorrupted(err) {
+		t.Errorf("flipped data byte: expected a corruption error, got %v", err)
+	}
+	if err := new(Needle).ReadBytes(blob, 0, size+8, Version3); !IsCorrupted(err) {
+		t.Errorf("wrong size: expected a corruption error, got %v", err)
+	}
+	if IsCorrupted(nil) || IsCorrupted(errors.New("not found")) {
+		t.Errorf("unrelated errors should not be corruption")
+	}
+}
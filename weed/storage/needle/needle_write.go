@@ -138,6 +138,15 @@ func (n *Needle) Append(w backend.BackendStorageFile, version Version) (offset u
 	return offset, size, actualSize, err
 }
 
+// ToBlob returns the needle bytes as written in the volume file.
+func (n *Needle) ToBlob(version Version) ([]byte, error) {
+	var bytesBuffer bytes.Buffer
+	if _, _, err := n.prepareWriteBuffer(version, &bytesBuffer); err != nil {
+		return nil, err
+	}
+	return bytesBuffer.Bytes(), nil
+}
+
 func WriteNeedleBlob(w backend.BackendStorageFile, dataSlice []byte, size Size, appendAtNs uint64, version Version) (offset uint64, err error) {
 
 	if end, _, e := w.GetStat(); e == nil {
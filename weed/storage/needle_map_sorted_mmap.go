@@ -0,0 +1,386 @@
+package storage
+
+import (
+	"bufio"
+	"fmt"
+	"io"
+	"os"
+	"sort"
+
+	"github.com/gateway-dao/seaweedfs/weed/glog"
+	"github.com/gateway-dao/seaweedfs/weed/storage/erasure_coding"
+	"github.com/gateway-dao/seaweedfs/weed/storage/idx"
+	"github.com/gateway-dao/seaweedfs/weed/storage/needle_map"
+	. "github.com/gateway-dao/seaweedfs/weed/storage/types"
+	"github.com/gateway-dao/seaweedfs/weed/util"
+)
+
+/*
+MmapSortedNeedleMap is the needle map of readonly volumes. It memory maps the
+sorted .sdx index, and a .sdm file next to it, with
+
+	header: the needle map metrics, and the .idx file size they were built from
+	fences: the first needle id of every block of sortedFenceStride entries
+	bloom:  a bloom filter of all needle ids in the .sdx file
+
+Opening takes constant time, as nothing is read until it is looked up. A
+lookup of a missing needle usually stops at the bloom filter, and a found
+needle touches one block of the .sdx file, found with the fence pointers.
+
+Deletions are marked in the .sdx file, and the metrics in the header are
+written back when the map is closed, so the .sdm file stays fresh.
+*/
+type MmapSortedNeedleMap struct {
+	baseNeedleMapper
+	baseFileName string
+
+	sdxFile *os.File
+	sdmFile *os.File
+	sdx     []byte
+	sdm     []byte
+
+	entryCount  int64
+	fenceStride int64
+	fences      []byte
+	bloom       []byte
+	bloomHashes uint32
+	dirty       bool
+}
+
+const (
+	sortedMetaMagic      = "SDM\x01"
+	sortedMetaHeaderSize = 64
+	sortedFenceStride    = 256 // 4KB blocks of .sdx entries
+	sortedBloomBitsPerId = 10  // about 1% false positives
+	sortedBloomHashes    = 7
+)
+
+func NewMmapSortedNeedleMap(indexBaseFileName string, indexFile *os.File) (m *MmapSortedNeedleMap, err error) {
+	if !isSortedMetaFresh(indexBaseFileName, indexFile) {
+		glog.V(0).Infof("Start to Generate %s.sdx and .sdm from %s", indexBaseFileName, indexFile.Name())
+		if err = writeSortedIndexWithMeta(indexBaseFileName, indexFile); err != nil {
+			return nil, err
+		}
+		glog.V(0).Infof("Finished Generating %s.sdx and .sdm from %s", indexBaseFileName, indexFile.Name())
+	}
+
+	m = &MmapSortedNeedleMap{baseFileName: indexBaseFileName}
+	if err = m.open(); err != nil {
+		m.unmap()
+		return nil, fmt.Errorf("open sorted index %s: %v", indexBaseFileName, err)
+	}
+	m.indexFile = indexFile
+	stat, err := indexFile.Stat()
+	if err != nil {
+		m.unmap()
+		return nil, fmt.Errorf("stat %s: %v", indexFile.Name(), err)
+	}
+	m.indexFileOffset = stat.Size()
+	return m, nil
+}
+
+func (m *MmapSortedNeedleMap) open() (err error) {
+	if m.sdxFile, err = os.OpenFile(m.baseFileName+".sdx", os.O_RDWR, 0); err != nil {
+		return
+	}
+	if m.sdmFile, err = os.OpenFile(m.baseFileName+".sdm", os.O_RDWR, 0); err != nil {
+		return
+	}
+	if m.sdx, err = mmapFile(m.sdxFile); err != nil {
+		return
+	}
+	if m.sdm, err = mmapFile(m.sdmFile); err != nil {
+		return
+	}
+	if len(m.sdm) < sortedMetaHeaderSize || string(m.sdm[0:4]) != sortedMetaMagic {
+		return fmt.Errorf("unexpected header")
+	}
+
+	m.fenceStride = int64(util.BytesToUint32(m.sdm[4:8]))
+	m.entryCount = int64(util.BytesToUint64(m.sdm[8:16]))
+	m.FileCounter = util.BytesToUint32(m.sdm[24:28])
+	m.DeletionCounter = util.BytesToUint32(m.sdm[28:32])
+	m.FileByteCounter = util.BytesToUint64(m.sdm[32:40])
+	m.DeletionByteCounter = util.BytesToUint64(m.sdm[40:48])
+	m.MaximumFileKey = util.BytesToUint64(m.sdm[48:56])
+	m.bloomHashes = util.BytesToUint32(m.sdm[56:60])
+
+	fenceCount := (m.entryCount + m.fenceStride - 1) / m.fenceStride
+	fencesEnd := sortedMetaHeaderSize + fenceCount*NeedleIdSize
+	if int64(len(m.sdx)) != m.entryCount*NeedleMapEntrySize || int64(len(m.sdm)) <= fencesEnd {
+		return fmt.Errorf("unexpected size of %d entries", m.entryCount)
+	}
+	m.fences = m.sdm[sortedMetaHeaderSize:fencesEnd]
+	m.bloom = m.sdm[fencesEnd:]
+	return nil
+}
+
+// isSortedMetaFresh checks the .sdm file was built from the current .idx file.
+func isSortedMetaFresh(indexBaseFileName string, indexFile *os.File) bool {
+	sdmFile, err := os.Open(indexBaseFileName + ".sdm")
+	if err != nil {
+		return false
+	}
+	defer sdmFile.Close()
+	header := make([]byte, sortedMetaHeaderSize)
+	if _, err = io.ReadFull(sdmFile, header); err != nil || string(header[0:4]) != sortedMetaMagic {
+		return false
+	}
+	sdmStat, sdmStatErr := sdmFile.Stat()
+	indexStat, indexStatErr := indexFile.Stat()
+	sdxStat, sdxStatErr := os.Stat(indexBaseFileName + ".sdx")
+	if sdmStatErr != nil || indexStatErr != nil || sdxStatErr != nil {
+		return false
+	}
+	return util.BytesToUint64(header[16:24]) == uint64(indexStat.Size()) &&
+		util.BytesToUint64(header[8:16])*NeedleMapEntrySize == uint64(sdxStat.Size()) &&
+		!sdmStat.ModTime().Before(indexStat.ModTime())
+}
+
+// writeSortedIndexWithMeta writes the .sdx file from the .idx file, and then
+// the .sdm file from both.
+func writeSortedIndexWithMeta(indexBaseFileName string, indexFile *os.File) error {
+	indexStat, err := indexFile.Stat()
+	if err != nil {
+		return fmt.Errorf("stat %s: %v", indexFile.Name(), err)
+	}
+	if err = erasure_coding.WriteSortedFileFromIdx(indexBaseFileName, ".sdx"); err != nil {
+		return err
+	}
+	mm, err := newNeedleMapMetricFromIndexFile(indexFile)
+	if err != nil {
+		return fmt.Errorf("read metrics from %s: %v", indexFile.Name(), err)
+	}
+
+	sdxFile, err := os.Open(indexBaseFileName + ".sdx")
+	if err != nil {
+		return err
+	}
+	defer sdxFile.Close()
+	sdxStat, err := sdxFile.Stat()
+	if err != nil {
+		return err
+	}
+	entryCount := sdxStat.Size() / NeedleMapEntrySize
+
+	fenceCount := (entryCount + sortedFenceStride - 1) / sortedFenceStride
+	bloomBytes := (entryCount*sortedBloomBitsPerId + 63) / 64 * 8
+	if bloomBytes == 0 {
+		bloomBytes = 8
+	}
+	meta := make([]byte, sortedMetaHeaderSize+fenceCount*NeedleIdSize+bloomBytes)
+	copy(meta[0:4], sortedMetaMagic)
+	util.Uint32toBytes(meta[4:8], sortedFenceStride)
+	util.Uint64toBytes(meta[8:16], uint64(entryCount))
+	util.Uint64toBytes(meta[16:24], uint64(indexStat.Size()))
+	util.Uint32toBytes(meta[24:28], mm.FileCounter)
+	util.Uint32toBytes(meta[28:32], mm.DeletionCounter)
+	util.Uint64toBytes(meta[32:40], mm.FileByteCounter)
+	util.Uint64toBytes(meta[40:48], mm.DeletionByteCounter)
+	util.Uint64toBytes(meta[48:56], mm.MaximumFileKey)
+	util.Uint32toBytes(meta[56:60], sortedBloomHashes)
+	fences := meta[sortedMetaHeaderSize : sortedMetaHeaderSize+fenceCount*NeedleIdSize]
+	bloom := meta[sortedMetaHeaderSize+fenceCount*NeedleIdSize:]
+
+	reader := bufio.NewReaderSize(sdxFile, 1024*1024)
+	entry := make([]byte, NeedleMapEntrySize)
+	for i := int64(0); i < entryCount; i++ {
+		if _, err = io.ReadFull(reader, entry); err != nil {
+			return fmt.Errorf("read %s.sdx: %v", indexBaseFileName, err)
+		}
+		key, _, _ := idx.IdxFileEntry(entry)
+		if i%sortedFenceStride == 0 {
+			NeedleIdToBytes(fences[i/sortedFenceStride*NeedleIdSize:], key)
+		}
+		bloomAdd(bloom, sortedBloomHashes, key)
+	}
+
+	// the .sdm file is complete, or missing
+	tmpFileName := indexBaseFileName + ".sdm.tmp"
+	if err = os.WriteFile(tmpFileName, meta, 0644); err != nil {
+		return err
+	}
+	return os.Rename(tmpFileName, indexBaseFileName+".sdm")
+}
+
+func bloomHashes(key NeedleId) (h1, h2 uint64) {
+	h1 = splitMix64(uint64(key))
+	h2 = splitMix64(h1) | 1
+	return
+}
+
+func splitMix64(z uint64) uint64 {
+	z += 0x9e3779b97f4a7c15
+	z = (z ^ (z >> 30)) * 0xbf58476d1ce4e5b9
+	z = (z ^ (z >> 27)) * 0x94d049bb133111eb
+	return z ^ (z >> 31)
+}
+
+func bloomAdd(bloom []byte, hashes uint32, key NeedleId) {
+	bits := uint64(len(bloom)) * 8
+	h1, h2 := bloomHashes(key)
+	for i := uint64(0); i < uint64(hashes); i++ {
+		bit := (h1 + i*h2) % bits
+		bloom[bit/8] |= 1 << (bit % 8)
+	}
+}
+
+func bloomMayContain(bloom []byte, hashes uint32, key NeedleId) bool {
+	bits := uint64(len(bloom)) * 8
+	h1, h2 := bloomHashes(key)
+	for i := uint64(0); i < uint64(hashes); i++ {
+		bit := (h1 + i*h2) % bits
+		if bloom[bit/8]&(1<<(bit%8)) == 0 {
+			return false
+		}
+	}
+	return true
+}
+
+func (m *MmapSortedNeedleMap) entry(i int64) []byte {
+	return m.sdx[i*NeedleMapEntrySize : (i+1)*NeedleMapEntrySize]
+}
+
+// search returns the index of the key in the .sdx file
+func (m *MmapSortedNeedleMap) search(key NeedleId) (int64, bool) {
+	if m.entryCount == 0 || !bloomMayContain(m.bloom, m.bloomHashes, key) {
+		return 0, false
+	}
+	fenceCount := len(m.fences) / NeedleIdSize
+	block := sort.Search(fenceCount, func(i int) bool {
+		return BytesToNeedleId(m.fences[i*NeedleIdSize:(i+1)*NeedleIdSize]) > key
+	}) - 1
+	if block < 0 {
+		return 0, false
+	}
+	start := int64(block) * m.fenceStride
+	end := start + m.fenceStride
+	if end > m.entryCount {
+		end = m.entryCount
+	}
+	i := start + int64(sort.Search(int(end-start), func(i int) bool {
+		return BytesToNeedleId(m.entry(start + int64(i))[:NeedleIdSize]) >= key
+	}))
+	if i < end && BytesToNeedleId(m.entry(i)[:NeedleIdSize]) == key {
+		return i, true
+	}
+	return 0, false
+}
+
+func (m *MmapSortedNeedleMap) Get(key NeedleId) (element *needle_map.NeedleValue, ok bool) {
+	i, found := m.search(key)
+	if !found {
+		return nil, false
+	}
+	_, offset, size := idx.IdxFileEntry(m.entry(i))
+	return &needle_map.NeedleValue{Key: key, Offset: offset, Size: size}, true
+}
+
+func (m *MmapSortedNeedleMap) Put(key NeedleId, offset Offset, size Size) error {
+	return os.ErrInvalid
+}
+
+func (m *MmapSortedNeedleMap) Delete(key NeedleId, offset Offset) error {
+	i, found := m.search(key)
+	if !found {
+		return nil
+	}
+	_, _, size := idx.IdxFileEntry(m.entry(i))
+	if size.IsDeleted() {
+		return nil
+	}
+
+	// write to index file first
+	if err := m.appendToIndexFile(key, offset, TombstoneFileSize); err != nil {
+		return err
+	}
+	// the mapped .sdx file sees the write
+	if err := erasure_coding.MarkNeedleDeleted(m.sdxFile, i*NeedleMapEntrySize); err != nil {
+		return err
+	}
+	m.logDelete(size)
+	m.dirty = true
+	return nil
+}
+
+// writeMetrics updates the header of the .sdm file after deletions.
+func (m *MmapSortedNeedleMap) writeMetrics() error {
+	header := make([]byte, sortedMetaHeaderSize-16)
+	util.Uint64toBytes(header[0:8], uint64(m.IndexFileSize()))
+	util.Uint32toBytes(header[8:12], uint32(m.FileCount()))
+	util.Uint32toBytes(header[12:16], uint32(m.DeletedCount()))
+	util.Uint64toBytes(header[16:24], m.ContentSize())
+	util.Uint64toBytes(header[24:32], m.DeletedSize())
+	util.Uint64toBytes(header[32:40], uint64(m.MaxFileKey()))
+	if err := m.indexFile.Sync(); err != nil {
+		return err
+	}
+	if err := m.sdxFile.Sync(); err != nil {
+		return err
+	}
+	_, err := m.sdmFile.WriteAt(header, 16)
+	return err
+}
+
+func (m *MmapSortedNeedleMap) unmap() {
+	if m.sdx != nil {
+		munmapFile(m.sdx)
+		m.sdx = nil
+	}
+	if m.sdm != nil {
+		munmapFile(m.sdm)
+		m.sdm = nil
+	}
+	if m.sdxFile != nil {
+		m.sdxFile.Close()
+	}
+	if m.sdmFile != nil {
+		m.sdmFile.Close()
+	}
+}
+
+func (m *MmapSortedNeedleMap) Close() {
+	if m == nil {
+		return
+	}
+	if m.dirty {
+		if err := m.writeMetrics(); err != nil {
+			glog.Warningf("update %s.sdm: %v", m.baseFileName, err)
+		}
+		m.dirty = false
+	}
+	m.unmap()
+	if m.indexFile != nil {
+		m.indexFile.Close()
+	}
+}
+
+func (m *MmapSortedNeedleMap) Destroy() error {
+	m.Close()
+	os.Remove(m.indexFile.Name())
+	os.Remove(m.baseFileName + ".sdm")
+	return os.Remove(m.baseFileName + ".sdx")
+}
+
+// newReadonlyNeedleMap memory maps the sorted index of a readonly volume, or
+// reads it from the file where memory mapping is not available.
+func newReadonlyNeedleMap(indexBaseFileName string, indexFile *os.File) (NeedleMapper, error) {
+	m, err := NewMmapSortedNeedleMap(indexBaseFileName, indexFile)
+	if err == nil {
+		return m, nil
+	}
+	glog.V(0).Infof("memory map sorted index %s: %v", indexBaseFileName, err)
+	sm, err := NewSortedFileNeedleMap(indexBaseFileName, indexFile)
+	if err != nil {
+		return nil, err
+	}
+	return sm, nil
+}
+
+func isReadonlyNeedleMap(nm NeedleMapper) bool {
+	switch nm.(type) {
+	case *MmapSortedNeedleMap, *SortedFileNeedleMap:
+		return true
+	}
+	return false
+}
@@ -0,0 +1,18 @@
+//go:build !unix
+// +build !unix
+
+package storage
+
+import (
+	"fmt"
+	"os"
+	"runtime"
+)
+
+func mmapFile(f *os.File) ([]byte, error) {
+	return nil, fmt.Errorf("memory mapped sorted index is not supported on %s", runtime.GOOS)
+}
+
+func munmapFile(data []byte) error {
+	return nil
+}
@@ -0,0 +1,139 @@
+package storage
+
+import (
+	"bytes"
+	"os"
+	"testing"
+	"time"
+
+	"github.com/gateway-dao/seaweedfs/weed/storage/needle"
+	"github.com/gateway-dao/seaweedfs/weed/storage/super_block"
+	"github.com/gateway-dao/seaweedfs/weed/storage/types"
+)
+
+func TestMmapSortedNeedleMap(t *testing.T) {
+	dir := t.TempDir()
+	v, err := NewVolume(dir, dir, "", 1, NeedleMapInMemory, &super_block.ReplicaPlacement{}, &needle.TTL{}, 0, 0, 0)
+	if err != nil {
+		t.Fatalf("volume creation: %v", err)
+	}
+	defer v.Close()
+
+	needles := make(map[uint64]*needle.Needle)
+	for id := uint64(1); id <= 1000; id++ {
+		n := newRandomNeedle(id * 3)
+		// empty needles are not found in the memory needle map
+		n.Data = append(n.Data, 'x')
+		n.Checksum = needle.NewCRC(n.Data)
+		if _, _, _, err = v.writeNeedle2(n, true, false); err != nil {
+			t.Fatalf("write: %v", err)
+		}
+		needles[id*3] = n
+	}
+	for id := uint64(3); id <= 300; id += 3 {
+		if _, err = v.deleteNeedle2(newEmptyNeedle(id)); err != nil {
+			t.Fatalf("delete: %v", err)
+		}
+		delete(needles, id)
+	}
+	// the same metrics as scanning the index file, like the other readonly needle maps
+	indexFile, err := os.Open(v.FileName(".idx"))
+	if err != nil {
+		t.Fatalf("open index: %v", err)
+	}
+	mm, err := newNeedleMapMetricFromIndexFile(indexFile)
+	indexFile.Close()
+	if err != nil {
+		t.Fatalf("index metrics: %v", err)
+	}
+	fileCount, deletedCount, contentSize, deletedSize := uint64(mm.FileCount()), uint64(mm.DeletedCount()), mm.ContentSize(), mm.DeletedSize()
+
+	v.noWriteOrDelete = true
+	if err = v.useReadonlyNeedleMap(); err != nil {
+		t.Fatalf("use readonly needle map: %v", err)
+	}
+	if _, ok := v.nm.(*MmapSortedNeedleMap); !ok {
+		t.Skipf("memory mapped sorted index is not available, using %T", v.nm)
+	}
+	if v.FileCount() != fileCount || v.DeletedCount() != deletedCount || v.ContentSize() != contentSize || v.DeletedSize() != deletedSize {
+		t.Fatalf("metrics changed: %d %d %d %d", v.FileCount(), v.DeletedCount(), v.ContentSize(), v.DeletedSize())
+	}
+
+	checkReads := func() {
+		for id := uint64(0); id <= 3010; id++ {
+			r := newEmptyNeedle(id)
+			_, err := v.readNeedle(r, nil, nil)
+			if n, found := needles[id]; found {
+				if err != nil || !bytes.Equal(r.Data, n.Data) {
+					t.Fatalf("read needle %d: %v", id, err)
+				}
+			} else if err == nil {
+				t.Fatalf("needle %d should not be found", id)
+			}
+		}
+	}
+	checkReads()
+
+	// deletions are kept in the sorted index, and the metrics on close
+	v.noWriteOrDelete = false
+	v.noWriteCanDelete = true
+	if _, err = v.deleteNeedle2(newEmptyNeedle(303)); err != nil {
+		t.Fatalf("delete from sorted index: %v", err)
+	}
+	delete(needles, 303)
+	deletedCount = v.DeletedCount()
+	v.nm.Close()
+
+	indexFile, err = os.OpenFile(v.FileName(".idx"), os.O_RDWR, 0644)
+	if err != nil {
+		t.Fatalf("open index: %v", err)
+	}
+	sdxStat, _ := os.Stat(v.FileName(".sdx"))
+	if !isSortedMetaFresh(v.IndexFileName(), indexFile) {
+		t.Fatalf("sorted index should be fresh after deletion")
+	}
+	time.Sleep(10 * time.Millisecond)
+	if v.nm, err = newReadonlyNeedleMap(v.IndexFileName(), indexFile); err != nil {
+		t.Fatalf("reopen: %v", err)
+	}
+	if stat, _ := os.Stat(v.FileName(".sdx")); !stat.ModTime().Equal(sdxStat.ModTime()) {
+		t.Fatalf("fresh sorted index should not be rebuilt")
+	}
+	if v.DeletedCount() != deletedCount || v.FileCount() != fileCount {
+		t.Fatalf("metrics not kept: deleted %d, expected %d", v.DeletedCount(), deletedCount)
+	}
+	checkReads()
+
+	// back to the writable needle map
+	v.noWriteCanDelete = false
+	if err = v.useWritableNeedleMap(); err != nil {
+		t.Fatalf("use writable needle map: %v", err)
+	}
+	if _, ok := v.nm.(*NeedleMap); !ok {
+		t.Fatalf("expected the memory needle map, got %T", v.nm)
+	}
+	n := newRandomNeedle(3003)
+	n.Data = append(n.Data, 'x')
+	n.Checksum = needle.NewCRC(n.Data)
+	if _, _, _, err = v.writeNeedle2(n, true, false); err != nil {
+		t.Fatalf("write after marked writable: %v", err)
+	}
+	needles[3003] = n
+	checkReads()
+}
+
+func TestMmapSortedNeedleMapEmpty(t *testing.T) {
+	dir := t.TempDir()
+	indexFile, err := os.OpenFile(dir+"/1.idx", os.O_RDWR|os.O_CREATE, 0644)
+	if err != nil {
+		t.Fatalf("create index: %v", err)
+	}
+	m, err := NewMmapSortedNeedleMap(dir+"/1", indexFile)
+	if err != nil {
+		t.Skipf("memory mapped sorted index is not available: %v", err)
+	}
+	defer m.Close()
+	if _, ok := m.Get(types.NeedleId(1)); ok || m.FileCount() != 0 {
+		t.Fatalf("empty index should find nothing")
+	}
+}
@@ -0,0 +1,50 @@
+package qos
+
+import (
+	"fmt"
+
+	"github.com/gateway-dao/seaweedfs/weed/pb/master_pb"
+	"github.com/gateway-dao/seaweedfs/weed/util"
+)
+
+const QosPrefix = "master.qos"
+
+// LoadConfiguration reads the I/O budgets of the volume servers from the master configuration.
+// It returns nil if the budgets are not enabled.
+func LoadConfiguration(config *util.ViperProxy) (*master_pb.IoQosConfiguration, error) {
+	if !config.GetBool(QosPrefix + ".enabled") {
+		return nil, nil
+	}
+
+	conf := &master_pb.IoQosConfiguration{
+		ServerReadBytesPerSecond:  uint64(config.GetInt(QosPrefix+".server_read_mbps")) * 1024 * 1024,
+		ServerWriteBytesPerSecond: uint64(config.GetInt(QosPrefix+".server_write_mbps")) * 1024 * 1024,
+	}
+
+	budgetPrefix := QosPrefix + ".budget"
+	for name := range config.GetStringMap(budgetPrefix) {
+		prefix := budgetPrefix + "." + name + "."
+		budget := &master_pb.IoBudget{
+			Name:                name,
+			Collection:          config.GetString(prefix + "collection"),
+			DiskType:            config.GetString(prefix + "disk_type"),
+			Traffic:             config.GetString(prefix + "traffic"),
+			ReadBytesPerSecond:  uint64(config.GetInt(prefix+"read_mbps")) * 1024 * 1024,
+			WriteBytesPerSecond: uint64(config.GetInt(prefix+"write_mbps")) * 1024 * 1024,
+			ReadIops:            uint64(config.GetInt(prefix + "read_iops")),
+			WriteIops:           uint64(config.GetInt(prefix + "write_iops")),
+			Weight:              uint32(config.GetInt(prefix + "weight")),
+		}
+		switch budget.Traffic {
+		case "":
+			budget.Traffic = TrafficClient
+		case TrafficClient, TrafficReplication, TrafficVacuum:
+		default:
+			return nil, fmt.Errorf("io budget %s: unknown traffic %q, expecting %s, %s or %s",
+				name, budget.Traffic, TrafficClient, TrafficReplication, TrafficVacuum)
+		}
+		conf.Budgets = append(conf.Budgets, budget)
+	}
+
+	return conf, nil
+}
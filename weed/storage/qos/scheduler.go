@@ -0,0 +1,298 @@
+package qos
+
+import (
+	"container/heap"
+	"context"
+	"path/filepath"
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/gateway-dao/seaweedfs/weed/pb/master_pb"
+	"github.com/gateway-dao/seaweedfs/weed/stats"
+	"github.com/gateway-dao/seaweedfs/weed/storage/types"
+)
+
+/*
+Scheduler throttles the I/O of a volume server with budgets, matched by
+collection, disk type, and traffic class. A budget limits the read and write
+bytes and operations per second of the requests it matches.
+
+The server wide read and write rates are shared by all budgets with weighted
+fair queuing: when the server is saturated, each budget with waiting requests
+gets bytes in proportion to its weight, using start time fair queuing.
+*/
+type Scheduler struct {
+	sync.RWMutex
+	budgets  []*Budget
+	defaults map[string]*Budget
+	read     *fairQueue
+	write    *fairQueue
+}
+
+const (
+	TrafficClient      = "client"
+	TrafficReplication = "replication"
+	TrafficVacuum      = "vacuum"
+
+	Read  = Op(0)
+	Write = Op(1)
+
+	// allowed bursts above the rates
+	burstWindow = 100 * time.Millisecond
+)
+
+type Op int
+
+func (op Op) String() string {
+	if op == Write {
+		return "write"
+	}
+	return "read"
+}
+
+type Budget struct {
+	Name       string
+	collection string
+	diskType   types.DiskType
+	anyDisk    bool
+	traffic    string
+	weight     float64
+	bytes      [2]*pacer
+	ops        [2]*pacer
+	lastTag    [2]float64 // the last start tag of the fair queues, guarded by the queue locks
+}
+
+func NewScheduler() *Scheduler {
+	s := &Scheduler{}
+	s.Configure(nil)
+	return s
+}
+
+// Configure replaces the budgets. Budgets are matched in the order of names,
+// and requests matching no budget are only limited by the server rates.
+func (s *Scheduler) Configure(conf *master_pb.IoQosConfiguration) {
+	var budgets []*Budget
+	for _, b := range conf.GetBudgets() {
+		budget := &Budget{
+			Name:       b.Name,
+			collection: b.Collection,
+			diskType:   types.ToDiskType(b.DiskType),
+			anyDisk:    b.DiskType == "",
+			traffic:    b.Traffic,
+			weight:     float64(b.Weight),
+		}
+		budget.bytes[Read] = newPacer(float64(b.ReadBytesPerSecond))
+		budget.bytes[Write] = newPacer(float64(b.WriteBytesPerSecond))
+		budget.ops[Read] = newPacer(float64(b.ReadIops))
+		budget.ops[Write] = newPacer(float64(b.WriteIops))
+		if budget.traffic == "" {
+			budget.traffic = TrafficClient
+		}
+		if budget.weight <= 0 {
+			budget.weight = 1
+		}
+		budgets = append(budgets, budget)
+	}
+	sort.Slice(budgets, func(i, j int) bool {
+		return budgets[i].Name < budgets[j].Name
+	})
+
+	defaults := make(map[string]*Budget)
+	for _, traffic := range []string{TrafficClient, TrafficReplication, TrafficVacuum} {
+		defaults[traffic] = &Budget{Name: traffic, traffic: traffic, weight: 1}
+	}
+
+	s.Lock()
+	defer s.Unlock()
+	s.budgets = budgets
+	s.defaults = defaults
+	s.read = newFairQueue(float64(conf.GetServerReadBytesPerSecond()))
+	s.write = newFairQueue(float64(conf.GetServerWriteBytesPerSecond()))
+}
+
+// Classify finds the budget of a request.
+func (s *Scheduler) Classify(collection string, diskType types.DiskType, traffic string) *Budget {
+	s.RLock()
+	defer s.RUnlock()
+	for _, b := range s.budgets {
+		if b.traffic != traffic || (!b.anyDisk && b.diskType != diskType) {
+			continue
+		}
+		if b.collection != "" {
+			if matched, _ := filepath.Match(b.collection, collection); !matched {
+				continue
+			}
+		}
+		return b
+	}
+	if b, found := s.defaults[traffic]; found {
+		return b
+	}
+	return s.defaults[TrafficClient]
+}
+
+// Wait blocks until the budget and the server have room for one operation of n bytes.
+func (s *Scheduler) Wait(ctx context.Context, b *Budget, op Op, n int64) error {
+	start := time.Now()
+	defer func() {
+		stats.VolumeServerIoQosBytesCounter.WithLabelValues(b.Name, op.String()).Add(float64(n))
+		stats.VolumeServerIoQosWaitCounter.WithLabelValues(b.Name, op.String()).Add(time.Since(start).Seconds())
+	}()
+
+	var delay time.Duration
+	if b.ops[op] != nil {
+		delay = b.ops[op].reserve(1)
+	}
+	if b.bytes[op] != nil {
+		if d := b.bytes[op].reserve(n); d > delay {
+			delay = d
+		}
+	}
+	if delay > 0 {
+		timer := time.NewTimer(delay)
+		select {
+		case <-timer.C:
+		case <-ctx.Done():
+			timer.Stop()
+			return ctx.Err()
+		}
+	}
+
+	s.RLock()
+	queue := s.write
+	if op == Read {
+		queue = s.read
+	}
+	s.RUnlock()
+	return queue.wait(ctx, b, op, n)
+}
+
+// pacer is a token bucket, which keeps the time when the next bytes are allowed.
+type pacer struct {
+	sync.Mutex
+	rate float64
+	next time.Time
+}
+
+func newPacer(rate float64) *pacer {
+	if rate <= 0 {
+		return nil
+	}
+	return &pacer{rate: rate}
+}
+
+// reserve takes n tokens, and returns how long to wait for them.
+func (p *pacer) reserve(n int64) time.Duration {
+	p.Lock()
+	defer p.Unlock()
+	now := time.Now()
+	if earliest := now.Add(-burstWindow); p.next.Before(earliest) {
+		p.next = earliest
+	}
+	at := p.next
+	p.next = p.next.Add(time.Duration(float64(n) / p.rate * float64(time.Second)))
+	if at.After(now) {
+		return at.Sub(now)
+	}
+	return 0
+}
+
+type fairRequest struct {
+	tag       float64
+	n         int64
+	ready     chan struct{}
+	cancelled bool
+	index     int
+}
+
+type fairRequests []*fairRequest
+
+func (h fairRequests) Len() int           { return len(h) }
+func (h fairRequests) Less(i, j int) bool { return h[i].tag < h[j].tag }
+func (h fairRequests) Swap(i, j int) {
+	h[i], h[j] = h[j], h[i]
+	h[i].index, h[j].index = i, j
+}
+func (h *fairRequests) Push(x any) {
+	r := x.(*fairRequest)
+	r.index = len(*h)
+	*h = append(*h, r)
+}
+func (h *fairRequests) Pop() any {
+	old := *h
+	r := old[len(old)-1]
+	*h = old[:len(old)-1]
+	return r
+}
+
+// fairQueue serves the waiting requests at the server rate, ordered by their
+// start tags. The tag of a request is the later one of the virtual time and
+// the tag of the previous request of the budget, plus its bytes by weight.
+type fairQueue struct {
+	sync.Mutex
+	pacer       *pacer
+	virtualTime float64
+	waiting     fairRequests
+	dispatching bool
+}
+
+func newFairQueue(rate float64) *fairQueue {
+	return &fairQueue{pacer: newPacer(rate)}
+}
+
+func (q *fairQueue) wait(ctx context.Context, b *Budget, op Op, n int64) error {
+	if q.pacer == nil {
+		return nil
+	}
+	q.Lock()
+	r := q.enqueue(b, op, n)
+	if !q.dispatching {
+		q.dispatching = true
+		go q.dispatch()
+	}
+	q.Unlock()
+
+	select {
+	case <-r.ready:
+		return nil
+	case <-ctx.Done():
+		q.Lock()
+		r.cancelled = true
+		q.Unlock()
+		return ctx.Err()
+	}
+}
+
+func (q *fairQueue) enqueue(b *Budget, op Op, n int64) *fairRequest {
+	start := q.virtualTime
+	if b.lastTag[op] > start {
+		start = b.lastTag[op]
+	}
+	b.lastTag[op] = start + float64(n)/b.weight
+	r := &fairRequest{tag: start, n: n, ready: make(chan struct{})}
+	heap.Push(&q.waiting, r)
+	return r
+}
+
+func (q *fairQueue) dispatch() {
+	for {
+		q.Lock()
+		if q.waiting.Len() == 0 {
+			q.dispatching = false
+			q.Unlock()
+			return
+		}
+		r := heap.Pop(&q.waiting).(*fairRequest)
+		q.virtualTime = r.tag
+		cancelled := r.cancelled
+		q.Unlock()
+		if cancelled {
+			continue
+		}
+		if delay := q.pacer.reserve(r.n); delay > 0 {
+			time.Sleep(delay)
+		}
+		close(r.ready)
+	}
+}
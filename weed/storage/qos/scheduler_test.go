@@ -0,0 +1,134 @@
+package qos
+
+import (
+	"container/heap"
+	"context"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/gateway-dao/seaweedfs/weed/pb/master_pb"
+	"github.com/gateway-dao/seaweedfs/weed/storage/types"
+)
+
+func TestClassify(t *testing.T) {
+	s := NewScheduler()
+	s.Configure(&master_pb.IoQosConfiguration{
+		Budgets: []*master_pb.IoBudget{
+			{Name: "b_ingest", Collection: "ingest*"},
+			{Name: "a_ingest_ssd", Collection: "ingest*", DiskType: "ssd"},
+			{Name: "vacuum", Traffic: TrafficVacuum},
+		},
+	})
+
+	for _, tc := range []struct {
+		collection string
+		diskType   types.DiskType
+		traffic    string
+		expected   string
+	}{
+		{"ingest_logs", types.SsdType, TrafficClient, "a_ingest_ssd"},
+		{"ingest_logs", types.HardDriveType, TrafficClient, "b_ingest"},
+		{"pictures", types.HardDriveType, TrafficClient, TrafficClient},
+		{"ingest_logs", types.HardDriveType, TrafficReplication, TrafficReplication},
+		{"pictures", types.SsdType, TrafficVacuum, "vacuum"},
+	} {
+		if b := s.Classify(tc.collection, tc.diskType, tc.traffic); b.Name != tc.expected {
+			t.Errorf("%s on %s %s: expected budget %s, got %s", tc.collection, tc.diskType, tc.traffic, tc.expected, b.Name)
+		}
+	}
+}
+
+func TestBudgetRate(t *testing.T) {
+	s := NewScheduler()
+	s.Configure(&master_pb.IoQosConfiguration{
+		Budgets: []*master_pb.IoBudget{
+			{Name: "slow", WriteBytesPerSecond: 1000, WriteIops: 1000},
+		},
+	})
+	b := s.Classify("", "", TrafficClient)
+
+	// the burst is allowed, and then the writes are paced
+	start := time.Now()
+	for i := 0; i < 4; i++ {
+		if err := s.Wait(context.Background(), b, Write, 100); err != nil {
+			t.Fatalf("wait: %v", err)
+		}
+	}
+	if elapsed := time.Since(start); elapsed < 150*time.Millisecond {
+		t.Errorf("400 bytes at 1000 bytes per second took only %v", elapsed)
+	}
+
+	// reads are not limited
+	start = time.Now()
+	if err := s.Wait(context.Background(), b, Read, 1<<20); err != nil || time.Since(start) > 50*time.Millisecond {
+		t.Errorf("reads should not wait: %v", err)
+	}
+
+	// waiting is cancelled with the context
+	ctx, cancel := context.WithTimeout(context.Background(), 20*time.Millisecond)
+	defer cancel()
+	if err := s.Wait(ctx, b, Write, 10000); err == nil {
+		t.Errorf("wait should be cancelled")
+	}
+}
+
+func TestWeightedFairQueuing(t *testing.T) {
+	heavy := &Budget{Name: "heavy", weight: 3}
+	light := &Budget{Name: "light", weight: 1}
+	q := newFairQueue(1 << 20)
+
+	// both budgets keep 8 requests waiting
+	budgetOf := make(map[*fairRequest]*Budget)
+	for i := 0; i < 8; i++ {
+		budgetOf[q.enqueue(heavy, Write, 1024)] = heavy
+		budgetOf[q.enqueue(light, Write, 1024)] = light
+	}
+	served := make(map[*Budget]int)
+	for i := 0; i < 400; i++ {
+		r := heap.Pop(&q.waiting).(*fairRequest)
+		q.virtualTime = r.tag
+		b := budgetOf[r]
+		served[b]++
+		budgetOf[q.enqueue(b, Write, 1024)] = b
+	}
+	if served[heavy] != 300 || served[light] != 100 {
+		t.Errorf("expected 300 and 100 requests served by weights, got %d and %d", served[heavy], served[light])
+	}
+
+	// an idle budget does not save up its share
+	idle := &Budget{Name: "idle", weight: 1}
+	r := q.enqueue(idle, Write, 1024)
+	if r.tag != q.virtualTime {
+		t.Errorf("idle budget should start at the virtual time %v, got %v", q.virtualTime, r.tag)
+	}
+}
+
+func TestServerRate(t *testing.T) {
+	s := NewScheduler()
+	s.Configure(&master_pb.IoQosConfiguration{
+		ServerWriteBytesPerSecond: 100 * 1024,
+	})
+	b := s.Classify("", "", TrafficClient)
+
+	ctx, cancel := context.WithTimeout(context.Background(), 300*time.Millisecond)
+	defer cancel()
+	var wg sync.WaitGroup
+	var written int64
+	for i := 0; i < 4; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for s.Wait(ctx, b, Write, 1024) == nil {
+				atomic.AddInt64(&written, 1024)
+			}
+		}()
+	}
+	wg.Wait()
+
+	// 300ms at the rate, and the burst
+	if written > 45*1024 {
+		t.Errorf("written %d bytes, over the server rate", written)
+	}
+}
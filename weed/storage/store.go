@@ -7,8 +7,10 @@ import (
 	"strings"
 	"sync"
 	"sync/atomic"
+	"time"
 
 	"github.com/gateway-dao/seaweedfs/weed/pb"
+	"github.com/gateway-dao/seaweedfs/weed/security"
 	"github.com/gateway-dao/seaweedfs/weed/storage/volume_info"
 	"github.com/gateway-dao/seaweedfs/weed/util"
 
@@ -16,8 +18,9 @@ import (
 
 	"github.com/gateway-dao/seaweedfs/weed/glog"
 	"github.com/gateway-dao/seaweedfs/weed/pb/master_pb"
+	"github.com/gateway-dao/seaweedfs/weed/pb/volume_server_pb"
 	"github.com/gateway-dao/seaweedfs/weed/stats"
-	"github.com/gateway-dao/seaweedfs/weed/storage/erasure_coding"
+	"github.com/gateway-dao/seaweedfs/weed/storage/backend"
 	"github.com/gateway-dao/seaweedfs/weed/storage/needle"
 	"github.com/gateway-dao/seaweedfs/weed/storage/super_block"
 	. "github.com/gateway-dao/seaweedfs/weed/storage/types"
@@ -37,6 +40,8 @@ type ReadOption struct {
 	VolumeRevision uint16
 	IsOutOfRange   bool // whether read over MaxPossibleVolumeSize
 
+	compactedSegments uint64
+
 	// If HasSlowRead is set to true:
 	//  * read requests and write requests compete for the lock.
 	//  * large file read P99 latency on busy sites will go up, due to the need to get locks multiple times.
@@ -72,6 +77,15 @@ type Store struct {
 	NewEcShardsChan     chan master_pb.VolumeEcShardInformationMessage
 	DeletedEcShardsChan chan master_pb.VolumeEcShardInformationMessage
 	isStopping          bool
+	keyWrapper          security.KeyWrapper // encrypts new volumes if set
+	AsyncReplication    *AsyncReplication   // read from the master
+
+	compressionDictionaries     map[string]compressionDictionary // by collection
+	compressionDictionariesLock sync.RWMutex
+
+	writtenBytes          uint64 // updated atomically
+	lastWrittenBytes      uint64
+	lastHeartbeatCollects time.Time
 }
 
 func (s *Store) String() (str string) {
@@ -80,13 +94,14 @@ func (s *Store) String() (str string) {
 }
 
 func NewStore(grpcDialOption grpc.DialOption, ip string, port int, grpcPort int, publicUrl string, dirnames []string, maxVolumeCounts []int32,
-	minFreeSpaces []util.MinFreeSpace, idxFolder string, needleMapKind NeedleMapKind, diskTypes []DiskType, ldbTimeout int64) (s *Store) {
-	s = &Store{grpcDialOption: grpcDialOption, Port: port, Ip: ip, GrpcPort: grpcPort, PublicUrl: publicUrl, NeedleMapKind: needleMapKind}
+	minFreeSpaces []util.MinFreeSpace, idxFolder string, needleMapKind NeedleMapKind, diskTypes []DiskType, diskIos []backend.DiskIoMode, ldbTimeout int64, keyWrapper security.KeyWrapper) (s *Store) {
+	s = &Store{grpcDialOption: grpcDialOption, Port: port, Ip: ip, GrpcPort: grpcPort, PublicUrl: publicUrl, NeedleMapKind: needleMapKind, keyWrapper: keyWrapper,
+		AsyncReplication: NewAsyncReplication(nil)}
 	s.Locations = make([]*DiskLocation, 0)
 
 	var wg sync.WaitGroup
 	for i := 0; i < len(dirnames); i++ {
-		location := NewDiskLocation(dirnames[i], int32(maxVolumeCounts[i]), minFreeSpaces[i], idxFolder, diskTypes[i])
+		location := NewDiskLocation(dirnames[i], int32(maxVolumeCounts[i]), minFreeSpaces[i], idxFolder, diskTypes[i], diskIos[i], keyWrapper)
 		s.Locations = append(s.Locations, location)
 		stats.VolumeServerMaxVolumeCounter.Add(float64(maxVolumeCounts[i]))
 
@@ -98,6 +113,8 @@ func NewStore(grpcDialOption grpc.DialOption, ip string, port int, grpcPort int,
 	}
 	wg.Wait()
 
+	s.loadCompressionDictionaries()
+
 	s.NewVolumesChan = make(chan master_pb.VolumeShortInformationMessage, 3)
 	s.DeletedVolumesChan = make(chan master_pb.VolumeShortInformationMessage, 3)
 
@@ -106,7 +123,7 @@ func NewStore(grpcDialOption grpc.DialOption, ip string, port int, grpcPort int,
 
 	return
 }
-func (s *Store) AddVolume(volumeId needle.VolumeId, collection string, needleMapKind NeedleMapKind, replicaPlacement string, ttlString string, preallocate int64, MemoryMapMaxSizeMb uint32, diskType DiskType, ldbTimeout int64) error {
+func (s *Store) AddVolume(volumeId needle.VolumeId, collection string, needleMapKind NeedleMapKind, replicaPlacement string, ttlString string, preallocate int64, MemoryMapMaxSizeMb uint32, diskType DiskType, ldbTimeout int64, encryption *volume_server_pb.VolumeEncryption) error {
 	rt, e := super_block.NewReplicaPlacementFromString(replicaPlacement)
 	if e != nil {
 		return e
@@ -115,7 +132,7 @@ func (s *Store) AddVolume(volumeId needle.VolumeId, collection string, needleMap
 	if e != nil {
 		return e
 	}
-	e = s.addVolume(volumeId, collection, needleMapKind, rt, ttl, preallocate, MemoryMapMaxSizeMb, diskType, ldbTimeout)
+	e = s.addVolume(volumeId, collection, needleMapKind, rt, ttl, preallocate, MemoryMapMaxSizeMb, diskType, ldbTimeout, encryption)
 	return e
 }
 func (s *Store) DeleteCollection(collection string) (e error) {
@@ -127,6 +144,7 @@ func (s *Store) DeleteCollection(collection string) (e error) {
 		stats.DeleteCollectionMetrics(collection)
 		// let the heartbeat send the list of volumes, instead of sending the deleted volume ids to DeletedVolumesChan
 	}
+	s.deleteCompressionDictionaries(collection)
 	return
 }
 
@@ -147,10 +165,7 @@ func (s *Store) FindFreeLocation(diskType DiskType) (ret *DiskLocation) {
 		if location.isDiskSpaceLow {
 			continue
 		}
-		currentFreeCount := location.MaxVolumeCount - int32(location.VolumesLen())
-		currentFreeCount *= erasure_coding.DataShardsCount
-		currentFreeCount -= int32(location.EcShardCount())
-		currentFreeCount /= erasure_coding.DataShardsCount
+		currentFreeCount := int32(float64(location.MaxVolumeCount-int32(location.VolumesLen())) - location.EcVolumeSlots())
 		if currentFreeCount > max {
 			max = currentFreeCount
 			ret = location
@@ -158,7 +173,7 @@ func (s *Store) FindFreeLocation(diskType DiskType) (ret *DiskLocation) {
 	}
 	return ret
 }
-func (s *Store) addVolume(vid needle.VolumeId, collection string, needleMapKind NeedleMapKind, replicaPlacement *super_block.ReplicaPlacement, ttl *needle.TTL, preallocate int64, memoryMapMaxSizeMb uint32, diskType DiskType, ldbTimeout int64) error {
+func (s *Store) addVolume(vid needle.VolumeId, collection string, needleMapKind NeedleMapKind, replicaPlacement *super_block.ReplicaPlacement, ttl *needle.TTL, preallocate int64, memoryMapMaxSizeMb uint32, diskType DiskType, ldbTimeout int64, encryption *volume_server_pb.VolumeEncryption) error {
 	if s.findVolume(vid) != nil {
 		return fmt.Errorf("Volume Id %d already exists!", vid)
 	}
@@ -166,6 +181,12 @@ func (s *Store) addVolume(vid needle.VolumeId, collection string, needleMapKind
 		glog.V(0).Infof("In dir %s adds volume:%v collection:%s replicaPlacement:%v ttl:%v",
 			location.Directory, vid, collection, replicaPlacement, ttl)
 		if volume, err := NewVolume(location.Directory, location.IdxDirectory, collection, vid, needleMapKind, replicaPlacement, ttl, preallocate, memoryMapMaxSizeMb, ldbTimeout); err == nil {
+			if s.keyWrapper != nil {
+				if err = volume.enableEncryption(s.keyWrapper, encryption); err != nil {
+					volume.Destroy(false)
+					return err
+				}
+			}
 			location.SetVolume(vid, volume)
 			glog.V(0).Infof("add volume %d", vid)
 			s.NewVolumesChan <- master_pb.VolumeShortInformationMessage{
@@ -249,13 +270,17 @@ func (s *Store) GetRack() string {
 func (s *Store) CollectHeartbeat() *master_pb.Heartbeat {
 	var volumeMessages []*master_pb.VolumeInformationMessage
 	maxVolumeCounts := make(map[string]uint32)
+	freeDiskBytes := make(map[string]uint64)
 	var maxFileKey NeedleId
 	collectionVolumeSize := make(map[string]int64)
 	collectionVolumeDeletedBytes := make(map[string]int64)
 	collectionVolumeReadOnlyCount := make(map[string]map[string]uint8)
+	collectionQuarantinedNeedleCount := make(map[string]uint64)
+	collectionReplicationLagSeconds := make(map[string]uint32)
 	for _, location := range s.Locations {
 		var deleteVids []needle.VolumeId
 		maxVolumeCounts[string(location.DiskType)] += uint32(location.MaxVolumeCount)
+		freeDiskBytes[string(location.DiskType)] += stats.NewDiskStatus(location.Directory).Free
 		location.volumesLock.RLock()
 		for _, v := range location.volumes {
 			curMaxFileKey, volumeMessage := v.ToVolumeInformationMessage()
@@ -296,6 +321,13 @@ func (s *Store) CollectHeartbeat() *master_pb.Heartbeat {
 				}
 			}
 
+			if !shouldDeleteVolume {
+				collectionQuarantinedNeedleCount[v.Collection] += volumeMessage.QuarantinedNeedleCount
+				if s.AsyncReplication.IsAsync(v.Collection) && volumeMessage.ReplicationLagSeconds >= collectionReplicationLagSeconds[v.Collection] {
+					collectionReplicationLagSeconds[v.Collection] = volumeMessage.ReplicationLagSeconds
+				}
+			}
+
 			if _, exist := collectionVolumeReadOnlyCount[v.Collection]; !exist {
 				collectionVolumeReadOnlyCount[v.Collection] = map[string]uint8{
 					stats.IsReadOnly:       0,
@@ -349,6 +381,14 @@ func (s *Store) CollectHeartbeat() *master_pb.Heartbeat {
 		stats.VolumeServerDiskSizeGauge.WithLabelValues(col, "deleted_bytes").Set(float64(deletedBytes))
 	}
 
+	for col, count := range collectionQuarantinedNeedleCount {
+		stats.VolumeServerQuarantinedNeedleGauge.WithLabelValues(col).Set(float64(count))
+	}
+
+	for col, lag := range collectionReplicationLagSeconds {
+		stats.VolumeServerReplicationLagGauge.WithLabelValues(col).Set(float64(lag))
+	}
+
 	for col, types := range collectionVolumeReadOnlyCount {
 		for t, count := range types {
 			stats.VolumeServerReadOnlyVolumeGauge.WithLabelValues(col, t).Set(float64(count))
@@ -356,17 +396,19 @@ func (s *Store) CollectHeartbeat() *master_pb.Heartbeat {
 	}
 
 	return &master_pb.Heartbeat{
-		Ip:              s.Ip,
-		Port:            uint32(s.Port),
-		GrpcPort:        uint32(s.GrpcPort),
-		PublicUrl:       s.PublicUrl,
-		MaxVolumeCounts: maxVolumeCounts,
-		MaxFileKey:      NeedleIdToUint64(maxFileKey),
-		DataCenter:      s.dataCenter,
-		Rack:            s.rack,
-		Volumes:         volumeMessages,
-		HasNoVolumes:    len(volumeMessages) == 0,
-		LocationUuids:   uuidList,
+		Ip:                  s.Ip,
+		Port:                uint32(s.Port),
+		GrpcPort:            uint32(s.GrpcPort),
+		PublicUrl:           s.PublicUrl,
+		MaxVolumeCounts:     maxVolumeCounts,
+		MaxFileKey:          NeedleIdToUint64(maxFileKey),
+		DataCenter:          s.dataCenter,
+		Rack:                s.rack,
+		Volumes:             volumeMessages,
+		HasNoVolumes:        len(volumeMessages) == 0,
+		LocationUuids:       uuidList,
+		FreeDiskBytes:       freeDiskBytes,
+		WriteBytesPerSecond: s.collectWriteBytesPerSecond(),
 	}
 
 }
@@ -390,6 +432,16 @@ func (s *Store) Close() {
 	}
 }
 
+// collectWriteBytesPerSecond is the write throughput since the last heartbeat, for the master to place new volumes.
+func (s *Store) collectWriteBytesPerSecond() (rate uint64) {
+	now, writtenBytes := time.Now(), atomic.LoadUint64(&s.writtenBytes)
+	if elapsed := now.Sub(s.lastHeartbeatCollects).Seconds(); !s.lastHeartbeatCollects.IsZero() && elapsed > 0 {
+		rate = uint64(float64(writtenBytes-s.lastWrittenBytes) / elapsed)
+	}
+	s.lastWrittenBytes, s.lastHeartbeatCollects = writtenBytes, now
+	return
+}
+
 func (s *Store) WriteVolumeNeedle(i needle.VolumeId, n *needle.Needle, checkCookie bool, fsync bool) (isUnchanged bool, err error) {
 	if v := s.findVolume(i); v != nil {
 		if v.IsReadOnly() {
@@ -397,6 +449,28 @@ func (s *Store) WriteVolumeNeedle(i needle.VolumeId, n *needle.Needle, checkCook
 			return
 		}
 		_, _, isUnchanged, err = v.writeNeedle2(n, checkCookie, fsync && s.isStopping)
+		if err == nil && !isUnchanged {
+			atomic.AddUint64(&s.writtenBytes, uint64(n.Size))
+		}
+		return
+	}
+	glog.V(0).Infoln("volume", i, "not found!")
+	err = fmt.Errorf("volume %d not found on %s:%d", i, s.Ip, s.Port)
+	return
+}
+
+// AppendVolumeNeedle appends n.Data to the existing needle with the same id and cookie at fileOffset,
+// which must be the current file size, and returns the number of bytes appended.
+func (s *Store) AppendVolumeNeedle(i needle.VolumeId, n *needle.Needle, fileOffset uint64, fsync bool) (size Size, err error) {
+	if v := s.findVolume(i); v != nil {
+		if v.IsReadOnly() {
+			err = fmt.Errorf("volume %d is read only", i)
+			return
+		}
+		_, size, err = v.appendNeedle(n, fileOffset, fsync)
+		if err == nil {
+			atomic.AddUint64(&s.writtenBytes, uint64(size))
+		}
 		return
 	}
 	glog.V(0).Infoln("volume", i, "not found!")
@@ -451,6 +525,9 @@ func (s *Store) MarkVolumeReadonly(i needle.VolumeId) error {
 	v.noWriteLock.Lock()
 	v.noWriteOrDelete = true
 	v.noWriteLock.Unlock()
+	if err := v.useReadonlyNeedleMap(); err != nil {
+		glog.Warningf("volume %d keeps its needle map: %v", i, err)
+	}
 	return nil
 }
 
@@ -462,6 +539,12 @@ func (s *Store) MarkVolumeWritable(i needle.VolumeId) error {
 	v.noWriteLock.Lock()
 	v.noWriteOrDelete = false
 	v.noWriteLock.Unlock()
+	if err := v.useWritableNeedleMap(); err != nil {
+		v.noWriteLock.Lock()
+		v.noWriteOrDelete = true
+		v.noWriteLock.Unlock()
+		return err
+	}
 	return nil
 }
 
@@ -0,0 +1,195 @@
+package storage
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/gateway-dao/seaweedfs/weed/glog"
+	"github.com/gateway-dao/seaweedfs/weed/util"
+)
+
+/*
+Zstd dictionaries trained for a collection are kept in every disk location,
+named like volume files: <collection>_<dictId>.zdict, or <dictId>.zdict for
+the default collection. The last added dictionary of a collection is used to
+compress new needles. Older ones are kept to read needles compressed by them.
+
+A needle only carries the dictionary id in its zstd frame header, so a volume
+or ec shard is readable only where its collection's dictionaries are. The shell
+adds a trained dictionary to every volume server, and VolumeCopy and
+VolumeEcShardsCopy copy the collection's dictionaries from the source first,
+keeping their creation time so the same dictionary stays the current one.
+*/
+
+const compressionDictionaryExt = ".zdict"
+
+type compressionDictionary struct {
+	dictId    uint32
+	createdAt time.Time
+}
+
+func compressionDictionaryFileName(dir string, collection string, dictId uint32) string {
+	return VolumeFileName(dir, collection, int(dictId)) + compressionDictionaryExt
+}
+
+func parseCompressionDictionaryFileName(name string) (collection string, dictId uint32, err error) {
+	base := strings.TrimSuffix(name, compressionDictionaryExt)
+	idString := base
+	if i := strings.LastIndex(base, "_"); i >= 0 {
+		collection, idString = base[:i], base[i+1:]
+	}
+	id, err := strconv.ParseUint(idString, 10, 32)
+	if err != nil {
+		return "", 0, fmt.Errorf("unknown compression dictionary file %s", name)
+	}
+	return collection, uint32(id), nil
+}
+
+func (s *Store) loadCompressionDictionaries() {
+	s.compressionDictionaries = make(map[string]compressionDictionary)
+	for _, location := range s.Locations {
+		entries, err := os.ReadDir(location.Directory)
+		if err != nil {
+			continue
+		}
+		for _, entry := range entries {
+			if entry.IsDir() || !strings.HasSuffix(entry.Name(), compressionDictionaryExt) {
+				continue
+			}
+			collection, dictId, err := parseCompressionDictionaryFileName(entry.Name())
+			if err != nil {
+				glog.Warningf("%s: %v", location.Directory, err)
+				continue
+			}
+			info, err := entry.Info()
+			if err != nil {
+				continue
+			}
+			dictionary, err := os.ReadFile(filepath.Join(location.Directory, entry.Name()))
+			if err != nil {
+				glog.Errorf("read compression dictionary %s: %v", entry.Name(), err)
+				continue
+			}
+			if _, err = util.AddZstdDictionary(dictionary); err != nil {
+				glog.Errorf("load compression dictionary %s: %v", entry.Name(), err)
+				continue
+			}
+			if current, found := s.compressionDictionaries[collection]; !found || current.createdAt.Before(info.ModTime()) {
+				s.compressionDictionaries[collection] = compressionDictionary{dictId: dictId, createdAt: info.ModTime()}
+			}
+		}
+	}
+	for collection, dictionary := range s.compressionDictionaries {
+		glog.V(0).Infof("collection %q compresses with zstd dictionary %d", collection, dictionary.dictId)
+	}
+}
+
+// AddCompressionDictionary saves the dictionary in all disk locations and
+// uses it to compress new needles of the collection.
+func (s *Store) AddCompressionDictionary(collection string, dictionary []byte) (dictId uint32, err error) {
+	return s.SaveCompressionDictionary(collection, dictionary, time.Now())
+}
+
+// SaveCompressionDictionary saves the dictionary created at createdAt in all disk locations.
+// It compresses new needles of the collection if it is the latest dictionary of the collection.
+func (s *Store) SaveCompressionDictionary(collection string, dictionary []byte, createdAt time.Time) (dictId uint32, err error) {
+	if dictId, err = util.AddZstdDictionary(dictionary); err != nil {
+		return 0, err
+	}
+	for _, location := range s.Locations {
+		fileName := compressionDictionaryFileName(location.Directory, collection, dictId)
+		if err = util.WriteFile(fileName+".tmp", dictionary, 0644); err != nil {
+			return 0, fmt.Errorf("write compression dictionary %s: %v", fileName, err)
+		}
+		if err = os.Chtimes(fileName+".tmp", createdAt, createdAt); err != nil {
+			return 0, fmt.Errorf("write compression dictionary %s: %v", fileName, err)
+		}
+		if err = os.Rename(fileName+".tmp", fileName); err != nil {
+			return 0, fmt.Errorf("save compression dictionary %s: %v", fileName, err)
+		}
+	}
+
+	s.compressionDictionariesLock.Lock()
+	defer s.compressionDictionariesLock.Unlock()
+	if current, found := s.compressionDictionaries[collection]; found && !current.createdAt.Before(createdAt) {
+		return dictId, nil
+	}
+	s.compressionDictionaries[collection] = compressionDictionary{dictId: dictId, createdAt: createdAt}
+	glog.V(0).Infof("collection %q compresses with zstd dictionary %d", collection, dictId)
+	return dictId, nil
+}
+
+// CompressionDictionaryFile is a saved zstd dictionary of a collection.
+type CompressionDictionaryFile struct {
+	DictId     uint32
+	Dictionary []byte
+	CreatedAt  time.Time
+}
+
+// ReadCompressionDictionaries returns all saved dictionaries of the collection.
+func (s *Store) ReadCompressionDictionaries(collection string) (dictionaries []CompressionDictionaryFile, err error) {
+	found := make(map[uint32]bool)
+	for _, location := range s.Locations {
+		entries, readErr := os.ReadDir(location.Directory)
+		if readErr != nil {
+			continue
+		}
+		for _, entry := range entries {
+			if entry.IsDir() || !strings.HasSuffix(entry.Name(), compressionDictionaryExt) {
+				continue
+			}
+			c, dictId, parseErr := parseCompressionDictionaryFileName(entry.Name())
+			if parseErr != nil || c != collection || found[dictId] {
+				continue
+			}
+			info, infoErr := entry.Info()
+			if infoErr != nil {
+				continue
+			}
+			dictionary, readErr := os.ReadFile(filepath.Join(location.Directory, entry.Name()))
+			if readErr != nil {
+				return nil, fmt.Errorf("read compression dictionary %s: %v", entry.Name(), readErr)
+			}
+			found[dictId] = true
+			dictionaries = append(dictionaries, CompressionDictionaryFile{
+				DictId:     dictId,
+				Dictionary: dictionary,
+				CreatedAt:  info.ModTime(),
+			})
+		}
+	}
+	return dictionaries, nil
+}
+
+func (s *Store) deleteCompressionDictionaries(collection string) {
+	for _, location := range s.Locations {
+		entries, err := os.ReadDir(location.Directory)
+		if err != nil {
+			continue
+		}
+		for _, entry := range entries {
+			if entry.IsDir() || !strings.HasSuffix(entry.Name(), compressionDictionaryExt) {
+				continue
+			}
+			if c, _, err := parseCompressionDictionaryFileName(entry.Name()); err == nil && c == collection {
+				os.Remove(filepath.Join(location.Directory, entry.Name()))
+			}
+		}
+	}
+
+	s.compressionDictionariesLock.Lock()
+	delete(s.compressionDictionaries, collection)
+	s.compressionDictionariesLock.Unlock()
+}
+
+// CompressionDictionary returns the id of the zstd dictionary for new needles of the collection.
+func (s *Store) CompressionDictionary(collection string) (dictId uint32, found bool) {
+	s.compressionDictionariesLock.RLock()
+	defer s.compressionDictionariesLock.RUnlock()
+	dictionary, found := s.compressionDictionaries[collection]
+	return dictionary.dictId, found
+}
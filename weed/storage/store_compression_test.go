@@ -0,0 +1,80 @@
+package storage
+
+import (
+	"bytes"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/klauspost/compress/dict"
+)
+
+func buildTestDictionary(t *testing.T, format string) []byte {
+	var samples [][]byte
+	for i := 0; i < 1000; i++ {
+		samples = append(samples, []byte(fmt.Sprintf(format, i, i%13)))
+	}
+	dictionary, err := dict.BuildZstdDict(samples, dict.Options{MaxDictSize: 4096, HashBytes: 6})
+	if err != nil {
+		t.Fatalf("build dictionary: %v", err)
+	}
+	return dictionary
+}
+
+func TestCopyCompressionDictionaries(t *testing.T) {
+	source := &Store{
+		Locations:               []*DiskLocation{{Directory: t.TempDir()}},
+		compressionDictionaries: make(map[string]compressionDictionary),
+	}
+	older := buildTestDictionary(t, `{"id":%d,"level":"info","shard":%d}`)
+	newer := buildTestDictionary(t, `<event seq="%d"><user>u%d</user></event>`)
+	olderId, err := source.SaveCompressionDictionary("logs", older, time.Now().Add(-time.Hour))
+	if err != nil {
+		t.Fatalf("save older dictionary: %v", err)
+	}
+	newerId, err := source.AddCompressionDictionary("logs", newer)
+	if err != nil {
+		t.Fatalf("add newer dictionary: %v", err)
+	}
+
+	dictionaries, err := source.ReadCompressionDictionaries("logs")
+	if err != nil {
+		t.Fatalf("read dictionaries: %v", err)
+	}
+	if len(dictionaries) != 2 {
+		t.Fatalf("read %d dictionaries, expected 2", len(dictionaries))
+	}
+	if others, _ := source.ReadCompressionDictionaries("other"); len(others) != 0 {
+		t.Fatalf("read %d dictionaries of another collection", len(others))
+	}
+
+	// copied in any order, the newer dictionary stays the current one
+	target := &Store{
+		Locations:               []*DiskLocation{{Directory: t.TempDir()}},
+		compressionDictionaries: make(map[string]compressionDictionary),
+	}
+	for i := len(dictionaries) - 1; i >= 0; i-- {
+		d := dictionaries[i]
+		if _, err = target.SaveCompressionDictionary("logs", d.Dictionary, d.CreatedAt); err != nil {
+			t.Fatalf("copy dictionary %d: %v", d.DictId, err)
+		}
+	}
+	if dictId, found := target.CompressionDictionary("logs"); !found || dictId != newerId {
+		t.Errorf("current dictionary %d, expected %d", dictId, newerId)
+	}
+
+	// and survives a restart
+	target.loadCompressionDictionaries()
+	if dictId, found := target.CompressionDictionary("logs"); !found || dictId != newerId {
+		t.Errorf("reloaded current dictionary %d, expected %d", dictId, newerId)
+	}
+	copied, err := target.ReadCompressionDictionaries("logs")
+	if err != nil {
+		t.Fatalf("read copied dictionaries: %v", err)
+	}
+	for _, d := range copied {
+		if d.DictId == olderId && !bytes.Equal(d.Dictionary, older) {
+			t.Errorf("copied dictionary %d differs", d.DictId)
+		}
+	}
+}
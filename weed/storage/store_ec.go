@@ -166,6 +166,23 @@ func (s *Store) ReadEcShardNeedle(vid needle.VolumeId, n *needle.Needle, onReadS
 			if err != nil {
 				return 0, fmt.Errorf("readbytes: %v", err)
 			}
+			if err = decryptNeedle(n, localEcVolume.DataKey); err != nil {
+				return 0, err
+			}
+			err = n.ResolveAppendChain(func(offset int64, size types.Size) (*needle.Needle, error) {
+				prevBytes, _, err := s.readEcShardIntervals(vid, n.Id, localEcVolume, localEcVolume.LocateEcShardNeedleInterval(localEcVolume.Version, offset, size))
+				if err != nil {
+					return nil, fmt.Errorf("ReadEcShardIntervals: %v", err)
+				}
+				prev := new(needle.Needle)
+				if err = prev.ReadBytes(prevBytes, offset, size, localEcVolume.Version); err != nil {
+					return nil, fmt.Errorf("readbytes: %v", err)
+				}
+				return prev, decryptNeedle(prev, localEcVolume.DataKey)
+			})
+			if err != nil {
+				return 0, err
+			}
 
 			return len(bytes), nil
 		}
@@ -238,11 +255,11 @@ func forgetShardId(ecVolume *erasure_coding.EcVolume, shardId erasure_coding.Sha
 func (s *Store) cachedLookupEcShardLocations(ecVolume *erasure_coding.EcVolume) (err error) {
 
 	shardCount := len(ecVolume.ShardLocations)
-	if shardCount < erasure_coding.DataShardsCount &&
+	if shardCount < ecVolume.Scheme.DataShards &&
 		ecVolume.ShardLocationsRefreshTime.Add(11*time.Second).After(time.Now()) ||
-		shardCount == erasure_coding.TotalShardsCount &&
+		shardCount == ecVolume.Scheme.TotalShards() &&
 			ecVolume.ShardLocationsRefreshTime.Add(37*time.Minute).After(time.Now()) ||
-		shardCount >= erasure_coding.DataShardsCount &&
+		shardCount >= ecVolume.Scheme.DataShards &&
 			ecVolume.ShardLocationsRefreshTime.Add(7*time.Minute).After(time.Now()) {
 		// still fresh
 		return nil
@@ -258,13 +275,16 @@ func (s *Store) cachedLookupEcShardLocations(ecVolume *erasure_coding.EcVolume)
 		if err != nil {
 			return fmt.Errorf("lookup ec volume %d: %v", ecVolume.VolumeId, err)
 		}
-		if len(resp.ShardIdLocations) < erasure_coding.DataShardsCount {
-			return fmt.Errorf("only %d shards found but %d required", len(resp.ShardIdLocations), erasure_coding.DataShardsCount)
+		if len(resp.ShardIdLocations) < ecVolume.Scheme.DataShards {
+			return fmt.Errorf("only %d shards found but %d required", len(resp.ShardIdLocations), ecVolume.Scheme.DataShards)
 		}
 
 		ecVolume.ShardLocationsLock.Lock()
 		for _, shardIdLocations := range resp.ShardIdLocations {
 			shardId := erasure_coding.ShardId(shardIdLocations.ShardId)
+			if int(shardId) >= ecVolume.Scheme.TotalShards() {
+				continue
+			}
 			delete(ecVolume.ShardLocations, shardId)
 			for _, loc := range shardIdLocations.Locations {
 				ecVolume.ShardLocations[shardId] = append(ecVolume.ShardLocations[shardId], pb.NewServerAddressFromLocation(loc))
@@ -339,12 +359,12 @@ func (s *Store) doReadRemoteEcShardInterval(sourceDataNode pb.ServerAddress, nee
 func (s *Store) recoverOneRemoteEcShardInterval(needleId types.NeedleId, ecVolume *erasure_coding.EcVolume, shardIdToRecover erasure_coding.ShardId, buf []byte, offset int64) (n int, is_deleted bool, err error) {
 	glog.V(3).Infof("recover ec shard %d.%d from other locations", ecVolume.VolumeId, shardIdToRecover)
 
-	enc, err := reedsolomon.New(erasure_coding.DataShardsCount, erasure_coding.ParityShardsCount)
+	enc, err := reedsolomon.New(ecVolume.Scheme.DataShards, ecVolume.Scheme.ParityShards)
 	if err != nil {
 		return 0, false, fmt.Errorf("failed to create encoder: %v", err)
 	}
 
-	bufs := make([][]byte, erasure_coding.TotalShardsCount)
+	bufs := make([][]byte, ecVolume.Scheme.TotalShards())
 
 	var wg sync.WaitGroup
 	ecVolume.ShardLocationsLock.RLock()
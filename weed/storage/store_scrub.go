@@ -0,0 +1,268 @@
+package storage
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/gateway-dao/seaweedfs/weed/glog"
+	"github.com/gateway-dao/seaweedfs/weed/operation"
+	"github.com/gateway-dao/seaweedfs/weed/pb"
+	"github.com/gateway-dao/seaweedfs/weed/pb/volume_server_pb"
+	"github.com/gateway-dao/seaweedfs/weed/stats"
+	"github.com/gateway-dao/seaweedfs/weed/storage/erasure_coding"
+	"github.com/gateway-dao/seaweedfs/weed/storage/needle"
+	. "github.com/gateway-dao/seaweedfs/weed/storage/types"
+	"github.com/gateway-dao/seaweedfs/weed/util"
+)
+
+type ScrubResult struct {
+	VolumeId         needle.VolumeId
+	Collection       string
+	IsEcVolume       bool
+	StartedAt        time.Time
+	CompletedAt      time.Time
+	ScannedNeedles   uint64
+	ScannedBytes     uint64
+	CorruptedNeedles uint64
+	RepairedNeedles  uint64
+	Error            error
+}
+
+// ScrubVolume verifies all live needles of a local volume.
+// Corrupted needles are quarantined, and repaired from a healthy replica if the volume is replicated.
+func (s *Store) ScrubVolume(vid needle.VolumeId, throttler *util.WriteThrottler) *ScrubResult {
+	result := &ScrubResult{VolumeId: vid, StartedAt: time.Now()}
+	defer func() {
+		result.CompletedAt = time.Now()
+	}()
+
+	v := s.findVolume(vid)
+	if v == nil {
+		result.Error = fmt.Errorf("volume %d not found", vid)
+		return result
+	}
+	result.Collection = v.Collection
+
+	var corruptedNeedleIds []NeedleId
+	result.ScannedNeedles, result.ScannedBytes, result.Error = v.Scrub(throttler, func(key NeedleId, offset Offset, size Size, err error) {
+		corruptedNeedleIds = append(corruptedNeedleIds, key)
+	})
+	result.CorruptedNeedles = uint64(len(corruptedNeedleIds))
+
+	if v.NeedToReplicate() {
+		for _, key := range corruptedNeedleIds {
+			if err := s.RepairVolumeNeedle(vid, key); err != nil {
+				glog.Warningf("repair volume %d needle %s: %v", vid, key, err)
+				continue
+			}
+			glog.V(0).Infof("repaired volume %d needle %s from replicas", vid, key)
+			result.RepairedNeedles++
+		}
+	}
+
+	recordScrubResult(result)
+	return result
+}
+
+// RepairVolumeNeedle replaces a corrupted or missing local copy of a needle with a verified copy from another replica.
+// A corrupted copy of the same size is overwritten in place, otherwise the verified copy is appended.
+// A needle deleted in the local index is never brought back, ErrorDeleted is returned instead.
+func (s *Store) RepairVolumeNeedle(vid needle.VolumeId, key NeedleId) error {
+	v := s.findVolume(vid)
+	if v == nil {
+		return fmt.Errorf("volume %d not found", vid)
+	}
+	if !v.NeedToReplicate() {
+		return fmt.Errorf("volume %d has no replicas", vid)
+	}
+	offset, size, err := v.needleLocation(key)
+	if err != nil && err != ErrorNotFound {
+		return err
+	}
+	hasLocalCopy := err == nil
+
+	needleBlob, blobSize, err := s.readNeedleBlobFromReplicas(vid, key, v.Version())
+	if err != nil {
+		return err
+	}
+	if hasLocalCopy && blobSize == size {
+		return v.repairNeedleBlob(key, offset, size, needleBlob)
+	}
+
+	if v.IsReadOnly() {
+		return fmt.Errorf("volume %d is read only", vid)
+	}
+	if err = v.WriteNeedleBlob(key, needleBlob, blobSize); err != nil {
+		return fmt.Errorf("write needle %s: %v", key, err)
+	}
+	v.releaseQuarantinedNeedle(key)
+	return nil
+}
+
+func (s *Store) readNeedleBlobFromReplicas(vid needle.VolumeId, key NeedleId, version needle.Version) (needleBlob []byte, size Size, err error) {
+	lookupResult, err := operation.LookupVolumeId(func(ctx context.Context) pb.ServerAddress {
+		return s.MasterAddress
+	}, s.grpcDialOption, vid.String())
+	if err != nil {
+		return nil, 0, fmt.Errorf("lookup volume %d: %v", vid, err)
+	}
+
+	selfUrl := util.JoinHostPort(s.Ip, s.Port)
+	err = fmt.Errorf("no other replica of volume %d found", vid)
+	for _, location := range lookupResult.Locations {
+		if location.Url == selfUrl {
+			continue
+		}
+		readErr := operation.WithVolumeServerClient(false, location.ServerAddress(), s.grpcDialOption, func(client volume_server_pb.VolumeServerClient) error {
+			resp, err := client.ReadNeedleBlob(context.Background(), &volume_server_pb.ReadNeedleBlobRequest{
+				VolumeId: uint32(vid),
+				NeedleId: uint64(key),
+			})
+			if err != nil {
+				return err
+			}
+			n := new(needle.Needle)
+			if err = n.ReadBytes(resp.NeedleBlob, 0, Size(resp.Size), version); err != nil {
+				return err
+			}
+			if n.Id != key {
+				return fmt.Errorf("needle id %v, expected %v", n.Id, key)
+			}
+			needleBlob, size = resp.NeedleBlob, Size(resp.Size)
+			return nil
+		})
+		if readErr == nil {
+			return needleBlob, size, nil
+		}
+		err = fmt.Errorf("read needle %s from %s: %v", key, location.Url, readErr)
+		glog.V(1).Infof("volume %d: %v", vid, err)
+	}
+	return nil, 0, err
+}
+
+// ScrubEcVolume verifies the needles whose data is stored only on the local ec shards.
+// Each needle is verified by the server holding its data shards, so parity shards are not checked here.
+// Corrupted intervals are rebuilt from the other shards and written back to the local shard files.
+func (s *Store) ScrubEcVolume(vid needle.VolumeId, throttler *util.WriteThrottler) *ScrubResult {
+	result := &ScrubResult{VolumeId: vid, IsEcVolume: true, StartedAt: time.Now()}
+	defer func() {
+		result.CompletedAt = time.Now()
+	}()
+
+	ecVolume, found := s.FindEcVolume(vid)
+	if !found {
+		result.Error = fmt.Errorf("ec volume %d not found", vid)
+		return result
+	}
+	result.Collection = ecVolume.Collection
+
+	result.Error = ecVolume.WalkIndex(func(key NeedleId, offset Offset, size Size) error {
+		if offset.IsZero() || size.IsDeleted() {
+			return nil
+		}
+		intervals := ecVolume.LocateEcShardNeedleInterval(ecVolume.Version, offset.ToActualOffset(), size)
+		for _, interval := range intervals {
+			shardId, _ := interval.ToShardIdAndOffset(erasure_coding.ErasureCodingLargeBlockSize, erasure_coding.ErasureCodingSmallBlockSize)
+			if _, found := ecVolume.FindEcVolumeShard(shardId); !found {
+				return nil
+			}
+		}
+
+		result.ScannedNeedles++
+		result.ScannedBytes += uint64(size)
+		throttler.MaybeSlowdown(int64(size))
+
+		verifyErr := verifyEcShardNeedle(ecVolume, key, offset, size, intervals, readLocalEcShardInterval)
+		if verifyErr == nil {
+			return nil
+		}
+		result.CorruptedNeedles++
+		glog.Warningf("scrub ec volume %d needle %s: %v", vid, key, verifyErr)
+
+		if repairErr := s.repairEcShardNeedle(ecVolume, key, offset, size, intervals); repairErr != nil {
+			glog.Warningf("repair ec volume %d needle %s: %v", vid, key, repairErr)
+			return nil
+		}
+		glog.V(0).Infof("repaired ec volume %d needle %s from other shards", vid, key)
+		result.RepairedNeedles++
+		return nil
+	})
+
+	recordScrubResult(result)
+	return result
+}
+
+type ecShardIntervalReader func(ecVolume *erasure_coding.EcVolume, key NeedleId, interval erasure_coding.Interval) ([]byte, error)
+
+func verifyEcShardNeedle(ecVolume *erasure_coding.EcVolume, key NeedleId, offset Offset, size Size, intervals []erasure_coding.Interval, readFn ecShardIntervalReader) error {
+	var bytes []byte
+	for _, interval := range intervals {
+		data, err := readFn(ecVolume, key, interval)
+		if err != nil {
+			return err
+		}
+		bytes = append(bytes, data...)
+	}
+	n := new(needle.Needle)
+	if err := n.ReadBytes(bytes, offset.ToActualOffset(), size, ecVolume.Version); err != nil {
+		return err
+	}
+	if n.Id != key {
+		return fmt.Errorf("index key %v does not match needle's Id %v", key, n.Id)
+	}
+	return nil
+}
+
+func readLocalEcShardInterval(ecVolume *erasure_coding.EcVolume, key NeedleId, interval erasure_coding.Interval) ([]byte, error) {
+	shardId, actualOffset := interval.ToShardIdAndOffset(erasure_coding.ErasureCodingLargeBlockSize, erasure_coding.ErasureCodingSmallBlockSize)
+	shard, found := ecVolume.FindEcVolumeShard(shardId)
+	if !found {
+		return nil, fmt.Errorf("ec shard %d.%d not found", ecVolume.VolumeId, shardId)
+	}
+	data := make([]byte, interval.Size)
+	if _, err := shard.ReadAt(data, actualOffset); err != nil {
+		return nil, fmt.Errorf("read ec shard %d.%d offset %d: %v", ecVolume.VolumeId, shardId, actualOffset, err)
+	}
+	return data, nil
+}
+
+func (s *Store) repairEcShardNeedle(ecVolume *erasure_coding.EcVolume, key NeedleId, offset Offset, size Size, intervals []erasure_coding.Interval) error {
+	if err := s.cachedLookupEcShardLocations(ecVolume); err != nil {
+		return fmt.Errorf("locate ec shards: %v", err)
+	}
+
+	var recovered [][]byte
+	recoverFn := func(ecVolume *erasure_coding.EcVolume, key NeedleId, interval erasure_coding.Interval) ([]byte, error) {
+		shardId, actualOffset := interval.ToShardIdAndOffset(erasure_coding.ErasureCodingLargeBlockSize, erasure_coding.ErasureCodingSmallBlockSize)
+		data := make([]byte, interval.Size)
+		if _, _, err := s.recoverOneRemoteEcShardInterval(key, ecVolume, shardId, data, actualOffset); err != nil {
+			return nil, fmt.Errorf("recover ec shard %d.%d offset %d: %v", ecVolume.VolumeId, shardId, actualOffset, err)
+		}
+		recovered = append(recovered, data)
+		return data, nil
+	}
+	if err := verifyEcShardNeedle(ecVolume, key, offset, size, intervals, recoverFn); err != nil {
+		return fmt.Errorf("verify recovered needle: %v", err)
+	}
+
+	for i, interval := range intervals {
+		shardId, actualOffset := interval.ToShardIdAndOffset(erasure_coding.ErasureCodingLargeBlockSize, erasure_coding.ErasureCodingSmallBlockSize)
+		shard, found := ecVolume.FindEcVolumeShard(shardId)
+		if !found {
+			return fmt.Errorf("ec shard %d.%d not found", ecVolume.VolumeId, shardId)
+		}
+		if _, err := shard.WriteAt(recovered[i], actualOffset); err != nil {
+			return fmt.Errorf("write ec shard %d.%d offset %d: %v", ecVolume.VolumeId, shardId, actualOffset, err)
+		}
+	}
+
+	return verifyEcShardNeedle(ecVolume, key, offset, size, intervals, readLocalEcShardInterval)
+}
+
+func recordScrubResult(result *ScrubResult) {
+	stats.VolumeServerScrubNeedleCounter.WithLabelValues(result.Collection, "scanned").Add(float64(result.ScannedNeedles))
+	stats.VolumeServerScrubNeedleCounter.WithLabelValues(result.Collection, "corrupted").Add(float64(result.CorruptedNeedles))
+	stats.VolumeServerScrubNeedleCounter.WithLabelValues(result.Collection, "repaired").Add(float64(result.RepairedNeedles))
+	stats.VolumeServerScrubBytesCounter.WithLabelValues(result.Collection).Add(float64(result.ScannedBytes))
+}
@@ -0,0 +1,97 @@
+package storage
+
+import (
+	"fmt"
+	"path/filepath"
+
+	"github.com/gateway-dao/seaweedfs/weed/glog"
+	"github.com/gateway-dao/seaweedfs/weed/pb/volume_server_pb"
+	"github.com/gateway-dao/seaweedfs/weed/storage/needle"
+)
+
+func (s *Store) SnapshotVolume(i needle.VolumeId, name string, hardLink bool, asOfNs uint64) (*volume_server_pb.VolumeSnapshot, error) {
+	v := s.findVolume(i)
+	if v == nil {
+		return nil, fmt.Errorf("volume %d not found", i)
+	}
+	return v.Snapshot(name, hardLink, asOfNs)
+}
+
+// ListVolumeSnapshots lists the snapshots in all disk locations, also of volumes deleted since.
+func (s *Store) ListVolumeSnapshots(volumeIds []needle.VolumeId, name string) (snapshots []*volume_server_pb.VolumeSnapshot, err error) {
+	if name == "" {
+		name = "*"
+	} else if err = validateSnapshotName(name); err != nil {
+		return nil, err
+	}
+	wanted := make(map[needle.VolumeId]bool)
+	for _, vid := range volumeIds {
+		wanted[vid] = true
+	}
+	for _, location := range s.Locations {
+		snapshotFiles, globErr := filepath.Glob(filepath.Join(location.Directory, SnapshotDirName, name, "*.snap"))
+		if globErr != nil {
+			return nil, globErr
+		}
+		for _, snapshotFile := range snapshotFiles {
+			snapshot, loadErr := loadSnapshotInfo(snapshotFile)
+			if loadErr != nil {
+				glog.Warningf("load snapshot %s: %v", snapshotFile, loadErr)
+				continue
+			}
+			if len(wanted) > 0 && !wanted[needle.VolumeId(snapshot.VolumeId)] {
+				continue
+			}
+			snapshots = append(snapshots, snapshot)
+		}
+	}
+	return snapshots, nil
+}
+
+func (s *Store) findVolumeSnapshot(i needle.VolumeId, name string) (*DiskLocation, *volume_server_pb.VolumeSnapshot, error) {
+	if err := validateSnapshotName(name); err != nil {
+		return nil, nil, err
+	}
+	for _, location := range s.Locations {
+		snapshotFiles, _ := filepath.Glob(filepath.Join(location.Directory, SnapshotDirName, name, "*.snap"))
+		for _, snapshotFile := range snapshotFiles {
+			snapshot, err := loadSnapshotInfo(snapshotFile)
+			if err != nil {
+				return nil, nil, err
+			}
+			if snapshot.VolumeId == uint32(i) {
+				return location, snapshot, nil
+			}
+		}
+	}
+	return nil, nil, fmt.Errorf("snapshot %s of volume %d not found", name, i)
+}
+
+// RestoreVolumeSnapshot copies the snapshot into the disk location holding it, and mounts it as volume newVolumeId.
+func (s *Store) RestoreVolumeSnapshot(i needle.VolumeId, name string, newVolumeId needle.VolumeId) error {
+	if s.findVolume(newVolumeId) != nil {
+		return fmt.Errorf("volume %d already exists", newVolumeId)
+	}
+	location, snapshot, err := s.findVolumeSnapshot(i, name)
+	if err != nil {
+		return err
+	}
+	if err = restoreSnapshotFiles(snapshot, location.Directory, location.IdxDirectory, newVolumeId); err != nil {
+		return fmt.Errorf("restore snapshot %s of volume %d as volume %d: %v", name, i, newVolumeId, err)
+	}
+	glog.V(0).Infof("restored snapshot %s of volume %d as volume %d", name, i, newVolumeId)
+	return s.MountVolume(newVolumeId)
+}
+
+func (s *Store) DeleteVolumeSnapshot(i needle.VolumeId, name string) error {
+	_, snapshot, err := s.findVolumeSnapshot(i, name)
+	if err != nil {
+		return err
+	}
+	if v := s.findVolume(i); v != nil {
+		v.removeSnapshotFiles(snapshot)
+	} else {
+		removeSnapshotFiles(snapshot)
+	}
+	return nil
+}
@@ -16,8 +16,16 @@ func (s *Store) CheckCompactVolume(volumeId needle.VolumeId) (float64, error) {
 	}
 	return 0, fmt.Errorf("volume id %d is not found during check compact", volumeId)
 }
-func (s *Store) CompactVolume(vid needle.VolumeId, preallocate int64, compactionBytePerSecond int64, progressFn ProgressFunc) error {
+func (s *Store) CompactVolume(vid needle.VolumeId, preallocate int64, compactionSegmentSize int64, compactionBytePerSecond int64, progressFn ProgressFunc) error {
 	if v := s.findVolume(vid); v != nil {
+		// an interrupted in place compaction must be resumed before the volume can be copied again
+		if v.hasCompactCheckpoint() || (compactionSegmentSize > 0 && !v.noWriteOrDelete && !v.noWriteCanDelete && !v.hasSnapshots()) {
+			s := stats.NewDiskStatus(v.dir)
+			if int64(s.Free) < compactionSegmentSize {
+				return fmt.Errorf("free space: %d bytes, not enough for %d bytes", s.Free, compactionSegmentSize)
+			}
+			return v.CompactSegmented(compactionSegmentSize, compactionBytePerSecond, progressFn)
+		}
 		s := stats.NewDiskStatus(v.dir)
 		if int64(s.Free) < preallocate {
 			return fmt.Errorf("free space: %d bytes, not enough for %d bytes", s.Free, preallocate)
@@ -12,8 +12,10 @@ import (
 	"github.com/gateway-dao/seaweedfs/weed/stats"
 	"github.com/gateway-dao/seaweedfs/weed/storage/backend"
 	"github.com/gateway-dao/seaweedfs/weed/storage/needle"
+	"github.com/gateway-dao/seaweedfs/weed/storage/needle_map"
 	"github.com/gateway-dao/seaweedfs/weed/storage/super_block"
 	"github.com/gateway-dao/seaweedfs/weed/storage/types"
+	"github.com/gateway-dao/seaweedfs/weed/util"
 
 	"github.com/gateway-dao/seaweedfs/weed/glog"
 )
@@ -32,6 +34,8 @@ type Volume struct {
 	noWriteLock        sync.RWMutex
 	hasRemoteFile      bool // if the volume has a remote file
 	MemoryMapMaxSizeMb uint32
+	diskIo             backend.DiskIoMode // how the local .dat file is read
+	needleCache        NeedleCache
 
 	super_block.SuperBlock
 
@@ -47,11 +51,26 @@ type Volume struct {
 
 	isCompacting       bool
 	isCommitCompacting bool
+	compactedSegments  uint64 // segments moved by in place compaction, readers re-check offsets when it changes
+
+	isCompactingInPlace     bool
+	compactMoveLock         sync.RWMutex // held by readers of the .dat file in append order, so in place compaction does not move needles under them
+	compactionBytePerSecond int64        // throttles the in place compaction catching up at the commit
+	snapshotsInProgress     int          // snapshots copying the .dat file up to their frozen size
+	copiesInProgress        int          // CopyFile streams of the .dat or .idx file
+	linkedSnapshots         int          // snapshots sharing the .dat file
 
 	volumeInfo *volume_server_pb.VolumeInfo
 	location   *DiskLocation
+	dataKey    util.CipherKey // unwrapped from volumeInfo.Encryption
 
 	lastIoError error
+
+	quarantine     map[types.NeedleId]needle_map.NeedleValue // needles failing verification
+	quarantineLock sync.RWMutex
+
+	asyncReplica     *asyncReplicaPosition // set when following a replica in another data center
+	asyncReplicaLock sync.Mutex
 }
 
 func NewVolume(dirname string, dirIdx string, collection string, id needle.VolumeId, needleMapKind NeedleMapKind, replicaPlacement *super_block.ReplicaPlacement, ttl *needle.TTL, preallocate int64, memoryMapMaxSizeMb uint32, ldbTimeout int64) (v *Volume, e error) {
@@ -92,10 +111,10 @@ func (v *Volume) IndexFileName() (fileName string) {
 
 func (v *Volume) FileName(ext string) (fileName string) {
 	switch ext {
-	case ".idx", ".cpx", ".ldb", ".cpldb":
+	case ".idx", ".cpx", ".ldb", ".cpldb", ".qdx":
 		return VolumeFileName(v.dirIdx, v.Collection, int(v.Id)) + ext
 	}
-	// .dat, .cpd, .vif
+	// .dat, .cpd, .cps, .cpk, .vif
 	return VolumeFileName(v.dir, v.Collection, int(v.Id)) + ext
 }
 
@@ -222,6 +241,8 @@ func (v *Volume) SyncToDisk() {
 
 // Close cleanly shuts down this volume
 func (v *Volume) Close() {
+	v.syncAsyncReplicaPosition()
+
 	v.dataFileAccessLock.Lock()
 	defer v.dataFileAccessLock.Unlock()
 
@@ -233,6 +254,7 @@ func (v *Volume) doClose() {
 		time.Sleep(521 * time.Millisecond)
 		glog.Warningf("Volume Close wait for compaction %d", v.Id)
 	}
+	v.uncacheVolume()
 
 	if v.nm != nil {
 		if err := v.nm.Sync(); err != nil {
@@ -323,19 +345,21 @@ func (v *Volume) ToVolumeInformationMessage() (types.NeedleId, *master_pb.Volume
 	}
 
 	volumeInfo := &master_pb.VolumeInformationMessage{
-		Id:               uint32(v.Id),
-		Size:             uint64(volumeSize),
-		Collection:       v.Collection,
-		FileCount:        fileCount,
-		DeleteCount:      deletedCount,
-		DeletedByteCount: deletedSize,
-		ReadOnly:         v.IsReadOnly(),
-		ReplicaPlacement: uint32(v.ReplicaPlacement.Byte()),
-		Version:          uint32(v.Version()),
-		Ttl:              v.Ttl.ToUint32(),
-		CompactRevision:  uint32(v.SuperBlock.CompactionRevision),
-		ModifiedAtSecond: modTime.Unix(),
-		DiskType:         string(v.location.DiskType),
+		Id:                     uint32(v.Id),
+		Size:                   uint64(volumeSize),
+		Collection:             v.Collection,
+		FileCount:              fileCount,
+		DeleteCount:            deletedCount,
+		DeletedByteCount:       deletedSize,
+		ReadOnly:               v.IsReadOnly(),
+		ReplicaPlacement:       uint32(v.ReplicaPlacement.Byte()),
+		Version:                uint32(v.Version()),
+		Ttl:                    v.Ttl.ToUint32(),
+		CompactRevision:        uint32(v.SuperBlock.CompactionRevision),
+		ModifiedAtSecond:       modTime.Unix(),
+		DiskType:               string(v.location.DiskType),
+		QuarantinedNeedleCount: uint64(v.QuarantinedNeedleCount()),
+		ReplicationLagSeconds:  uint32(v.ReplicationLag().Seconds()),
 	}
 
 	volumeInfo.RemoteStorageName, volumeInfo.RemoteStorageKey = v.RemoteStorageNameKey()
@@ -0,0 +1,215 @@
+package storage
+
+import (
+	"fmt"
+
+	"github.com/gateway-dao/seaweedfs/weed/glog"
+	"github.com/gateway-dao/seaweedfs/weed/storage/backend"
+	"github.com/gateway-dao/seaweedfs/weed/storage/needle"
+	. "github.com/gateway-dao/seaweedfs/weed/storage/types"
+	"github.com/gateway-dao/seaweedfs/weed/util"
+)
+
+// appendNeedle appends n.Data to the existing needle with the same id and cookie.
+// The data is only appended if the file size is fileOffset, otherwise ErrorAppendConflict is returned,
+// so concurrent appends to the same file id can not interleave.
+func (v *Volume) appendNeedle(n *needle.Needle, fileOffset uint64, fsync bool) (offset uint64, size Size, err error) {
+	v.dataFileAccessLock.Lock()
+	defer v.dataFileAccessLock.Unlock()
+
+	if offset, size, err = v.doAppendRequest(n, fileOffset); err != nil {
+		return
+	}
+	if fsync {
+		err = v.DataBackend.Sync()
+	}
+	return
+}
+
+func (v *Volume) doAppendRequest(n *needle.Needle, fileOffset uint64) (offset uint64, size Size, err error) {
+	nv, ok := v.nm.Get(n.Id)
+	if !ok || nv.Offset.IsZero() {
+		return 0, 0, ErrorNotFound
+	}
+	if nv.Size.IsDeleted() {
+		return 0, 0, ErrorDeleted
+	}
+	if MaxPossibleVolumeSize < v.nm.ContentSize()+uint64(len(n.Data)) {
+		return 0, 0, fmt.Errorf("volume size limit %d exceeded! current size is %d", MaxPossibleVolumeSize, v.nm.ContentSize())
+	}
+
+	version := v.Version()
+	actualOffset := nv.Offset.ToActualOffset()
+	record := new(needle.Needle)
+	if err = record.ReadData(v.DataBackend, actualOffset, nv.Size, version); err != nil {
+		return 0, 0, fmt.Errorf("read needle %s: %v", n.Id, err)
+	}
+	if err = decryptNeedle(record, v.dataKey); err != nil {
+		return 0, 0, err
+	}
+	if record.Cookie != n.Cookie {
+		glog.V(0).Infof("append cookie mismatch: existing %s, new %s",
+			needle.NewFileIdFromNeedle(v.Id, record), needle.NewFileIdFromNeedle(v.Id, n))
+		return 0, 0, fmt.Errorf("mismatching cookie %x", n.Cookie)
+	}
+	if record.IsChunkedManifest() {
+		return 0, 0, fmt.Errorf("needle %s is a chunk manifest", n.Id)
+	}
+
+	isCompressed := record.IsCompressed()
+	if isCompressed {
+		// the appended data is not compressed, so the needle is stored uncompressed from now on
+		if record.Data, err = util.DecompressData(record.Data); err != nil {
+			return 0, 0, fmt.Errorf("decompress needle %s: %v", n.Id, err)
+		}
+		record.Flags &^= needle.FlagIsCompressed
+	}
+
+	link, isContinuation := record.GetAppendLink()
+	fileSize := link.DataOffset + uint64(len(record.Data))
+	if fileSize != fileOffset {
+		return 0, 0, fmt.Errorf("%w: needle %s has %d bytes, not %d", ErrorAppendConflict, n.Id, fileSize, fileOffset)
+	}
+	if len(n.Data) == 0 {
+		return uint64(actualOffset), 0, nil
+	}
+
+	// acknowledged bytes are never rewritten: the data is appended as a continuation needle,
+	// or the whole file as a new needle, and the index points to it only after it is written.
+	// A crash mid-write leaves a partial needle after the last indexed one, which the
+	// integrity check on load drops.
+	switch {
+	case version != needle.Version1 && !isCompressed && link.Depth < needle.MaxAppendChainLength && !v.isCompacting:
+		continuation := &needle.Needle{
+			Cookie: n.Cookie,
+			Id:     n.Id,
+			Data:   n.Data,
+		}
+		if record.HasTtl() {
+			continuation.Ttl = record.Ttl
+			continuation.SetHasTtl()
+		}
+		record = continuation
+		record.SetAppendLink(needle.AppendLink{
+			PrevOffset: actualOffset,
+			PrevSize:   nv.Size,
+			Depth:      link.Depth + 1,
+			DataOffset: fileSize,
+		})
+	default:
+		// write the whole file as a single needle
+		if isContinuation {
+			if err = record.ResolveAppendChain(v.appendChainReader(v.DataBackend)); err != nil {
+				return 0, 0, err
+			}
+		}
+		record.Data = concatNeedleData(record.Data, n.Data)
+	}
+	record.DataSize = uint32(len(record.Data))
+	record.Checksum = needle.NewCRC(record.Data)
+	record.LastModified = n.LastModified
+	record.SetHasLastModifiedDate()
+	record.UpdateAppendAtNs(v.lastAppendAtNs)
+
+	toWrite := record
+	if v.IsEncrypted() {
+		if toWrite, err = v.encryptNeedle(record); err != nil {
+			return 0, 0, err
+		}
+	}
+	offset, _, _, err = toWrite.Append(v.DataBackend, version)
+	v.checkReadWriteError(err)
+	if err != nil {
+		return 0, 0, err
+	}
+	v.lastAppendAtNs = toWrite.AppendAtNs
+
+	if err = v.nm.Put(n.Id, ToOffset(int64(offset)), toWrite.Size); err != nil {
+		glog.V(4).Infof("failed to save in needle map %d: %v", n.Id, err)
+	}
+	v.uncacheNeedle(n.Id)
+	if v.lastModifiedTsSeconds < record.LastModified {
+		v.lastModifiedTsSeconds = record.LastModified
+	}
+	return offset, Size(len(n.Data)), nil
+}
+
+// concatNeedleData copies the data into a new slice, since the data read from a needle
+// shares its buffer with the name, mime type and pairs that follow it.
+func concatNeedleData(data, appended []byte) []byte {
+	result := make([]byte, 0, len(data)+len(appended))
+	result = append(result, data...)
+	return append(result, appended...)
+}
+
+// appendChainReader reads the needles a continuation needle is chained to from datBackend.
+func (v *Volume) appendChainReader(datBackend backend.BackendStorageFile) func(offset int64, size Size) (*needle.Needle, error) {
+	return func(offset int64, size Size) (*needle.Needle, error) {
+		n := new(needle.Needle)
+		if err := n.ReadData(datBackend, offset, size, v.Version()); err != nil {
+			return nil, err
+		}
+		if err := decryptNeedle(n, v.dataKey); err != nil {
+			return nil, err
+		}
+		return n, nil
+	}
+}
+
+// flattenAppendChain returns a continuation needle as it is stored, with the chain it links to
+// in datBackend merged into a single needle, also as it is to be stored.
+// Compaction moves needles, so it must not copy the links to the old offsets.
+func (v *Volume) flattenAppendChain(n *needle.Needle, datBackend backend.BackendStorageFile) (*needle.Needle, error) {
+	flat := *n
+	if err := decryptNeedle(&flat, v.dataKey); err != nil {
+		return nil, err
+	}
+	if err := flat.ResolveAppendChain(v.appendChainReader(datBackend)); err != nil {
+		return nil, err
+	}
+	if !v.IsEncrypted() {
+		return &flat, nil
+	}
+	return v.encryptNeedle(&flat)
+}
+
+// flattenLiveAppendChain rewrites the current needle of key as a single needle at the end of the
+// volume, if it is a continuation needle. The caller holds dataFileAccessLock if locked is true.
+func (v *Volume) flattenLiveAppendChain(key NeedleId, locked bool) error {
+	if !locked {
+		v.dataFileAccessLock.Lock()
+		defer v.dataFileAccessLock.Unlock()
+	}
+
+	nv, ok := v.nm.Get(key)
+	if !ok || nv.Offset.IsZero() || !nv.Size.IsValid() {
+		return nil
+	}
+	version := v.Version()
+	n := new(needle.Needle)
+	if err := n.ReadNeedleMeta(v.DataBackend, nv.Offset.ToActualOffset(), nv.Size, version); err != nil {
+		return fmt.Errorf("read needle %s meta: %v", key, err)
+	}
+	if !n.IsAppendContinuation() {
+		return nil
+	}
+	if err := n.ReadData(v.DataBackend, nv.Offset.ToActualOffset(), nv.Size, version); err != nil {
+		return fmt.Errorf("read needle %s: %v", key, err)
+	}
+	flat, err := v.flattenAppendChain(n, v.DataBackend)
+	if err != nil {
+		return err
+	}
+	flat.UpdateAppendAtNs(v.lastAppendAtNs)
+	offset, _, _, err := flat.Append(v.DataBackend, version)
+	v.checkReadWriteError(err)
+	if err != nil {
+		return fmt.Errorf("append flattened needle %s: %v", key, err)
+	}
+	v.lastAppendAtNs = flat.AppendAtNs
+	if err = v.nm.Put(key, ToOffset(int64(offset)), flat.Size); err != nil {
+		return fmt.Errorf("update needle %s offset: %v", key, err)
+	}
+	v.uncacheNeedle(key)
+	return nil
+}
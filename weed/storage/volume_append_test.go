@@ -0,0 +1,205 @@
+package storage
+
+import (
+	"bytes"
+	"errors"
+	"os"
+	"testing"
+
+	"github.com/gateway-dao/seaweedfs/weed/storage/needle"
+	"github.com/gateway-dao/seaweedfs/weed/storage/super_block"
+)
+
+// newAppendTestNeedle returns a named needle with some data, since an empty needle keeps no name.
+func newAppendTestNeedle(id uint64) *needle.Needle {
+	n := newRandomNeedle(id)
+	n.Data = append(n.Data, byte(id))
+	n.Checksum = needle.NewCRC(n.Data)
+	n.Name = []byte("log.txt")
+	n.SetHasName()
+	return n
+}
+
+func appendTestData(t *testing.T, v *Volume, n *needle.Needle, fileOffset int, data []byte) {
+	an := &needle.Needle{Id: n.Id, Cookie: n.Cookie, Data: data, LastModified: 1}
+	_, size, err := v.appendNeedle(an, uint64(fileOffset), false)
+	if err != nil {
+		t.Fatalf("append to %s: %v", n.Id, err)
+	}
+	if int(size) != len(data) {
+		t.Fatalf("append to %s: size %d, expected %d", n.Id, size, len(data))
+	}
+}
+
+func readAppendTestData(t *testing.T, v *Volume, n *needle.Needle, expected []byte) {
+	read := newEmptyNeedle(uint64(n.Id))
+	if _, err := v.readNeedle(read, nil, nil); err != nil {
+		t.Fatalf("read %s: %v", n.Id, err)
+	}
+	if !bytes.Equal(read.Data, expected) {
+		t.Fatalf("read %s: %d bytes, expected %d bytes", n.Id, len(read.Data), len(expected))
+	}
+	if string(read.Name) != "log.txt" {
+		t.Fatalf("read %s: name %q is not kept", n.Id, read.Name)
+	}
+	if read.IsAppendContinuation() {
+		t.Fatalf("read %s: continuation needle is not resolved", n.Id)
+	}
+}
+
+func TestAppendNeedle(t *testing.T) {
+	dir := t.TempDir()
+
+	v, err := NewVolume(dir, dir, "", 1, NeedleMapInMemory, &super_block.ReplicaPlacement{}, &needle.TTL{}, 0, 0, 0)
+	if err != nil {
+		t.Fatalf("volume creation: %v", err)
+	}
+	defer v.Close()
+
+	n := newAppendTestNeedle(1)
+	n.Cookie = 0x12345678
+	if _, _, _, err = v.writeNeedle2(n, true, false); err != nil {
+		t.Fatalf("write: %v", err)
+	}
+	expected := append([]byte(nil), n.Data...)
+
+	// the written needle is never rewritten, even if it is the last one
+	datSize, _, _ := v.DataBackend.GetStat()
+	written := make([]byte, datSize)
+	if _, err = v.DataBackend.ReadAt(written, 0); err != nil {
+		t.Fatalf("read volume: %v", err)
+	}
+	appendTestData(t, v, n, len(expected), []byte("appended"))
+	expected = append(expected, []byte("appended")...)
+	readAppendTestData(t, v, n, expected)
+	kept := make([]byte, datSize)
+	if _, err = v.DataBackend.ReadAt(kept, 0); err != nil {
+		t.Fatalf("read volume: %v", err)
+	}
+	if !bytes.Equal(kept, written) {
+		t.Fatalf("appending rewrote the acknowledged bytes")
+	}
+	if nv, _ := v.nm.Get(n.Id); nv.Offset.ToActualOffset() < datSize {
+		t.Fatalf("needle at %d, expected a continuation needle after %d", nv.Offset.ToActualOffset(), datSize)
+	}
+
+	// other needles written after it make the appended data a continuation needle
+	for i := 2; i <= 5; i++ {
+		other := newRandomNeedle(uint64(i))
+		if _, _, _, err = v.writeNeedle2(other, true, false); err != nil {
+			t.Fatalf("write %d: %v", i, err)
+		}
+		data := []byte{byte(i), byte(i), byte(i)}
+		appendTestData(t, v, n, len(expected), data)
+		expected = append(expected, data...)
+		readAppendTestData(t, v, n, expected)
+	}
+
+	blob, size, err := v.ReadNeedleBlobByKey(n.Id)
+	if err != nil {
+		t.Fatalf("read blob: %v", err)
+	}
+	flat := new(needle.Needle)
+	if err = flat.ReadBytes(blob, 0, size, v.Version()); err != nil {
+		t.Fatalf("parse blob: %v", err)
+	}
+	if flat.IsAppendContinuation() || !bytes.Equal(flat.Data, expected) {
+		t.Fatalf("needle blob is not flattened")
+	}
+
+	// a wrong cookie is rejected
+	if _, _, err = v.appendNeedle(&needle.Needle{Id: n.Id, Cookie: n.Cookie + 1, Data: []byte("x")}, uint64(len(expected)), false); err == nil {
+		t.Fatalf("append with a wrong cookie should fail")
+	}
+
+	// an append at a stale offset is rejected, and leaves the file unchanged
+	for _, fileOffset := range []int{len(expected) - 3, len(expected) + 1} {
+		_, _, err = v.appendNeedle(&needle.Needle{Id: n.Id, Cookie: n.Cookie, Data: []byte("x")}, uint64(fileOffset), false)
+		if !errors.Is(err, ErrorAppendConflict) {
+			t.Fatalf("append at offset %d of %d bytes: %v, expected a conflict", fileOffset, len(expected), err)
+		}
+	}
+	readAppendTestData(t, v, n, expected)
+
+	// compaction flattens the chain
+	if err = v.Compact2(0, 0, nil); err != nil {
+		t.Fatalf("compact: %v", err)
+	}
+	if err = v.CommitCompact(); err != nil {
+		t.Fatalf("commit compact: %v", err)
+	}
+	readAppendTestData(t, v, n, expected)
+	nv, _ := v.nm.Get(n.Id)
+	stored := new(needle.Needle)
+	if err = stored.ReadData(v.DataBackend, nv.Offset.ToActualOffset(), nv.Size, v.Version()); err != nil {
+		t.Fatalf("read stored needle: %v", err)
+	}
+	if stored.IsAppendContinuation() {
+		t.Fatalf("compaction kept the continuation needle")
+	}
+}
+
+func TestAppendNeedleChainLimit(t *testing.T) {
+	dir := t.TempDir()
+
+	v, err := NewVolume(dir, dir, "", 1, NeedleMapInMemory, &super_block.ReplicaPlacement{}, &needle.TTL{}, 0, 0, 0)
+	if err != nil {
+		t.Fatalf("volume creation: %v", err)
+	}
+	defer v.Close()
+
+	n := newAppendTestNeedle(1)
+	if _, _, _, err = v.writeNeedle2(n, true, false); err != nil {
+		t.Fatalf("write: %v", err)
+	}
+	expected := append([]byte(nil), n.Data...)
+
+	for i := 0; i < 2*needle.MaxAppendChainLength; i++ {
+		other := newRandomNeedle(uint64(i + 2))
+		if _, _, _, err = v.writeNeedle2(other, true, false); err != nil {
+			t.Fatalf("write %d: %v", i, err)
+		}
+		data := []byte{byte(i)}
+		appendTestData(t, v, n, len(expected), data)
+		expected = append(expected, data...)
+	}
+	readAppendTestData(t, v, n, expected)
+}
+
+func TestAppendNeedleInterrupted(t *testing.T) {
+	dir := t.TempDir()
+
+	v, err := NewVolume(dir, dir, "", 1, NeedleMapInMemory, &super_block.ReplicaPlacement{}, &needle.TTL{}, 0, 0, 0)
+	if err != nil {
+		t.Fatalf("volume creation: %v", err)
+	}
+
+	n := newAppendTestNeedle(1)
+	if _, _, _, err = v.writeNeedle2(n, true, false); err != nil {
+		t.Fatalf("write: %v", err)
+	}
+	expected := append([]byte(nil), n.Data...)
+	appendTestData(t, v, n, len(expected), []byte("acknowledged"))
+	expected = append(expected, []byte("acknowledged")...)
+
+	// the volume server crashes while writing the next append, after its index entry
+	datSize, _, _ := v.DataBackend.GetStat()
+	appendTestData(t, v, n, len(expected), bytes.Repeat([]byte("lost"), 1024))
+	newDatSize, _, _ := v.DataBackend.GetStat()
+	v.Close()
+	if err = os.Truncate(v.FileName(".dat"), datSize+(newDatSize-datSize)/2); err != nil {
+		t.Fatalf("truncate: %v", err)
+	}
+
+	v, err = NewVolume(dir, dir, "", 1, NeedleMapInMemory, &super_block.ReplicaPlacement{}, &needle.TTL{}, 0, 0, 0)
+	if err != nil {
+		t.Fatalf("reload volume: %v", err)
+	}
+	defer v.Close()
+	readAppendTestData(t, v, n, expected)
+
+	// the producer retries the lost append
+	appendTestData(t, v, n, len(expected), []byte("retried"))
+	expected = append(expected, []byte("retried")...)
+	readAppendTestData(t, v, n, expected)
+}
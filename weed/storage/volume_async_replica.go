@@ -0,0 +1,155 @@
+package storage
+
+import (
+	"encoding/json"
+	"os"
+	"time"
+
+	"github.com/gateway-dao/seaweedfs/weed/glog"
+	"github.com/gateway-dao/seaweedfs/weed/storage/needle"
+	"github.com/gateway-dao/seaweedfs/weed/util"
+)
+
+const (
+	// how far back to start tailing a new source, since the clocks of the replicas differ
+	asyncReplicaRewind = time.Minute
+	// how often the position of an asynchronous replica is saved
+	asyncReplicaSaveInterval = 10 * time.Second
+)
+
+// asyncReplicaPosition is kept in the .arp file of a replica following a replica in another data center.
+type asyncReplicaPosition struct {
+	Source  string `json:"source"`
+	SinceNs uint64 `json:"sinceNs"` // AppendAtNs of the last needle applied, in the source volume
+
+	syncedAtNs int64 // the replica has all the writes of the source up to this time
+	savedAt    time.Time
+}
+
+// StartAsyncReplica makes the volume follow the source, and returns the AppendAtNs in the source volume to tail from.
+func (v *Volume) StartAsyncReplica(source string) (sinceNs uint64) {
+	v.asyncReplicaLock.Lock()
+	defer v.asyncReplicaLock.Unlock()
+
+	position := &asyncReplicaPosition{}
+	if data, err := os.ReadFile(v.FileName(".arp")); err == nil {
+		if err = json.Unmarshal(data, position); err != nil {
+			glog.Warningf("volume %d async replica position: %v", v.Id, err)
+		}
+	}
+	if position.SinceNs == 0 {
+		// the needles copied from another replica keep their AppendAtNs
+		position.SinceNs = v.lastAppendAtNs
+	}
+	if position.Source != source {
+		position.SinceNs = rewindAppendAtNs(position.SinceNs, asyncReplicaRewind)
+	}
+	position.Source = source
+	if v.asyncReplica != nil {
+		position.syncedAtNs = v.asyncReplica.syncedAtNs
+	}
+	if position.syncedAtNs == 0 {
+		position.syncedAtNs = int64(position.SinceNs)
+	}
+	v.asyncReplica = position
+	return position.SinceNs
+}
+
+func rewindAppendAtNs(appendAtNs uint64, d time.Duration) uint64 {
+	if appendAtNs <= uint64(d) {
+		return 0
+	}
+	return appendAtNs - uint64(d)
+}
+
+// AdvanceAsyncReplica records a needle from the source is applied.
+func (v *Volume) AdvanceAsyncReplica(appendAtNs uint64) {
+	v.asyncReplicaLock.Lock()
+	defer v.asyncReplicaLock.Unlock()
+	if v.asyncReplica == nil || appendAtNs <= v.asyncReplica.SinceNs {
+		return
+	}
+	v.asyncReplica.SinceNs = appendAtNs
+	if int64(appendAtNs) > v.asyncReplica.syncedAtNs {
+		v.asyncReplica.syncedAtNs = int64(appendAtNs)
+	}
+	if time.Since(v.asyncReplica.savedAt) > asyncReplicaSaveInterval {
+		v.saveAsyncReplicaPosition()
+	}
+}
+
+// AsyncReplicaCaughtUp records the replica has all the writes of the source.
+func (v *Volume) AsyncReplicaCaughtUp() {
+	v.asyncReplicaLock.Lock()
+	defer v.asyncReplicaLock.Unlock()
+	if v.asyncReplica != nil {
+		v.asyncReplica.syncedAtNs = time.Now().UnixNano()
+	}
+}
+
+// StopAsyncReplica saves the position, and stops reporting replication lag.
+func (v *Volume) StopAsyncReplica() {
+	v.asyncReplicaLock.Lock()
+	defer v.asyncReplicaLock.Unlock()
+	if v.asyncReplica != nil {
+		v.saveAsyncReplicaPosition()
+		v.asyncReplica = nil
+	}
+}
+
+func (v *Volume) syncAsyncReplicaPosition() {
+	v.asyncReplicaLock.Lock()
+	defer v.asyncReplicaLock.Unlock()
+	if v.asyncReplica != nil {
+		v.saveAsyncReplicaPosition()
+	}
+}
+
+func (v *Volume) saveAsyncReplicaPosition() {
+	data, err := json.Marshal(v.asyncReplica)
+	if err == nil {
+		err = util.WriteFile(v.FileName(".arp"), data, 0644)
+	}
+	if err != nil {
+		glog.Warningf("save volume %d async replica position: %v", v.Id, err)
+		return
+	}
+	v.asyncReplica.savedAt = time.Now()
+}
+
+// ReplicationLag is how long the volume is behind the replica it follows in another data center.
+func (v *Volume) ReplicationLag() time.Duration {
+	v.asyncReplicaLock.Lock()
+	defer v.asyncReplicaLock.Unlock()
+	if v.asyncReplica == nil {
+		return 0
+	}
+	lag := time.Since(time.Unix(0, v.asyncReplica.syncedAtNs))
+	if lag < 0 {
+		return 0
+	}
+	return lag
+}
+
+// AsyncReplicaSource is the replica followed by the volume, if lagging more than maxLag.
+func (v *Volume) AsyncReplicaSource(maxLag time.Duration) (source string, isLagging bool) {
+	if v.ReplicationLag() <= maxLag {
+		return "", false
+	}
+	v.asyncReplicaLock.Lock()
+	defer v.asyncReplicaLock.Unlock()
+	if v.asyncReplica == nil {
+		return "", false
+	}
+	return v.asyncReplica.Source, true
+}
+
+// IsDeletionNeedle tells whether the needle at the offset marks a deletion.
+// A deletion is appended as an empty needle, and only differs from an empty file by the index entry.
+func (v *Volume) IsDeletionNeedle(n *needle.Needle, offset int64) bool {
+	if n.Size != 0 {
+		return false
+	}
+	nv, ok := v.nm.Get(n.Id)
+	return !ok || nv.Offset.ToActualOffset() != offset || !nv.Size.IsValid()
+}
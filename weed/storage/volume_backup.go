@@ -11,10 +11,12 @@ import (
 
 	"github.com/gateway-dao/seaweedfs/weed/operation"
 	"github.com/gateway-dao/seaweedfs/weed/pb/volume_server_pb"
+	"github.com/gateway-dao/seaweedfs/weed/storage/backend"
 	"github.com/gateway-dao/seaweedfs/weed/storage/idx"
 	"github.com/gateway-dao/seaweedfs/weed/storage/needle"
 	"github.com/gateway-dao/seaweedfs/weed/storage/super_block"
 	. "github.com/gateway-dao/seaweedfs/weed/storage/types"
+	"github.com/gateway-dao/seaweedfs/weed/util"
 )
 
 func (v *Volume) GetVolumeSyncStatus() *volume_server_pb.VolumeSyncStatusResponse {
@@ -154,12 +156,16 @@ func (v *Volume) locateLastAppendEntry() (Offset, error) {
 }
 
 func (v *Volume) readAppendAtNs(offset Offset) (uint64, error) {
+	return readNeedleAppendAtNs(v.DataBackend, v.SuperBlock.Version, offset)
+}
+
+func readNeedleAppendAtNs(datBackend backend.BackendStorageFile, version needle.Version, offset Offset) (uint64, error) {
 
-	n, _, bodyLength, err := needle.ReadNeedleHeader(v.DataBackend, v.SuperBlock.Version, offset.ToActualOffset())
+	n, _, bodyLength, err := needle.ReadNeedleHeader(datBackend, version, offset.ToActualOffset())
 	if err != nil {
-		return 0, fmt.Errorf("ReadNeedleHeader %s [%d,%d): %v", v.DataBackend.Name(), offset.ToActualOffset(), offset.ToActualOffset()+NeedleHeaderSize, err)
+		return 0, fmt.Errorf("ReadNeedleHeader %s [%d,%d): %v", datBackend.Name(), offset.ToActualOffset(), offset.ToActualOffset()+NeedleHeaderSize, err)
 	}
-	_, err = n.ReadNeedleBody(v.DataBackend, v.SuperBlock.Version, offset.ToActualOffset()+NeedleHeaderSize, bodyLength)
+	_, err = n.ReadNeedleBody(datBackend, version, offset.ToActualOffset()+NeedleHeaderSize, bodyLength)
 	if err != nil {
 		return 0, fmt.Errorf("ReadNeedleBody offset %d, bodyLength %d: %v", offset.ToActualOffset(), bodyLength, err)
 	}
@@ -167,9 +173,106 @@ func (v *Volume) readAppendAtNs(offset Offset) (uint64, error) {
 
 }
 
+// DataRange is the byte range [Start, Stop) of the .dat file.
+type DataRange struct {
+	Start int64
+	Stop  int64
+}
+
+// ReadAppendedSince calls fn with the ranges of the .dat file holding the needles appended after sinceNs,
+// in append order, or with no ranges if nothing is appended since. In place compaction does not move
+// needles while fn runs.
+func (v *Volume) ReadAppendedSince(sinceNs uint64, fn func(ranges []DataRange) error) error {
+	v.compactMoveLock.RLock()
+	defer v.compactMoveLock.RUnlock()
+
+	v.dataFileAccessLock.RLock()
+	datSize, _, err := v.DataBackend.GetStat()
+	v.dataFileAccessLock.RUnlock()
+	if err != nil {
+		return fmt.Errorf("stat volume %d: %v", v.Id, err)
+	}
+
+	cp, err := loadCompactCheckpoint(v.FileName(".cpk"))
+	if err != nil {
+		return err
+	}
+	if cp == nil {
+		offset, isLast, err := v.BinarySearchByAppendAtNs(sinceNs)
+		if err != nil {
+			return fmt.Errorf("fail to locate by appendAtNs %d: %v", sinceNs, err)
+		}
+		if isLast || offset.ToActualOffset() >= datSize {
+			return fn(nil)
+		}
+		return fn([]DataRange{{Start: offset.ToActualOffset(), Stop: datSize}})
+	}
+
+	// while compacting in place, the compacted needles are followed by the needles not compacted yet,
+	// with the bytes in between already overwritten or about to be
+	compacted := DataRange{Start: int64(v.SuperBlock.BlockSize()), Stop: cp.writeOffset}
+	rest := DataRange{Start: cp.readOffset, Stop: datSize}
+	if rest.Start < rest.Stop {
+		appendAtNs, err := readNeedleAppendAtNs(v.DataBackend, v.Version(), ToOffset(rest.Start))
+		if err != nil {
+			return err
+		}
+		if appendAtNs <= sinceNs {
+			if rest.Start, err = findNeedleAppendedSince(v.DataBackend, v.Version(), rest, sinceNs); err != nil {
+				return err
+			}
+			if rest.Start >= rest.Stop {
+				return fn(nil)
+			}
+			return fn([]DataRange{rest})
+		}
+	}
+	if compacted.Start, err = findNeedleAppendedSince(v.DataBackend, v.Version(), compacted, sinceNs); err != nil {
+		return err
+	}
+	var ranges []DataRange
+	for _, r := range []DataRange{compacted, rest} {
+		if r.Start < r.Stop {
+			ranges = append(ranges, r)
+		}
+	}
+	return fn(ranges)
+}
+
+// findNeedleAppendedSince returns the offset of the first needle in r appended after sinceNs, or r.Stop.
+// It only reads the needle headers and append times.
+func findNeedleAppendedSince(datBackend backend.BackendStorageFile, version needle.Version, r DataRange, sinceNs uint64) (int64, error) {
+	if version != needle.Version3 {
+		// the append time is not kept
+		return r.Start, nil
+	}
+	tsBytes := make([]byte, TimestampSize)
+	for offset := r.Start; offset < r.Stop; {
+		n, _, bodyLength, err := needle.ReadNeedleHeader(datBackend, version, offset)
+		if err != nil {
+			return 0, fmt.Errorf("ReadNeedleHeader %s at %d: %v", datBackend.Name(), offset, err)
+		}
+		tsOffset := offset + NeedleHeaderSize + int64(n.Size) + needle.NeedleChecksumSize
+		if count, err := datBackend.ReadAt(tsBytes, tsOffset); count != len(tsBytes) {
+			return 0, fmt.Errorf("read append time %s at %d: %v", datBackend.Name(), tsOffset, err)
+		}
+		if util.BytesToUint64(tsBytes) > sinceNs {
+			return offset, nil
+		}
+		offset += NeedleHeaderSize + bodyLength
+	}
+	return r.Stop, nil
+}
+
 // on server side
 func (v *Volume) BinarySearchByAppendAtNs(sinceNs uint64) (offset Offset, isLast bool, err error) {
 
+	// moved needles are appended to the .idx out of append order until the compaction is committed
+	if v.hasCompactCheckpoint() {
+		err = fmt.Errorf("volume %d is being compacted in place", v.Id)
+		return
+	}
+
 	fileSize := int64(v.IndexFileSize())
 	if fileSize%NeedleMapEntrySize != 0 {
 		err = fmt.Errorf("unexpected file %s.idx size: %d", v.IndexFileName(), fileSize)
@@ -306,6 +409,7 @@ func (scanner *VolumeFileScanner4GenIdx) ReadNeedleBody() bool {
 }
 
 func (scanner *VolumeFileScanner4GenIdx) VisitNeedle(n *needle.Needle, offset int64, needleHeader, needleBody []byte) error {
+	scanner.v.uncacheNeedle(n.Id)
 	if n.Size > 0 && n.Size.IsValid() {
 		return scanner.v.nm.Put(n.Id, ToOffset(offset), n.Size)
 	}
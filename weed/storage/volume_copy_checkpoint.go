@@ -0,0 +1,109 @@
+package storage
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"os"
+	"time"
+
+	"github.com/gateway-dao/seaweedfs/weed/storage/idx"
+	"github.com/gateway-dao/seaweedfs/weed/storage/needle"
+	"github.com/gateway-dao/seaweedfs/weed/storage/super_block"
+	"github.com/gateway-dao/seaweedfs/weed/storage/types"
+	"github.com/gateway-dao/seaweedfs/weed/util"
+)
+
+// CopyCheckpointMaxAge is how long the files of an interrupted volume copy are kept for a retry.
+const CopyCheckpointMaxAge = 7 * 24 * time.Hour
+
+// CopyCheckpoint is kept in the .cpy file next to a volume being copied from another volume server.
+// It records how much of the .dat file is safely on disk, so that an interrupted copy can resume.
+type CopyCheckpoint struct {
+	SourceDataNode     string `json:"sourceDataNode"`
+	Collection         string `json:"collection"`
+	CompactionRevision uint32 `json:"compactionRevision"`
+	DatFileSize        uint64 `json:"datFileSize"`   // the source .dat file size when the copy started
+	CopiedDatSize      uint64 `json:"copiedDatSize"` // the bytes of the .dat file synced to disk
+	UpdatedAtNs        int64  `json:"updatedAtNs"`
+}
+
+func LoadCopyCheckpoint(baseFileName string) (*CopyCheckpoint, error) {
+	data, err := os.ReadFile(baseFileName + ".cpy")
+	if err != nil {
+		return nil, err
+	}
+	checkpoint := &CopyCheckpoint{}
+	if err = json.Unmarshal(data, checkpoint); err != nil {
+		return nil, fmt.Errorf("parse %s.cpy: %v", baseFileName, err)
+	}
+	return checkpoint, nil
+}
+
+func (c *CopyCheckpoint) Save(baseFileName string) error {
+	c.UpdatedAtNs = time.Now().UnixNano()
+	data, err := json.Marshal(c)
+	if err != nil {
+		return err
+	}
+	if err = util.WriteFile(baseFileName+".cpy.tmp", data, 0644); err != nil {
+		return err
+	}
+	return os.Rename(baseFileName+".cpy.tmp", baseFileName+".cpy")
+}
+
+func (c *CopyCheckpoint) IsExpired() bool {
+	return time.Since(time.Unix(0, c.UpdatedAtNs)) > CopyCheckpointMaxAge
+}
+
+func RemoveCopyCheckpoint(baseFileName string) {
+	os.Remove(baseFileName + ".cpy")
+	os.Remove(baseFileName + ".cpy.tmp")
+}
+
+// FindCopyCheckpoint looks for an interrupted copy of the volume in all disk locations.
+func (s *Store) FindCopyCheckpoint(collection string, vid needle.VolumeId) (*DiskLocation, *CopyCheckpoint) {
+	for _, location := range s.Locations {
+		if checkpoint, err := LoadCopyCheckpoint(VolumeFileName(location.Directory, collection, int(vid))); err == nil {
+			return location, checkpoint
+		}
+	}
+	return nil, nil
+}
+
+// CopyResumeOffset finds where to resume copying the .dat file, given the .idx file of the source volume
+// and the partial .dat file copied so far.
+// The copy resumes at the start of the last needle with its header below copiedDatSize, after checking the header
+// in the partial .dat file. The last needle is always copied again, since it may have been extended in place.
+// It returns 0 if nothing can be kept.
+func CopyResumeOffset(idxFile io.ReaderAt, datFile io.ReaderAt, copiedDatSize uint64) (uint64, error) {
+	var resumeOffset int64
+	var resumeKey types.NeedleId
+	err := idx.WalkIndexFile(idxFile, 0, func(key types.NeedleId, offset types.Offset, size types.Size) error {
+		actualOffset := offset.ToActualOffset()
+		if offset.IsZero() || uint64(actualOffset)+types.NeedleHeaderSize > copiedDatSize {
+			return nil
+		}
+		if actualOffset > resumeOffset {
+			resumeOffset, resumeKey = actualOffset, key
+		}
+		return nil
+	})
+	if err != nil {
+		return 0, err
+	}
+	if resumeOffset < super_block.SuperBlockSize {
+		return 0, nil
+	}
+
+	header := make([]byte, types.NeedleHeaderSize)
+	if _, err = datFile.ReadAt(header, resumeOffset); err != nil {
+		return 0, fmt.Errorf("read needle header at %d: %v", resumeOffset, err)
+	}
+	n := &needle.Needle{}
+	n.ParseNeedleHeader(header)
+	if n.Id != resumeKey {
+		return 0, fmt.Errorf("needle at %d is %s instead of %s", resumeOffset, n.Id, resumeKey)
+	}
+	return uint64(resumeOffset), nil
+}
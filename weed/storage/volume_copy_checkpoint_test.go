@@ -0,0 +1,94 @@
+package storage
+
+import (
+	"bytes"
+	"os"
+	"testing"
+
+	"github.com/gateway-dao/seaweedfs/weed/storage/needle"
+	"github.com/gateway-dao/seaweedfs/weed/storage/super_block"
+)
+
+func TestCopyCheckpoint(t *testing.T) {
+	baseFileName := VolumeFileName(t.TempDir(), "c", 1)
+
+	checkpoint := &CopyCheckpoint{
+		SourceDataNode:     "127.0.0.1:8080",
+		Collection:         "c",
+		CompactionRevision: 2,
+		DatFileSize:        1000,
+		CopiedDatSize:      500,
+	}
+	if err := checkpoint.Save(baseFileName); err != nil {
+		t.Fatalf("save: %v", err)
+	}
+	loaded, err := LoadCopyCheckpoint(baseFileName)
+	if err != nil {
+		t.Fatalf("load: %v", err)
+	}
+	if *loaded != *checkpoint || loaded.IsExpired() {
+		t.Fatalf("loaded %+v, expected %+v", loaded, checkpoint)
+	}
+
+	RemoveCopyCheckpoint(baseFileName)
+	if _, err = LoadCopyCheckpoint(baseFileName); err == nil {
+		t.Fatalf("checkpoint is not removed")
+	}
+}
+
+func TestCopyResumeOffset(t *testing.T) {
+	dir := t.TempDir()
+
+	v, err := NewVolume(dir, dir, "", 1, NeedleMapInMemory, &super_block.ReplicaPlacement{}, &needle.TTL{}, 0, 0, 0)
+	if err != nil {
+		t.Fatalf("volume creation: %v", err)
+	}
+	needles := writeSnapshotTestNeedles(t, v, 1, 50)
+	nv, _ := v.nm.Get(needles[20].Id)
+	needleOffset := uint64(nv.Offset.ToActualOffset())
+	nv, _ = v.nm.Get(needles[21].Id)
+	nextNeedleOffset := uint64(nv.Offset.ToActualOffset())
+	v.Close()
+
+	source, err := os.ReadFile(v.FileName(".dat"))
+	if err != nil {
+		t.Fatalf("read source: %v", err)
+	}
+	idxFile, err := os.Open(v.FileName(".idx"))
+	if err != nil {
+		t.Fatalf("open idx: %v", err)
+	}
+	defer idxFile.Close()
+
+	// interrupted in the middle of the needle after needles[20]
+	copiedDatSize := nextNeedleOffset + 20
+	offset, err := CopyResumeOffset(idxFile, bytes.NewReader(source[:copiedDatSize]), copiedDatSize)
+	if err != nil {
+		t.Fatalf("resume offset: %v", err)
+	}
+	if offset != nextNeedleOffset {
+		t.Fatalf("resume at %d, expected %d", offset, nextNeedleOffset)
+	}
+
+	// the last complete needle is copied again, it may have been extended in place
+	if offset, err = CopyResumeOffset(idxFile, bytes.NewReader(source[:nextNeedleOffset]), nextNeedleOffset); err != nil || offset != needleOffset {
+		t.Fatalf("resume at %d, expected %d: %v", offset, needleOffset, err)
+	}
+
+	// interrupted in the middle of the needle header
+	if offset, err = CopyResumeOffset(idxFile, bytes.NewReader(source[:nextNeedleOffset+10]), nextNeedleOffset+10); err != nil || offset != needleOffset {
+		t.Fatalf("resume at %d, expected %d: %v", offset, needleOffset, err)
+	}
+
+	// the partial copy does not match the index
+	corrupted := append([]byte{}, source[:copiedDatSize]...)
+	copy(corrupted[nextNeedleOffset:], make([]byte, 16))
+	if _, err = CopyResumeOffset(idxFile, bytes.NewReader(corrupted), copiedDatSize); err == nil {
+		t.Fatalf("mismatched needle header is not detected")
+	}
+
+	// nothing to keep before the first needle
+	if offset, err = CopyResumeOffset(idxFile, bytes.NewReader(source[:10]), 10); err != nil || offset != 0 {
+		t.Fatalf("resume at %d: %v", offset, err)
+	}
+}
@@ -0,0 +1,212 @@
+package storage
+
+import (
+	"fmt"
+
+	"github.com/gateway-dao/seaweedfs/weed/glog"
+	"github.com/gateway-dao/seaweedfs/weed/pb/volume_server_pb"
+	"github.com/gateway-dao/seaweedfs/weed/security"
+	"github.com/gateway-dao/seaweedfs/weed/storage/erasure_coding"
+	"github.com/gateway-dao/seaweedfs/weed/storage/needle"
+	"github.com/gateway-dao/seaweedfs/weed/storage/volume_info"
+	"github.com/gateway-dao/seaweedfs/weed/util"
+)
+
+/*
+Volume level encryption at rest.
+
+When the volume server has a key wrapper, every new volume gets a random AES-GCM
+data key. The master generates it once per volume, so all replicas share it and
+raw needle blobs stay valid when copied between replicas. The master wraps it with
+the same keyring before sending it, so the data key is never sent in plain text.
+The data key is stored wrapped in the .vif file, together with the id of the master key.
+
+Only the needle data is encrypted. Name, mime, pairs and ttl stay in plain
+text so that the index can be rebuilt and the volume compacted without keys.
+The stored CRC covers the cipher text, so scrubbing works without keys too.
+*/
+
+func (v *Volume) loadDataKey(keyWrapper security.KeyWrapper) error {
+	encryption := v.volumeInfo.GetEncryption()
+	if encryption == nil {
+		return nil
+	}
+	if keyWrapper == nil {
+		return fmt.Errorf("volume %d is encrypted with master key %q, but no keyring is configured", v.Id, encryption.KeyId)
+	}
+	dataKey, err := keyWrapper.UnwrapKey(encryption.KeyId, encryption.WrappedKey)
+	if err != nil {
+		return fmt.Errorf("volume %d: %v", v.Id, err)
+	}
+	v.dataKey = dataKey
+	return nil
+}
+
+// enableEncryption saves the data key wrapped by the master in the .vif file.
+// It only applies to new volumes, since existing needles are not rewritten.
+func (v *Volume) enableEncryption(keyWrapper security.KeyWrapper, encryption *volume_server_pb.VolumeEncryption) error {
+	if encryption == nil {
+		return fmt.Errorf("volume %d has no wrapped data key, the master needs the same encryption keyring", v.Id)
+	}
+	dataKey, err := keyWrapper.UnwrapKey(encryption.KeyId, encryption.WrappedKey)
+	if err != nil {
+		return fmt.Errorf("volume %d: %v", v.Id, err)
+	}
+	v.volumeInfo.Encryption = encryption
+	if err = v.SaveVolumeInfo(); err != nil {
+		return fmt.Errorf("save encryption info of volume %d: %v", v.Id, err)
+	}
+	v.dataKey = dataKey
+	return nil
+}
+
+func (v *Volume) IsEncrypted() bool {
+	return v.volumeInfo.GetEncryption() != nil
+}
+
+// encryptNeedle returns a copy of the needle with the data encrypted.
+func (v *Volume) encryptNeedle(n *needle.Needle) (*needle.Needle, error) {
+	if v.dataKey == nil {
+		return nil, fmt.Errorf("volume %d is encrypted but its data key is not available", v.Id)
+	}
+	cipherText, err := util.Encrypt(n.Data, v.dataKey)
+	if err != nil {
+		return nil, fmt.Errorf("encrypt needle %s: %v", n.Id, err)
+	}
+	encrypted := *n
+	encrypted.Data = cipherText
+	encrypted.Checksum = needle.NewCRC(cipherText)
+	encrypted.SetIsEncrypted()
+	return &encrypted, nil
+}
+
+// decryptNeedle replaces the needle data with the plain text, if it is encrypted.
+func decryptNeedle(n *needle.Needle, dataKey util.CipherKey) error {
+	if !n.IsEncrypted() {
+		return nil
+	}
+	if dataKey == nil {
+		return fmt.Errorf("needle %s is encrypted but the data key is not available", n.Id)
+	}
+	plainText, err := util.Decrypt(n.Data, dataKey)
+	if err != nil {
+		return fmt.Errorf("decrypt needle %s: %v", n.Id, err)
+	}
+	n.Data = plainText
+	n.DataSize = uint32(len(plainText))
+	n.Checksum = needle.NewCRC(plainText)
+	n.Flags &^= needle.FlagIsEncrypted
+	return nil
+}
+
+func loadEcVolumeDataKey(ecVolume *erasure_coding.EcVolume, keyWrapper security.KeyWrapper) error {
+	if ecVolume.Encryption == nil {
+		return nil
+	}
+	if keyWrapper == nil {
+		return fmt.Errorf("ec volume %d is encrypted with master key %q, but no keyring is configured", ecVolume.VolumeId, ecVolume.Encryption.KeyId)
+	}
+	dataKey, err := keyWrapper.UnwrapKey(ecVolume.Encryption.KeyId, ecVolume.Encryption.WrappedKey)
+	if err != nil {
+		return fmt.Errorf("ec volume %d: %v", ecVolume.VolumeId, err)
+	}
+	ecVolume.DataKey = dataKey
+	return nil
+}
+
+// RotateEncryptionKeys reloads the keyring and re-wraps all data keys that
+// are not wrapped by the current master key. The needle data is not touched.
+func (s *Store) RotateEncryptionKeys() (keyId string, volumeCount, ecVolumeCount int, err error) {
+	if s.keyWrapper == nil {
+		return "", 0, 0, fmt.Errorf("no keyring is configured")
+	}
+	if err = s.keyWrapper.Reload(); err != nil {
+		return "", 0, 0, err
+	}
+	keyId = s.keyWrapper.CurrentKeyId()
+
+	for _, location := range s.Locations {
+		location.volumesLock.RLock()
+		volumes := make([]*Volume, 0, len(location.volumes))
+		for _, v := range location.volumes {
+			volumes = append(volumes, v)
+		}
+		location.volumesLock.RUnlock()
+
+		for _, v := range volumes {
+			rotated, rotateErr := v.rewrapDataKey(s.keyWrapper)
+			if rotateErr != nil {
+				return keyId, volumeCount, ecVolumeCount, rotateErr
+			}
+			if rotated {
+				volumeCount++
+			}
+		}
+
+		location.ecVolumesLock.RLock()
+		ecVolumes := make([]*erasure_coding.EcVolume, 0, len(location.ecVolumes))
+		for _, ev := range location.ecVolumes {
+			ecVolumes = append(ecVolumes, ev)
+		}
+		location.ecVolumesLock.RUnlock()
+
+		for _, ev := range ecVolumes {
+			rotated, rotateErr := rewrapEcVolumeDataKey(ev, s.keyWrapper)
+			if rotateErr != nil {
+				return keyId, volumeCount, ecVolumeCount, rotateErr
+			}
+			if rotated {
+				ecVolumeCount++
+			}
+		}
+	}
+	return
+}
+
+func (v *Volume) rewrapDataKey(keyWrapper security.KeyWrapper) (rotated bool, err error) {
+	v.dataFileAccessLock.Lock()
+	defer v.dataFileAccessLock.Unlock()
+
+	encryption := v.volumeInfo.GetEncryption()
+	if encryption == nil || encryption.KeyId == keyWrapper.CurrentKeyId() {
+		return false, nil
+	}
+	if v.dataKey == nil {
+		return false, fmt.Errorf("volume %d: data key is not available", v.Id)
+	}
+	keyId, wrapped, err := keyWrapper.WrapKey(v.dataKey)
+	if err != nil {
+		return false, fmt.Errorf("wrap data key of volume %d: %v", v.Id, err)
+	}
+	v.volumeInfo.Encryption = &volume_server_pb.VolumeEncryption{KeyId: keyId, WrappedKey: wrapped}
+	if err = v.SaveVolumeInfo(); err != nil {
+		return false, fmt.Errorf("save encryption info of volume %d: %v", v.Id, err)
+	}
+	glog.V(0).Infof("volume %d data key re-wrapped with master key %s", v.Id, keyId)
+	return true, nil
+}
+
+func rewrapEcVolumeDataKey(ev *erasure_coding.EcVolume, keyWrapper security.KeyWrapper) (rotated bool, err error) {
+	if ev.Encryption == nil || ev.Encryption.KeyId == keyWrapper.CurrentKeyId() {
+		return false, nil
+	}
+	if ev.DataKey == nil {
+		return false, fmt.Errorf("ec volume %d: data key is not available", ev.VolumeId)
+	}
+	keyId, wrapped, err := keyWrapper.WrapKey(ev.DataKey)
+	if err != nil {
+		return false, fmt.Errorf("wrap data key of ec volume %d: %v", ev.VolumeId, err)
+	}
+	vifFile := ev.DataBaseFileName() + ".vif"
+	volumeInfo, _, _, err := volume_info.MaybeLoadVolumeInfo(vifFile)
+	if err != nil {
+		return false, err
+	}
+	volumeInfo.Encryption = &volume_server_pb.VolumeEncryption{KeyId: keyId, WrappedKey: wrapped}
+	if err = volume_info.SaveVolumeInfo(vifFile, volumeInfo); err != nil {
+		return false, fmt.Errorf("save encryption info of ec volume %d: %v", ev.VolumeId, err)
+	}
+	ev.Encryption = volumeInfo.Encryption
+	glog.V(0).Infof("ec volume %d data key re-wrapped with master key %s", ev.VolumeId, keyId)
+	return true, nil
+}
@@ -0,0 +1,119 @@
+package storage
+
+import (
+	"bytes"
+	"encoding/base64"
+	"fmt"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/gateway-dao/seaweedfs/weed/pb/volume_server_pb"
+	"github.com/gateway-dao/seaweedfs/weed/security"
+	"github.com/gateway-dao/seaweedfs/weed/storage/needle"
+	"github.com/gateway-dao/seaweedfs/weed/storage/super_block"
+	"github.com/gateway-dao/seaweedfs/weed/storage/types"
+	"github.com/gateway-dao/seaweedfs/weed/util"
+)
+
+func writeTestKeyring(t *testing.T, path string, primary string, keyIds ...string) {
+	keys := ""
+	for i, keyId := range keyIds {
+		if i > 0 {
+			keys += ","
+		}
+		key := bytes.Repeat([]byte(keyId[len(keyId)-1:]), 32)
+		keys += fmt.Sprintf("%q: %q", keyId, base64.StdEncoding.EncodeToString(key))
+	}
+	content := fmt.Sprintf(`{"primary": %q, "keys": {%s}}`, primary, keys)
+	if err := os.WriteFile(path, []byte(content), 0600); err != nil {
+		t.Fatalf("write keyring: %v", err)
+	}
+}
+
+func TestEncryptedVolume(t *testing.T) {
+	dir := t.TempDir()
+	keyringFile := filepath.Join(dir, "keyring.json")
+	writeTestKeyring(t, keyringFile, "k1", "k1")
+	keyWrapper, err := security.NewKeyWrapper(keyringFile)
+	if err != nil {
+		t.Fatalf("load keyring: %v", err)
+	}
+
+	v, err := NewVolume(dir, dir, "", 1, NeedleMapInMemory, &super_block.ReplicaPlacement{}, &needle.TTL{}, 0, 0, 0)
+	if err != nil {
+		t.Fatalf("volume creation: %v", err)
+	}
+	if err = v.enableEncryption(keyWrapper, nil); err == nil {
+		t.Fatalf("encryption enabled without a wrapped data key")
+	}
+	// wrapped by the master
+	keyId, wrapped, err := keyWrapper.WrapKey(util.GenCipherKey())
+	if err != nil {
+		t.Fatalf("wrap data key: %v", err)
+	}
+	if err = v.enableEncryption(keyWrapper, &volume_server_pb.VolumeEncryption{KeyId: keyId, WrappedKey: wrapped}); err != nil {
+		t.Fatalf("enable encryption: %v", err)
+	}
+
+	fileCount := 100
+	infos := make([]*needleInfo, fileCount)
+	for i := 1; i <= fileCount; i++ {
+		doSomeWritesDeletes(i, v, t, infos)
+	}
+	// the same content is detected as unchanged
+	n := newRandomNeedle(uint64(fileCount + 1))
+	if _, _, _, err = v.writeNeedle2(n, true, false); err != nil {
+		t.Fatalf("write: %v", err)
+	}
+	if _, _, isUnchanged, _ := v.writeNeedle2(n, true, false); !isUnchanged {
+		t.Fatalf("rewriting the same content should be unchanged")
+	}
+
+	blob, size, err := v.ReadNeedleBlobByKey(n.Id)
+	if err != nil {
+		t.Fatalf("read blob: %v", err)
+	}
+	stored := new(needle.Needle)
+	if err = stored.ReadBytes(blob, 0, size, v.Version()); err != nil {
+		t.Fatalf("parse blob: %v", err)
+	}
+	if !stored.IsEncrypted() || bytes.Contains(blob, n.Data) {
+		t.Fatalf("needle data is stored in plain text")
+	}
+
+	// rotate the master key, and reload the volume with the new keyring only
+	writeTestKeyring(t, keyringFile, "k2", "k1", "k2")
+	if err = keyWrapper.Reload(); err != nil {
+		t.Fatalf("reload keyring: %v", err)
+	}
+	if rotated, err := v.rewrapDataKey(keyWrapper); err != nil || !rotated {
+		t.Fatalf("rewrap data key: %v %v", rotated, err)
+	}
+	v.Close()
+	writeTestKeyring(t, keyringFile, "k2", "k2")
+	if err = keyWrapper.Reload(); err != nil {
+		t.Fatalf("reload keyring: %v", err)
+	}
+	if v, err = NewVolume(dir, dir, "", 1, NeedleMapInMemory, nil, nil, 0, 0, 0); err != nil {
+		t.Fatalf("volume reloading: %v", err)
+	}
+	if err = v.loadDataKey(keyWrapper); err != nil {
+		t.Fatalf("load data key: %v", err)
+	}
+
+	for i := 1; i <= fileCount; i++ {
+		if infos[i-1].size == 0 {
+			continue
+		}
+		n := newEmptyNeedle(uint64(i))
+		size, err := v.readNeedle(n, nil, nil)
+		if err != nil {
+			t.Fatalf("read file %d: %v", i, err)
+		}
+		if infos[i-1].size != types.Size(size) || infos[i-1].crc != n.Checksum {
+			t.Fatalf("read file %d mismatch", i)
+		}
+	}
+	v.Close()
+}
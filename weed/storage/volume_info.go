@@ -25,6 +25,9 @@ type VolumeInfo struct {
 	ModifiedAtSecond  int64
 	RemoteStorageName string
 	RemoteStorageKey  string
+
+	QuarantinedNeedleCount uint64
+	ReplicationLagSeconds  uint32
 }
 
 func NewVolumeInfo(m *master_pb.VolumeInformationMessage) (vi VolumeInfo, err error) {
@@ -42,6 +45,9 @@ func NewVolumeInfo(m *master_pb.VolumeInformationMessage) (vi VolumeInfo, err er
 		RemoteStorageName: m.RemoteStorageName,
 		RemoteStorageKey:  m.RemoteStorageKey,
 		DiskType:          m.DiskType,
+
+		QuarantinedNeedleCount: m.QuarantinedNeedleCount,
+		ReplicationLagSeconds:  m.ReplicationLagSeconds,
 	}
 	rp, e := super_block.NewReplicaPlacementFromByte(byte(m.ReplicaPlacement))
 	if e != nil {
@@ -94,6 +100,9 @@ func (vi VolumeInfo) ToVolumeInformationMessage() *master_pb.VolumeInformationMe
 		RemoteStorageName: vi.RemoteStorageName,
 		RemoteStorageKey:  vi.RemoteStorageKey,
 		DiskType:          vi.DiskType,
+
+		QuarantinedNeedleCount: vi.QuarantinedNeedleCount,
+		ReplicationLagSeconds:  vi.ReplicationLagSeconds,
 	}
 }
 
@@ -26,6 +26,7 @@ func loadVolumeWithoutIndex(dirname string, collection string, id needle.VolumeI
 
 func (v *Volume) load(alsoLoadIndex bool, createDatIfMissing bool, needleMapKind NeedleMapKind, preallocate int64) (err error) {
 	alreadyHasSuperBlock := false
+	v.uncacheVolume()
 
 	hasLoadedVolume := false
 	defer func() {
@@ -43,6 +44,13 @@ func (v *Volume) load(alsoLoadIndex bool, createDatIfMissing bool, needleMapKind
 
 	hasVolumeInfoFile := v.maybeLoadVolumeInfo()
 
+	var pendingCompaction *compactCheckpoint
+	if alsoLoadIndex && !v.HasRemoteFile() {
+		if pendingCompaction, err = v.recoverSegmentedCompactionData(); err != nil {
+			return fmt.Errorf("recover compaction of volume %d: %v", v.Id, err)
+		}
+	}
+
 	if v.HasRemoteFile() {
 		v.noWriteCanDelete = true
 		v.noWriteOrDelete = false
@@ -66,10 +74,13 @@ func (v *Volume) load(alsoLoadIndex bool, createDatIfMissing bool, needleMapKind
 		if fileSize >= super_block.SuperBlockSize {
 			alreadyHasSuperBlock = true
 		}
-		v.DataBackend = backend.NewDiskFile(dataFile)
+		v.DataBackend = backend.WithDiskIo(backend.NewDiskFile(dataFile), v.diskIo)
 	} else {
 		if createDatIfMissing {
 			v.DataBackend, err = backend.CreateVolumeFile(v.FileName(".dat"), preallocate, v.MemoryMapMaxSizeMb)
+			if err == nil {
+				v.DataBackend = backend.WithDiskIo(v.DataBackend, v.diskIo)
+			}
 		} else {
 			return fmt.Errorf("volume data file %s does not exist", v.FileName(".dat"))
 		}
@@ -127,72 +138,24 @@ func (v *Volume) load(alsoLoadIndex bool, createDatIfMissing bool, needleMapKind
 			v.noWriteOrDelete = true
 			glog.V(0).Infof("volumeDataIntegrityChecking failed %v", err)
 		}
+		if err := v.loadQuarantine(); err != nil {
+			glog.Warningf("volume %d load quarantine index: %v", v.Id, err)
+		}
+		v.linkedSnapshots = v.countLinkedSnapshots()
 
 		if v.noWriteOrDelete || v.noWriteCanDelete {
-			if v.nm, err = NewSortedFileNeedleMap(v.IndexFileName(), indexFile); err != nil {
+			if v.nm, err = newReadonlyNeedleMap(v.IndexFileName(), indexFile); err != nil {
 				glog.V(0).Infof("loading sorted db %s error: %v", v.FileName(".sdx"), err)
 			}
 		} else {
-			switch needleMapKind {
-			case NeedleMapInMemory:
-				if v.tmpNm != nil {
-					glog.V(0).Infof("updating memory compact index %s ", v.FileName(".idx"))
-					err = v.tmpNm.UpdateNeedleMap(v, indexFile, nil, 0)
-				} else {
-					glog.V(0).Infoln("loading memory index", v.FileName(".idx"), "to memory")
-					if v.nm, err = LoadCompactNeedleMap(indexFile); err != nil {
-						glog.V(0).Infof("loading index %s to memory error: %v", v.FileName(".idx"), err)
-					}
-				}
-			case NeedleMapLevelDb:
-				opts := &opt.Options{
-					BlockCacheCapacity:            2 * 1024 * 1024, // default value is 8MiB
-					WriteBuffer:                   1 * 1024 * 1024, // default value is 4MiB
-					CompactionTableSizeMultiplier: 10,              // default value is 1
-				}
-				if v.tmpNm != nil {
-					glog.V(0).Infoln("updating leveldb index", v.FileName(".ldb"))
-					err = v.tmpNm.UpdateNeedleMap(v, indexFile, opts, v.ldbTimeout)
-				} else {
-					glog.V(0).Infoln("loading leveldb index", v.FileName(".ldb"))
-					if v.nm, err = NewLevelDbNeedleMap(v.FileName(".ldb"), indexFile, opts, v.ldbTimeout); err != nil {
-						glog.V(0).Infof("loading leveldb %s error: %v", v.FileName(".ldb"), err)
-					}
-				}
-			case NeedleMapLevelDbMedium:
-				opts := &opt.Options{
-					BlockCacheCapacity:            4 * 1024 * 1024, // default value is 8MiB
-					WriteBuffer:                   2 * 1024 * 1024, // default value is 4MiB
-					CompactionTableSizeMultiplier: 10,              // default value is 1
-				}
-				if v.tmpNm != nil {
-					glog.V(0).Infoln("updating leveldb medium index", v.FileName(".ldb"))
-					err = v.tmpNm.UpdateNeedleMap(v, indexFile, opts, v.ldbTimeout)
-				} else {
-					glog.V(0).Infoln("loading leveldb medium index", v.FileName(".ldb"))
-					if v.nm, err = NewLevelDbNeedleMap(v.FileName(".ldb"), indexFile, opts, v.ldbTimeout); err != nil {
-						glog.V(0).Infof("loading leveldb %s error: %v", v.FileName(".ldb"), err)
-					}
-				}
-			case NeedleMapLevelDbLarge:
-				opts := &opt.Options{
-					BlockCacheCapacity:            8 * 1024 * 1024, // default value is 8MiB
-					WriteBuffer:                   4 * 1024 * 1024, // default value is 4MiB
-					CompactionTableSizeMultiplier: 10,              // default value is 1
-				}
-				if v.tmpNm != nil {
-					glog.V(0).Infoln("updating leveldb large index", v.FileName(".ldb"))
-					err = v.tmpNm.UpdateNeedleMap(v, indexFile, opts, v.ldbTimeout)
-				} else {
-					glog.V(0).Infoln("loading leveldb large index", v.FileName(".ldb"))
-					if v.nm, err = NewLevelDbNeedleMap(v.FileName(".ldb"), indexFile, opts, v.ldbTimeout); err != nil {
-						glog.V(0).Infof("loading leveldb %s error: %v", v.FileName(".ldb"), err)
-					}
-				}
-			}
+			err = v.loadWritableNeedleMap(indexFile, needleMapKind)
 		}
 	}
 
+	if err == nil && pendingCompaction != nil && v.nm != nil {
+		err = v.applyCompactMoves(pendingCompaction)
+	}
+
 	if !hasVolumeInfoFile {
 		v.volumeInfo.Version = uint32(v.SuperBlock.Version)
 		v.volumeInfo.BytesOffset = uint32(types.OffsetSize)
@@ -209,3 +172,142 @@ func (v *Volume) load(alsoLoadIndex bool, createDatIfMissing bool, needleMapKind
 
 	return err
 }
+
+// setDiskIo changes how the local .dat file is read. Reloads keep the mode.
+func (v *Volume) setDiskIo(mode backend.DiskIoMode) {
+	v.dataFileAccessLock.Lock()
+	defer v.dataFileAccessLock.Unlock()
+
+	v.diskIo = mode
+	if v.DataBackend != nil {
+		v.DataBackend = backend.WithDiskIo(v.DataBackend, mode)
+	}
+}
+
+func (v *Volume) loadWritableNeedleMap(indexFile *os.File, needleMapKind NeedleMapKind) (err error) {
+	switch needleMapKind {
+	case NeedleMapInMemory:
+		if v.tmpNm != nil {
+			glog.V(0).Infof("updating memory compact index %s ", v.FileName(".idx"))
+			err = v.tmpNm.UpdateNeedleMap(v, indexFile, nil, 0)
+		} else {
+			glog.V(0).Infoln("loading memory index", v.FileName(".idx"), "to memory")
+			if v.nm, err = LoadCompactNeedleMap(indexFile); err != nil {
+				glog.V(0).Infof("loading index %s to memory error: %v", v.FileName(".idx"), err)
+			}
+		}
+	case NeedleMapLevelDb:
+		opts := &opt.Options{
+			BlockCacheCapacity:            2 * 1024 * 1024, // default value is 8MiB
+			WriteBuffer:                   1 * 1024 * 1024, // default value is 4MiB
+			CompactionTableSizeMultiplier: 10,              // default value is 1
+		}
+		if v.tmpNm != nil {
+			glog.V(0).Infoln("updating leveldb index", v.FileName(".ldb"))
+			err = v.tmpNm.UpdateNeedleMap(v, indexFile, opts, v.ldbTimeout)
+		} else {
+			glog.V(0).Infoln("loading leveldb index", v.FileName(".ldb"))
+			if v.nm, err = NewLevelDbNeedleMap(v.FileName(".ldb"), indexFile, opts, v.ldbTimeout); err != nil {
+				glog.V(0).Infof("loading leveldb %s error: %v", v.FileName(".ldb"), err)
+			}
+		}
+	case NeedleMapLevelDbMedium:
+		opts := &opt.Options{
+			BlockCacheCapacity:            4 * 1024 * 1024, // default value is 8MiB
+			WriteBuffer:                   2 * 1024 * 1024, // default value is 4MiB
+			CompactionTableSizeMultiplier: 10,              // default value is 1
+		}
+		if v.tmpNm != nil {
+			glog.V(0).Infoln("updating leveldb medium index", v.FileName(".ldb"))
+			err = v.tmpNm.UpdateNeedleMap(v, indexFile, opts, v.ldbTimeout)
+		} else {
+			glog.V(0).Infoln("loading leveldb medium index", v.FileName(".ldb"))
+			if v.nm, err = NewLevelDbNeedleMap(v.FileName(".ldb"), indexFile, opts, v.ldbTimeout); err != nil {
+				glog.V(0).Infof("loading leveldb %s error: %v", v.FileName(".ldb"), err)
+			}
+		}
+	case NeedleMapLevelDbLarge:
+		opts := &opt.Options{
+			BlockCacheCapacity:            8 * 1024 * 1024, // default value is 8MiB
+			WriteBuffer:                   4 * 1024 * 1024, // default value is 4MiB
+			CompactionTableSizeMultiplier: 10,              // default value is 1
+		}
+		if v.tmpNm != nil {
+			glog.V(0).Infoln("updating leveldb large index", v.FileName(".ldb"))
+			err = v.tmpNm.UpdateNeedleMap(v, indexFile, opts, v.ldbTimeout)
+		} else {
+			glog.V(0).Infoln("loading leveldb large index", v.FileName(".ldb"))
+			if v.nm, err = NewLevelDbNeedleMap(v.FileName(".ldb"), indexFile, opts, v.ldbTimeout); err != nil {
+				glog.V(0).Infof("loading leveldb %s error: %v", v.FileName(".ldb"), err)
+			}
+		}
+	}
+	return
+}
+
+// useReadonlyNeedleMap replaces the needle map of a volume marked readonly
+// with the memory mapped sorted index, to free the memory of the needle map.
+func (v *Volume) useReadonlyNeedleMap() error {
+	// the sorted index is built without blocking the reads
+	v.dataFileAccessLock.RLock()
+	if v.nm == nil || isReadonlyNeedleMap(v.nm) || v.tmpNm != nil || !v.isMarkedReadonly() {
+		v.dataFileAccessLock.RUnlock()
+		return nil
+	}
+	indexFile, err := os.OpenFile(v.FileName(".idx"), os.O_RDWR, 0644)
+	if err == nil && !isSortedMetaFresh(v.IndexFileName(), indexFile) {
+		err = writeSortedIndexWithMeta(v.IndexFileName(), indexFile)
+	}
+	v.dataFileAccessLock.RUnlock()
+	if err != nil {
+		if indexFile != nil {
+			indexFile.Close()
+		}
+		return fmt.Errorf("sort index of volume %d: %v", v.Id, err)
+	}
+
+	v.dataFileAccessLock.Lock()
+	defer v.dataFileAccessLock.Unlock()
+	if v.nm == nil || isReadonlyNeedleMap(v.nm) || v.tmpNm != nil || !v.isMarkedReadonly() {
+		indexFile.Close()
+		return nil
+	}
+	// rebuilt if the volume was written in between
+	nm, err := NewMmapSortedNeedleMap(v.IndexFileName(), indexFile)
+	if err != nil {
+		indexFile.Close()
+		return fmt.Errorf("memory map sorted index of volume %d: %v", v.Id, err)
+	}
+	v.nm.Close()
+	v.nm = nm
+	glog.V(1).Infof("volume %d uses the memory mapped sorted index", v.Id)
+	return nil
+}
+
+// useWritableNeedleMap loads the needle map of the volume kind again, when a
+// volume using the sorted index is marked writable.
+func (v *Volume) useWritableNeedleMap() error {
+	v.dataFileAccessLock.Lock()
+	defer v.dataFileAccessLock.Unlock()
+	if v.nm == nil || !isReadonlyNeedleMap(v.nm) || v.isMarkedReadonly() {
+		return nil
+	}
+	indexFile, err := os.OpenFile(v.FileName(".idx"), os.O_RDWR|os.O_CREATE, 0644)
+	if err != nil {
+		return fmt.Errorf("cannot write Volume Index %s: %v", v.FileName(".idx"), err)
+	}
+	readonlyNm := v.nm
+	if err = v.loadWritableNeedleMap(indexFile, v.needleMapKind); err != nil || v.nm == readonlyNm {
+		v.nm = readonlyNm
+		indexFile.Close()
+		return fmt.Errorf("load index of volume %d: %v", v.Id, err)
+	}
+	readonlyNm.Close()
+	return nil
+}
+
+func (v *Volume) isMarkedReadonly() bool {
+	v.noWriteLock.RLock()
+	defer v.noWriteLock.RUnlock()
+	return v.noWriteOrDelete || v.noWriteCanDelete
+}
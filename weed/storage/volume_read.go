@@ -41,6 +41,7 @@ func (v *Volume) readNeedle(n *needle.Needle, readOption *ReadOption, onReadSize
 	}
 	if readOption != nil && readOption.AttemptMetaOnly && readSize > PagedReadLimit {
 		readOption.VolumeRevision = v.SuperBlock.CompactionRevision
+		readOption.compactedSegments = v.compactedSegments
 		err = n.ReadNeedleMeta(v.DataBackend, nv.Offset.ToActualOffset(), readSize, v.Version())
 		if err == needle.ErrorSizeMismatch && OffsetSize == 4 {
 			readOption.IsOutOfRange = true
@@ -49,16 +50,22 @@ func (v *Volume) readNeedle(n *needle.Needle, readOption *ReadOption, onReadSize
 		if err != nil {
 			return 0, err
 		}
-		if !n.IsCompressed() && !n.IsChunkedManifest() {
+		if !n.IsCompressed() && !n.IsChunkedManifest() && !n.IsEncrypted() && !n.IsAppendContinuation() {
 			readOption.IsMetaOnly = true
 		}
 	}
 	if readOption == nil || !readOption.IsMetaOnly {
-		err = n.ReadData(v.DataBackend, nv.Offset.ToActualOffset(), readSize, v.Version())
+		err = v.readNeedleData(n, nv.Offset.ToActualOffset(), readSize)
 		v.checkReadWriteError(err)
 		if err != nil {
 			return 0, err
 		}
+		if err = decryptNeedle(n, v.dataKey); err != nil {
+			return 0, err
+		}
+		if err = n.ResolveAppendChain(v.appendChainReader(v.DataBackend)); err != nil {
+			return 0, err
+		}
 	}
 	count = int(n.DataSize)
 	if !n.HasTtl() {
@@ -143,7 +150,7 @@ func (v *Volume) readNeedleDataInto(n *needle.Needle, readOption *ReadOption, wr
 			v.dataFileAccessLock.RLock()
 		}
 		// possibly re-read needle offset if volume is compacted
-		if readOption.VolumeRevision != v.SuperBlock.CompactionRevision {
+		if readOption.VolumeRevision != v.SuperBlock.CompactionRevision || readOption.compactedSegments != v.compactedSegments {
 			// the volume is compacted
 			nv, ok = v.nm.Get(n.Id)
 			if !ok || nv.Offset.IsZero() {
@@ -154,6 +161,7 @@ func (v *Volume) readNeedleDataInto(n *needle.Needle, readOption *ReadOption, wr
 			}
 			actualOffset = nv.Offset.ToActualOffset()
 			readOption.VolumeRevision = v.SuperBlock.CompactionRevision
+			readOption.compactedSegments = v.compactedSegments
 		}
 		count, err := n.ReadNeedleData(v.DataBackend, actualOffset, buf, x)
 		if readOption.HasSlowRead {
@@ -201,6 +209,39 @@ func (v *Volume) ReadNeedleBlob(offset int64, size Size) ([]byte, error) {
 	return needle.ReadNeedleBlob(v.DataBackend, offset, size, v.Version())
 }
 
+// ReadNeedleBlobByKey reads the raw bytes of the latest version of the needle.
+// Appended needles are returned as a single needle.
+func (v *Volume) ReadNeedleBlobByKey(key NeedleId) ([]byte, Size, error) {
+	v.dataFileAccessLock.RLock()
+	defer v.dataFileAccessLock.RUnlock()
+
+	nv, ok := v.nm.Get(key)
+	if !ok || nv.Offset.IsZero() {
+		return nil, 0, ErrorNotFound
+	}
+	if nv.Size.IsDeleted() {
+		return nil, 0, ErrorDeleted
+	}
+	blob, err := needle.ReadNeedleBlob(v.DataBackend, nv.Offset.ToActualOffset(), nv.Size, v.Version())
+	if err != nil {
+		return nil, 0, err
+	}
+
+	// the links of continuation needles are only valid in this volume file
+	n := new(needle.Needle)
+	if err = n.ReadBytes(blob, nv.Offset.ToActualOffset(), nv.Size, v.Version()); err != nil || !n.IsAppendContinuation() {
+		return blob, nv.Size, err
+	}
+	flat, err := v.flattenAppendChain(n, v.DataBackend)
+	if err != nil {
+		return nil, 0, err
+	}
+	if blob, err = flat.ToBlob(v.Version()); err != nil {
+		return nil, 0, err
+	}
+	return blob, flat.Size, nil
+}
+
 type VolumeFileScanner interface {
 	VisitSuperBlock(super_block.SuperBlock) error
 	ReadNeedleBody() bool
@@ -264,3 +305,30 @@ func ScanVolumeFileFrom(version needle.Version, datBackend backend.BackendStorag
 	}
 	return nil
 }
+
+// ScanVolumeFileRange visits the needles in [offset, stopOffset).
+func ScanVolumeFileRange(version needle.Version, datBackend backend.BackendStorageFile, offset, stopOffset int64, volumeFileScanner VolumeFileScanner) error {
+	for offset < stopOffset {
+		n, nh, rest, err := needle.ReadNeedleHeader(datBackend, version, offset)
+		if err != nil {
+			if err == io.EOF {
+				return nil
+			}
+			return fmt.Errorf("cannot read needle header at offset %d: %v", offset, err)
+		}
+		var needleBody []byte
+		if volumeFileScanner.ReadNeedleBody() {
+			if needleBody, err = n.ReadNeedleBody(datBackend, version, offset+NeedleHeaderSize, rest); err != nil {
+				return fmt.Errorf("cannot read needle body [%d, %d): %v", offset+NeedleHeaderSize, offset+NeedleHeaderSize+rest, err)
+			}
+		}
+		if err = volumeFileScanner.VisitNeedle(n, offset, nh, needleBody); err != nil {
+			if err == io.EOF {
+				return nil
+			}
+			return fmt.Errorf("visit needle error: %v", err)
+		}
+		offset += NeedleHeaderSize + rest
+	}
+	return nil
+}
@@ -28,6 +28,12 @@ func (scanner *VolumeFileScanner4ReadAll) VisitNeedle(n *needle.Needle, offset i
 	if nv.Offset.ToActualOffset() != offset {
 		return nil
 	}
+	if err := decryptNeedle(n, scanner.V.dataKey); err != nil {
+		return err
+	}
+	if err := n.ResolveAppendChain(scanner.V.appendChainReader(scanner.V.DataBackend)); err != nil {
+		return err
+	}
 
 	sendErr := scanner.Stream.Send(&volume_server_pb.ReadAllNeedlesResponse{
 		VolumeId:             uint32(scanner.V.Id),
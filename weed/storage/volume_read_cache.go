@@ -0,0 +1,72 @@
+package storage
+
+import (
+	"github.com/gateway-dao/seaweedfs/weed/storage/needle"
+	. "github.com/gateway-dao/seaweedfs/weed/storage/types"
+)
+
+// NeedleCache keeps needle records recently read from the volumes, as stored
+// on disk, so encrypted needles stay encrypted in the cache. A record is
+// looked up with its current offset from the needle map, so overwritten and
+// deleted needles miss even before they are removed from the cache.
+type NeedleCache interface {
+	// MaxNeedleSize is the largest record size worth caching.
+	MaxNeedleSize() Size
+	GetNeedle(vid needle.VolumeId, id NeedleId, offset int64) []byte
+	SetNeedle(vid needle.VolumeId, id NeedleId, offset int64, record []byte)
+	DeleteNeedle(vid needle.VolumeId, id NeedleId)
+	// DeleteVolume drops all records of the volume, whose offsets are reused
+	// after compaction or when the volume is loaded again.
+	DeleteVolume(vid needle.VolumeId)
+}
+
+// SetNeedleCache makes all volumes of the store read through the cache.
+func (s *Store) SetNeedleCache(cache NeedleCache) {
+	for _, location := range s.Locations {
+		location.volumesLock.Lock()
+		location.needleCache = cache
+		for _, v := range location.volumes {
+			v.needleCache = cache
+		}
+		location.volumesLock.Unlock()
+	}
+}
+
+// readNeedleData reads the needle record at offset, through the needle cache if any.
+func (v *Volume) readNeedleData(n *needle.Needle, offset int64, size Size) error {
+	cache := v.needleCache
+	if cache == nil || size > cache.MaxNeedleSize() {
+		return n.ReadData(v.DataBackend, offset, size, v.Version())
+	}
+
+	if record := cache.GetNeedle(v.Id, n.Id, offset); record != nil {
+		// the checksum is verified again, in case the cache is corrupted
+		if err := n.ReadBytes(record, offset, size, v.Version()); err == nil {
+			return nil
+		}
+		cache.DeleteNeedle(v.Id, n.Id)
+	}
+
+	record, err := needle.ReadNeedleBlob(v.DataBackend, offset, size, v.Version())
+	if err != nil {
+		return err
+	}
+	if err = n.ReadBytes(record, offset, size, v.Version()); err != nil {
+		// the rare needles beyond the 4 bytes offset limit are not cached
+		return n.ReadData(v.DataBackend, offset, size, v.Version())
+	}
+	cache.SetNeedle(v.Id, n.Id, offset, record)
+	return nil
+}
+
+func (v *Volume) uncacheNeedle(id NeedleId) {
+	if v.needleCache != nil {
+		v.needleCache.DeleteNeedle(v.Id, id)
+	}
+}
+
+func (v *Volume) uncacheVolume() {
+	if v.needleCache != nil {
+		v.needleCache.DeleteVolume(v.Id)
+	}
+}
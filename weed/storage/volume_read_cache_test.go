@@ -0,0 +1,89 @@
+package storage
+
+import (
+	"bytes"
+	"fmt"
+	"sync"
+	"testing"
+
+	"github.com/gateway-dao/seaweedfs/weed/storage/needle"
+	"github.com/gateway-dao/seaweedfs/weed/storage/super_block"
+	"github.com/gateway-dao/seaweedfs/weed/storage/types"
+)
+
+type testNeedleCache struct {
+	sync.Mutex
+	records map[string][]byte
+	hits    int
+}
+
+func (c *testNeedleCache) MaxNeedleSize() types.Size { return 1024 * 1024 }
+
+func (c *testNeedleCache) GetNeedle(vid needle.VolumeId, id types.NeedleId, offset int64) []byte {
+	c.Lock()
+	defer c.Unlock()
+	record, found := c.records[fmt.Sprintf("%d,%d,%d", vid, id, offset)]
+	if found {
+		c.hits++
+	}
+	return record
+}
+
+func (c *testNeedleCache) SetNeedle(vid needle.VolumeId, id types.NeedleId, offset int64, record []byte) {
+	c.Lock()
+	defer c.Unlock()
+	c.records[fmt.Sprintf("%d,%d,%d", vid, id, offset)] = record
+}
+
+func (c *testNeedleCache) DeleteNeedle(vid needle.VolumeId, id types.NeedleId) {}
+
+func (c *testNeedleCache) DeleteVolume(vid needle.VolumeId) {
+	c.Lock()
+	defer c.Unlock()
+	c.records = make(map[string][]byte)
+}
+
+func TestVolumeReadThroughNeedleCache(t *testing.T) {
+	dir := t.TempDir()
+	v, err := NewVolume(dir, dir, "", 1, NeedleMapInMemory, &super_block.ReplicaPlacement{}, &needle.TTL{}, 0, 0, 0)
+	if err != nil {
+		t.Fatalf("volume creation: %v", err)
+	}
+	defer v.Close()
+	cache := &testNeedleCache{records: make(map[string][]byte)}
+	v.needleCache = cache
+
+	n := newRandomNeedle(1)
+	if _, _, _, err = v.writeNeedle2(n, true, false); err != nil {
+		t.Fatalf("write: %v", err)
+	}
+	read := func() *needle.Needle {
+		r := newEmptyNeedle(1)
+		if _, err := v.readNeedle(r, nil, nil); err != nil {
+			t.Fatalf("read: %v", err)
+		}
+		return r
+	}
+	read()
+	if r := read(); cache.hits != 1 || !bytes.Equal(r.Data, n.Data) {
+		t.Fatalf("second read should hit the cache: hits %d", cache.hits)
+	}
+
+	// overwritten needles are read from the new offset
+	overwrite := newRandomNeedle(1)
+	overwrite.Data = append(overwrite.Data, 'x')
+	overwrite.Checksum = needle.NewCRC(overwrite.Data)
+	if _, _, _, err = v.writeNeedle2(overwrite, true, false); err != nil {
+		t.Fatalf("overwrite: %v", err)
+	}
+	if r := read(); !bytes.Equal(r.Data, overwrite.Data) {
+		t.Fatalf("read stale data after overwrite")
+	}
+
+	if _, err = v.deleteNeedle2(newEmptyNeedle(1)); err != nil {
+		t.Fatalf("delete: %v", err)
+	}
+	if _, err = v.readNeedle(newEmptyNeedle(1), nil, nil); err != ErrorDeleted {
+		t.Fatalf("read deleted needle: %v", err)
+	}
+}
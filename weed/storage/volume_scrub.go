@@ -0,0 +1,268 @@
+package storage
+
+import (
+	"bytes"
+	"fmt"
+	"os"
+
+	"github.com/gateway-dao/seaweedfs/weed/glog"
+	"github.com/gateway-dao/seaweedfs/weed/storage/idx"
+	"github.com/gateway-dao/seaweedfs/weed/storage/needle"
+	"github.com/gateway-dao/seaweedfs/weed/storage/needle_map"
+	. "github.com/gateway-dao/seaweedfs/weed/storage/types"
+	"github.com/gateway-dao/seaweedfs/weed/util"
+)
+
+// ScrubCorruptedFunc is called for each needle that fails verification during scrubbing
+type ScrubCorruptedFunc func(key NeedleId, offset Offset, size Size, err error)
+
+// the quarantine index uses the same layout as the .idx file,
+// one entry per needle that failed verification and has not been repaired yet
+func (v *Volume) loadQuarantine() error {
+	v.quarantineLock.Lock()
+	defer v.quarantineLock.Unlock()
+
+	v.quarantine = make(map[NeedleId]needle_map.NeedleValue)
+
+	qdxFile, err := os.OpenFile(v.FileName(".qdx"), os.O_RDONLY, 0644)
+	if os.IsNotExist(err) {
+		return nil
+	}
+	if err != nil {
+		return fmt.Errorf("open quarantine index %s: %v", v.FileName(".qdx"), err)
+	}
+	defer qdxFile.Close()
+
+	return idx.WalkIndexFile(qdxFile, 0, func(key NeedleId, offset Offset, size Size) error {
+		v.quarantine[key] = needle_map.NeedleValue{Key: key, Offset: offset, Size: size}
+		return nil
+	})
+}
+
+func (v *Volume) saveQuarantine() error {
+	if len(v.quarantine) == 0 {
+		if err := os.Remove(v.FileName(".qdx")); err != nil && !os.IsNotExist(err) {
+			return err
+		}
+		return nil
+	}
+	var buf bytes.Buffer
+	for _, nv := range v.quarantine {
+		buf.Write(nv.ToBytes())
+	}
+	return util.WriteFile(v.FileName(".qdx"), buf.Bytes(), 0644)
+}
+
+func (v *Volume) quarantineNeedle(key NeedleId, offset Offset, size Size) {
+	v.quarantineLock.Lock()
+	defer v.quarantineLock.Unlock()
+
+	if v.quarantine == nil {
+		v.quarantine = make(map[NeedleId]needle_map.NeedleValue)
+	}
+	if nv, found := v.quarantine[key]; found && nv.Offset == offset && nv.Size == size {
+		return
+	}
+	v.quarantine[key] = needle_map.NeedleValue{Key: key, Offset: offset, Size: size}
+	if err := v.saveQuarantine(); err != nil {
+		glog.Warningf("volume %d save quarantine index: %v", v.Id, err)
+	}
+}
+
+func (v *Volume) releaseQuarantinedNeedle(key NeedleId) {
+	v.quarantineLock.Lock()
+	defer v.quarantineLock.Unlock()
+
+	if _, found := v.quarantine[key]; !found {
+		return
+	}
+	delete(v.quarantine, key)
+	if err := v.saveQuarantine(); err != nil {
+		glog.Warningf("volume %d save quarantine index: %v", v.Id, err)
+	}
+}
+
+// IsQuarantined returns true if the needle failed verification and has not been repaired
+func (v *Volume) IsQuarantined(key NeedleId) bool {
+	v.quarantineLock.RLock()
+	defer v.quarantineLock.RUnlock()
+	_, found := v.quarantine[key]
+	return found
+}
+
+func (v *Volume) QuarantinedNeedleCount() int {
+	v.quarantineLock.RLock()
+	defer v.quarantineLock.RUnlock()
+	return len(v.quarantine)
+}
+
+func (v *Volume) QuarantinedNeedleIds() (keys []NeedleId) {
+	v.quarantineLock.RLock()
+	defer v.quarantineLock.RUnlock()
+	for key := range v.quarantine {
+		keys = append(keys, key)
+	}
+	return
+}
+
+// Scrub walks through the index in append order and verifies the CRC of every live needle in the .dat file.
+// Needles that fail verification are added to the quarantine index and reported via onCorruptedFn.
+// Needles that were quarantined earlier and now pass verification are released.
+func (v *Volume) Scrub(throttler *util.WriteThrottler, onCorruptedFn ScrubCorruptedFunc) (scannedCount, scannedBytes uint64, err error) {
+	if v.HasRemoteFile() {
+		// avoid downloading the whole remote file
+		return 0, 0, nil
+	}
+
+	v.dataFileAccessLock.RLock()
+	if v.nm == nil {
+		v.dataFileAccessLock.RUnlock()
+		return 0, 0, fmt.Errorf("volume %d is not loaded", v.Id)
+	}
+	compactionRevision := v.SuperBlock.CompactionRevision
+	entryCount := int64(v.nm.IndexFileSize() / NeedleMapEntrySize)
+	v.dataFileAccessLock.RUnlock()
+
+	for i := int64(0); i < entryCount; i++ {
+		key, offset, size, verifyErr, isLive, readErr := v.scrubIndexEntry(i, compactionRevision)
+		if readErr != nil {
+			return scannedCount, scannedBytes, readErr
+		}
+		if !isLive {
+			continue
+		}
+		scannedCount++
+		scannedBytes += uint64(size)
+		throttler.MaybeSlowdown(int64(size))
+
+		if verifyErr == nil {
+			v.releaseQuarantinedNeedle(key)
+			continue
+		}
+		glog.Warningf("scrub volume %d needle %s offset %d size %d: %v", v.Id, key, offset.ToActualOffset(), size, verifyErr)
+		v.quarantineNeedle(key, offset, size)
+		if onCorruptedFn != nil {
+			onCorruptedFn(key, offset, size, verifyErr)
+		}
+	}
+
+	v.releaseStaleQuarantinedNeedles()
+
+	return
+}
+
+// quarantined needles that are deleted or overwritten since are no longer a concern
+func (v *Volume) releaseStaleQuarantinedNeedles() {
+	for _, key := range v.QuarantinedNeedleIds() {
+		v.quarantineLock.RLock()
+		quarantined := v.quarantine[key]
+		v.quarantineLock.RUnlock()
+		if offset, size, err := v.needleLocation(key); err == nil && offset == quarantined.Offset && size == quarantined.Size {
+			continue
+		}
+		v.releaseQuarantinedNeedle(key)
+	}
+}
+
+func (v *Volume) scrubIndexEntry(i int64, compactionRevision uint16) (key NeedleId, offset Offset, size Size, verifyErr error, isLive bool, err error) {
+	v.dataFileAccessLock.RLock()
+	defer v.dataFileAccessLock.RUnlock()
+
+	if v.nm == nil || v.DataBackend == nil {
+		return 0, Offset{}, 0, nil, false, fmt.Errorf("volume %d is closed", v.Id)
+	}
+	if v.SuperBlock.CompactionRevision != compactionRevision {
+		return 0, Offset{}, 0, nil, false, fmt.Errorf("volume %d is compacted during scrubbing", v.Id)
+	}
+
+	if key, offset, size, err = v.nm.ReadIndexEntry(i); err != nil {
+		return 0, Offset{}, 0, nil, false, fmt.Errorf("read index entry %d: %v", i, err)
+	}
+	if offset.IsZero() || size.IsDeleted() {
+		return
+	}
+	// only the latest entry of a needle is verified
+	nv, ok := v.nm.Get(key)
+	if !ok || nv.Offset != offset || nv.Size != size {
+		return
+	}
+	isLive = true
+
+	n := new(needle.Needle)
+	if verifyErr = n.ReadData(v.DataBackend, offset.ToActualOffset(), size, v.Version()); verifyErr == nil && n.Id != key {
+		verifyErr = fmt.Errorf("index key %v does not match needle's Id %v", key, n.Id)
+	}
+	return
+}
+
+// repairNeedleBlob overwrites a corrupted needle in place with a verified copy of the same needle.
+// The local append timestamp is kept, so the .idx order and incremental copy stay intact.
+func (v *Volume) repairNeedleBlob(key NeedleId, offset Offset, size Size, needleBlob []byte) error {
+	v.dataFileAccessLock.Lock()
+	defer v.dataFileAccessLock.Unlock()
+
+	if v.nm == nil || v.DataBackend == nil {
+		return fmt.Errorf("volume %d is closed", v.Id)
+	}
+	if v.HasRemoteFile() {
+		return fmt.Errorf("volume %d is tiered to remote storage", v.Id)
+	}
+	nv, ok := v.nm.Get(key)
+	if !ok || nv.Offset != offset || nv.Size != size {
+		return fmt.Errorf("needle %s has changed during repair", key)
+	}
+
+	version := v.Version()
+	n := new(needle.Needle)
+	if err := n.ReadBytes(needleBlob, offset.ToActualOffset(), size, version); err != nil {
+		return fmt.Errorf("verify needle blob: %v", err)
+	}
+	if n.Id != key {
+		return fmt.Errorf("needle blob id %v does not match %v", n.Id, key)
+	}
+
+	// header + body + checksum, leaving the timestamp and padding untouched
+	repairLength := NeedleHeaderSize + int(size) + needle.NeedleChecksumSize
+	if len(needleBlob) < repairLength {
+		return fmt.Errorf("needle blob has %d bytes, expected at least %d", len(needleBlob), repairLength)
+	}
+	actualOffset := offset.ToActualOffset()
+	if _, err := v.DataBackend.WriteAt(needleBlob[:repairLength], actualOffset); err != nil {
+		v.checkReadWriteError(err)
+		return fmt.Errorf("write needle %s at %d: %v", key, actualOffset, err)
+	}
+	if err := v.DataBackend.Sync(); err != nil {
+		return fmt.Errorf("sync volume %d: %v", v.Id, err)
+	}
+
+	if err := new(needle.Needle).ReadData(v.DataBackend, actualOffset, size, version); err != nil {
+		return fmt.Errorf("verify repaired needle %s: %v", key, err)
+	}
+
+	v.releaseQuarantinedNeedle(key)
+	return nil
+}
+
+// HasNeedleEntry tells whether the local index has an entry for the needle, even a deleted one.
+func (v *Volume) HasNeedleEntry(key NeedleId) bool {
+	_, _, err := v.needleLocation(key)
+	return err != ErrorNotFound
+}
+
+// needleLocation returns the offset and size of the latest version of the needle
+func (v *Volume) needleLocation(key NeedleId) (offset Offset, size Size, err error) {
+	v.dataFileAccessLock.RLock()
+	defer v.dataFileAccessLock.RUnlock()
+
+	if v.nm == nil {
+		return Offset{}, 0, fmt.Errorf("volume %d is closed", v.Id)
+	}
+	nv, ok := v.nm.Get(key)
+	if !ok || nv.Offset.IsZero() {
+		return Offset{}, 0, ErrorNotFound
+	}
+	if nv.Size.IsDeleted() {
+		return Offset{}, 0, ErrorDeleted
+	}
+	return nv.Offset, nv.Size, nil
+}
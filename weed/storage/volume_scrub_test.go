@@ -0,0 +1,95 @@
+package storage
+
+import (
+	"testing"
+
+	"github.com/gateway-dao/seaweedfs/weed/storage/needle"
+	"github.com/gateway-dao/seaweedfs/weed/storage/super_block"
+	"github.com/gateway-dao/seaweedfs/weed/storage/types"
+	"github.com/gateway-dao/seaweedfs/weed/util"
+)
+
+func TestScrubQuarantinesAndRepairsCorruptedNeedle(t *testing.T) {
+	dir := t.TempDir()
+
+	v, err := NewVolume(dir, dir, "", 1, NeedleMapInMemory, &super_block.ReplicaPlacement{}, &needle.TTL{}, 0, 0, 0)
+	if err != nil {
+		t.Fatalf("volume creation: %v", err)
+	}
+	defer v.Close()
+
+	for i := 1; i <= 10; i++ {
+		n := newEmptyNeedle(uint64(i))
+		n.Data = []byte("needle data to be scrubbed")
+		n.Checksum = needle.NewCRC(n.Data)
+		if _, _, _, err := v.writeNeedle2(n, true, false); err != nil {
+			t.Fatalf("write needle %d: %v", i, err)
+		}
+	}
+
+	key := types.Uint64ToNeedleId(3)
+	goodBlob, size, err := v.ReadNeedleBlobByKey(key)
+	if err != nil {
+		t.Fatalf("read needle blob: %v", err)
+	}
+	offset, _, err := v.needleLocation(key)
+	if err != nil {
+		t.Fatalf("locate needle: %v", err)
+	}
+
+	// flip one byte of the needle body
+	corruptedAt := offset.ToActualOffset() + types.NeedleHeaderSize + 8
+	if _, err := v.DataBackend.WriteAt([]byte{goodBlob[types.NeedleHeaderSize+8] ^ 0xff}, corruptedAt); err != nil {
+		t.Fatalf("corrupt needle: %v", err)
+	}
+
+	var corrupted []types.NeedleId
+	scannedCount, _, err := v.Scrub(util.NewWriteThrottler(0), func(key types.NeedleId, offset types.Offset, size types.Size, err error) {
+		corrupted = append(corrupted, key)
+	})
+	if err != nil {
+		t.Fatalf("scrub: %v", err)
+	}
+	if scannedCount != 10 {
+		t.Errorf("scanned %d needles, expected 10", scannedCount)
+	}
+	if len(corrupted) != 1 || corrupted[0] != key {
+		t.Fatalf("corrupted needles %v, expected [%v]", corrupted, key)
+	}
+	if !v.IsQuarantined(key) {
+		t.Fatalf("needle %v is not quarantined", key)
+	}
+
+	// the quarantine survives reloading
+	if err := v.loadQuarantine(); err != nil {
+		t.Fatalf("load quarantine: %v", err)
+	}
+	if v.QuarantinedNeedleCount() != 1 {
+		t.Fatalf("quarantined %d needles after reload, expected 1", v.QuarantinedNeedleCount())
+	}
+
+	if err := v.repairNeedleBlob(key, offset, size, goodBlob); err != nil {
+		t.Fatalf("repair needle: %v", err)
+	}
+	if v.IsQuarantined(key) {
+		t.Errorf("needle %v is still quarantined after repair", key)
+	}
+	if util.FileExists(v.FileName(".qdx")) {
+		t.Errorf("quarantine index %s is not removed", v.FileName(".qdx"))
+	}
+	if !v.HasNeedleEntry(key) {
+		t.Errorf("needle %v has no index entry", key)
+	}
+
+	// only needles missing from the local index can be read-repaired, not deleted ones
+	deleted := types.Uint64ToNeedleId(4)
+	if _, err := v.deleteNeedle2(newEmptyNeedle(uint64(deleted))); err != nil {
+		t.Fatalf("delete needle: %v", err)
+	}
+	if !v.HasNeedleEntry(deleted) {
+		t.Errorf("deleted needle %v has no index entry", deleted)
+	}
+	if v.HasNeedleEntry(types.Uint64ToNeedleId(11)) {
+		t.Errorf("needle 11 is never written")
+	}
+}
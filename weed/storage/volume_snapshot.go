@@ -0,0 +1,394 @@
+package storage
+
+import (
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+	"time"
+
+	jsonpb "google.golang.org/protobuf/encoding/protojson"
+
+	"github.com/gateway-dao/seaweedfs/weed/glog"
+	"github.com/gateway-dao/seaweedfs/weed/pb/volume_server_pb"
+	"github.com/gateway-dao/seaweedfs/weed/storage/backend"
+	"github.com/gateway-dao/seaweedfs/weed/storage/idx"
+	"github.com/gateway-dao/seaweedfs/weed/storage/needle"
+	"github.com/gateway-dao/seaweedfs/weed/storage/super_block"
+	. "github.com/gateway-dao/seaweedfs/weed/storage/types"
+	"github.com/gateway-dao/seaweedfs/weed/util"
+)
+
+/*
+A snapshot freezes the .dat size, the .idx size and the super block of a volume
+while holding the data file lock, so every write is either completely in the
+snapshot or not at all. The files are then copied up to the frozen sizes while
+writes continue. Needles are only appended after the frozen sizes, and while a
+snapshot is taken appends chain new needles instead of rewriting the last one.
+
+A hard linked snapshot shares the .dat file with the volume. As long as it
+exists, the volume does not rewrite needles in place, and vacuuming copies the
+volume into new files instead of compacting it in place.
+
+With as_of_ns, the snapshot is cut before the first needle appended after that
+time, so snapshots of several volumes, even on different servers, share the same
+point in time, within the clock skew of the volume servers. A needle extended
+in place by an append counts as appended at the time of the last append.
+
+The snapshots are kept in <volume dir>/snapshots/<name>/, with the same file
+names as the volume and the frozen state in a .snap file, written last.
+*/
+
+const (
+	SnapshotDirName = "snapshots"
+
+	// how far ahead of the local clock the point in time of a snapshot can be
+	maxSnapshotClockSkew = time.Minute
+)
+
+func snapshotDir(dir string, name string) string {
+	return filepath.Join(dir, SnapshotDirName, name)
+}
+
+func validateSnapshotName(name string) error {
+	if name == "" || name == "." || name == ".." || strings.ContainsAny(name, `/\`) {
+		return fmt.Errorf("invalid snapshot name %q", name)
+	}
+	return nil
+}
+
+func loadSnapshotInfo(fileName string) (*volume_server_pb.VolumeSnapshot, error) {
+	data, err := os.ReadFile(fileName)
+	if err != nil {
+		return nil, err
+	}
+	snapshot := &volume_server_pb.VolumeSnapshot{}
+	if err = jsonpb.Unmarshal(data, snapshot); err != nil {
+		return nil, fmt.Errorf("unmarshal %s: %v", fileName, err)
+	}
+	snapshot.Dir = filepath.Dir(fileName)
+	return snapshot, nil
+}
+
+func saveSnapshotInfo(fileName string, snapshot *volume_server_pb.VolumeSnapshot) error {
+	m := jsonpb.MarshalOptions{
+		EmitUnpopulated: true,
+		Indent:          "  ",
+	}
+	text, err := m.Marshal(snapshot)
+	if err != nil {
+		return fmt.Errorf("marshal %s: %v", fileName, err)
+	}
+	return util.WriteFile(fileName, text, 0644)
+}
+
+// snapshotFileName is the name of a volume file in the snapshot directory
+func snapshotFileName(snapshot *volume_server_pb.VolumeSnapshot, ext string) string {
+	return VolumeFileName(snapshot.Dir, snapshot.Collection, int(snapshot.VolumeId)) + ext
+}
+
+// hasSnapshots tells whether snapshots are being taken or share the .dat file,
+// which prevents compacting the volume in place.
+func (v *Volume) hasSnapshots() bool {
+	v.dataFileAccessLock.RLock()
+	defer v.dataFileAccessLock.RUnlock()
+	return v.snapshotsInProgress > 0 || v.linkedSnapshots > 0
+}
+
+// countLinkedSnapshots counts the snapshots that share the current .dat file.
+func (v *Volume) countLinkedSnapshots() (count int) {
+	datStat, err := os.Stat(v.FileName(".dat"))
+	if err != nil {
+		return 0
+	}
+	snapshotFiles, _ := filepath.Glob(filepath.Join(v.dir, SnapshotDirName, "*", filepath.Base(v.DataFileName())+".snap"))
+	for _, snapshotFile := range snapshotFiles {
+		snapshot, err := loadSnapshotInfo(snapshotFile)
+		if err != nil || !snapshot.IsHardLinked {
+			continue
+		}
+		if linkedStat, err := os.Stat(snapshotFileName(snapshot, ".dat")); err == nil && os.SameFile(datStat, linkedStat) {
+			count++
+		}
+	}
+	return count
+}
+
+// Snapshot takes a point in time copy of the volume into the snapshot directory name.
+func (v *Volume) Snapshot(name string, hardLink bool, asOfNs uint64) (*volume_server_pb.VolumeSnapshot, error) {
+	if err := validateSnapshotName(name); err != nil {
+		return nil, err
+	}
+	if v.HasRemoteFile() {
+		return nil, fmt.Errorf("volume %d data is in remote storage", v.Id)
+	}
+	if asOfNs > 0 {
+		if v.Version() < needle.Version3 {
+			return nil, fmt.Errorf("volume %d version %d has no append time", v.Id, v.Version())
+		}
+		// the needles appended before asOfNs must be on disk
+		wait := time.Duration(int64(asOfNs) - time.Now().UnixNano())
+		if wait > maxSnapshotClockSkew {
+			return nil, fmt.Errorf("snapshot time %v is %v ahead of the local clock", time.Unix(0, int64(asOfNs)), wait)
+		}
+		if wait > 0 {
+			time.Sleep(wait + time.Millisecond)
+		}
+	}
+
+	snapshot := &volume_server_pb.VolumeSnapshot{
+		Name:        name,
+		VolumeId:    uint32(v.Id),
+		Collection:  v.Collection,
+		Dir:         snapshotDir(v.dir, name),
+		AsOfNs:      asOfNs,
+		CreatedAtNs: time.Now().UnixNano(),
+	}
+	snapshotFile := snapshotFileName(snapshot, ".snap")
+	if util.FileExists(snapshotFile) {
+		return nil, fmt.Errorf("snapshot %s of volume %d already exists", name, v.Id)
+	}
+	if err := os.MkdirAll(snapshot.Dir, 0755); err != nil {
+		return nil, fmt.Errorf("create snapshot directory: %v", err)
+	}
+
+	datFile, idxFile, err := v.freezeSnapshot(snapshot, hardLink)
+	if err != nil {
+		return nil, err
+	}
+	defer func() {
+		datFile.Close()
+		idxFile.Close()
+		v.dataFileAccessLock.Lock()
+		v.snapshotsInProgress--
+		v.dataFileAccessLock.Unlock()
+	}()
+
+	if err = v.writeSnapshotFiles(snapshot, datFile, idxFile); err != nil {
+		v.removeSnapshotFiles(snapshot)
+		return nil, err
+	}
+	glog.V(0).Infof("snapshot %s of volume %d: dat %d bytes, idx %d bytes, hard linked %v",
+		name, v.Id, snapshot.DatSize, snapshot.IdxSize, snapshot.IsHardLinked)
+	return snapshot, nil
+}
+
+// freezeSnapshot records the state of the volume, and opens the .dat and .idx files,
+// so the snapshot reads the same files even if a compaction is committed meanwhile.
+func (v *Volume) freezeSnapshot(snapshot *volume_server_pb.VolumeSnapshot, hardLink bool) (datFile, idxFile *os.File, err error) {
+	v.dataFileAccessLock.Lock()
+	defer v.dataFileAccessLock.Unlock()
+
+	if v.nm == nil || v.DataBackend == nil {
+		return nil, nil, fmt.Errorf("volume %d is not loaded", v.Id)
+	}
+	// in place compaction moves needles below the frozen sizes
+	if v.isCompactingInPlace || v.hasCompactCheckpoint() {
+		return nil, nil, fmt.Errorf("volume %d is being compacted in place", v.Id)
+	}
+	if err = v.DataBackend.Sync(); err != nil {
+		return nil, nil, fmt.Errorf("sync volume %d data: %v", v.Id, err)
+	}
+	if err = v.nm.Sync(); err != nil {
+		return nil, nil, fmt.Errorf("sync volume %d index: %v", v.Id, err)
+	}
+	datSize, _, err := v.DataBackend.GetStat()
+	if err != nil {
+		return nil, nil, fmt.Errorf("stat volume %d data: %v", v.Id, err)
+	}
+	snapshot.DatSize = uint64(datSize)
+	snapshot.IdxSize = v.nm.IndexFileSize()
+	snapshot.SuperBlock = v.SuperBlock.Bytes()
+	snapshot.LastAppendAtNs = v.lastAppendAtNs
+
+	if datFile, err = os.Open(v.FileName(".dat")); err != nil {
+		return nil, nil, err
+	}
+	if idxFile, err = os.Open(v.FileName(".idx")); err != nil {
+		datFile.Close()
+		return nil, nil, err
+	}
+
+	if hardLink {
+		linkErr := os.Link(v.FileName(".dat"), snapshotFileName(snapshot, ".dat"))
+		if linkErr == nil {
+			snapshot.IsHardLinked = true
+			v.linkedSnapshots++
+		} else {
+			glog.V(0).Infof("snapshot %s of volume %d is copied: %v", snapshot.Name, v.Id, linkErr)
+		}
+	}
+	v.snapshotsInProgress++
+	return datFile, idxFile, nil
+}
+
+func (v *Volume) writeSnapshotFiles(snapshot *volume_server_pb.VolumeSnapshot, datFile, idxFile *os.File) error {
+	if snapshot.AsOfNs > 0 && snapshot.AsOfNs < snapshot.LastAppendAtNs {
+		if err := v.cutSnapshotAsOf(snapshot, datFile, idxFile); err != nil {
+			return err
+		}
+	}
+
+	if !snapshot.IsHardLinked {
+		if err := copySnapshotFile(datFile, snapshotFileName(snapshot, ".dat"), int64(snapshot.DatSize), snapshot.SuperBlock); err != nil {
+			return fmt.Errorf("copy volume %d data: %v", v.Id, err)
+		}
+	}
+	if err := copySnapshotFile(idxFile, snapshotFileName(snapshot, ".idx"), int64(snapshot.IdxSize), nil); err != nil {
+		return fmt.Errorf("copy volume %d index: %v", v.Id, err)
+	}
+	if vifData, err := os.ReadFile(v.FileName(".vif")); err == nil {
+		if err = util.WriteFile(snapshotFileName(snapshot, ".vif"), vifData, 0644); err != nil {
+			return fmt.Errorf("copy volume %d info: %v", v.Id, err)
+		}
+	}
+	return saveSnapshotInfo(snapshotFileName(snapshot, ".snap"), snapshot)
+}
+
+// cutSnapshotAsOf moves the frozen sizes before the first needle appended after snapshot.AsOfNs.
+// The needles in the .idx file are in the order they are appended to the .dat file.
+func (v *Volume) cutSnapshotAsOf(snapshot *volume_server_pb.VolumeSnapshot, datFile, idxFile *os.File) error {
+	datBackend := backend.NewDiskFile(datFile)
+	version := v.Version()
+	entryCount := int(snapshot.IdxSize / NeedleMapEntrySize)
+
+	entryOffset := func(i int) (Offset, error) {
+		entry := make([]byte, NeedleMapEntrySize)
+		if _, err := idxFile.ReadAt(entry, int64(i)*NeedleMapEntrySize); err != nil {
+			return Offset{}, err
+		}
+		_, offset, _ := idx.IdxFileEntry(entry)
+		return offset, nil
+	}
+
+	var searchErr error
+	cut := sort.Search(entryCount, func(i int) bool {
+		if searchErr != nil {
+			return true
+		}
+		offset, err := entryOffset(i)
+		if err != nil {
+			searchErr = err
+			return true
+		}
+		if offset.IsZero() {
+			return false
+		}
+		appendAtNs, err := readNeedleAppendAtNs(datBackend, version, offset)
+		if err != nil {
+			searchErr = err
+			return true
+		}
+		return appendAtNs > snapshot.AsOfNs
+	})
+	if searchErr != nil {
+		return fmt.Errorf("find volume %d needles appended after %d: %v", v.Id, snapshot.AsOfNs, searchErr)
+	}
+	if cut == entryCount {
+		return nil
+	}
+
+	offset, err := entryOffset(cut)
+	if err != nil {
+		return err
+	}
+	snapshot.DatSize = uint64(offset.ToActualOffset())
+	snapshot.IdxSize = uint64(cut) * NeedleMapEntrySize
+	// the entries before the cut pointing to the same needle are for the needle extended in place
+	for snapshot.IdxSize > 0 {
+		previous, err := entryOffset(int(snapshot.IdxSize/NeedleMapEntrySize) - 1)
+		if err != nil {
+			return err
+		}
+		if previous.ToActualOffset() < offset.ToActualOffset() {
+			break
+		}
+		snapshot.IdxSize -= NeedleMapEntrySize
+	}
+	snapshot.LastAppendAtNs = snapshot.AsOfNs
+	return nil
+}
+
+// copySnapshotFile copies the first size bytes of src to dst, with the super block, if any, at the start.
+func copySnapshotFile(src *os.File, dst string, size int64, superBlock []byte) error {
+	dstFile, err := os.OpenFile(dst, os.O_WRONLY|os.O_CREATE|os.O_TRUNC, 0644)
+	if err != nil {
+		return err
+	}
+	if _, err = io.Copy(dstFile, io.NewSectionReader(src, 0, size)); err == nil && len(superBlock) > 0 {
+		_, err = dstFile.WriteAt(superBlock, 0)
+	}
+	if err == nil {
+		err = dstFile.Sync()
+	}
+	if closeErr := dstFile.Close(); err == nil {
+		err = closeErr
+	}
+	return err
+}
+
+func (v *Volume) removeSnapshotFiles(snapshot *volume_server_pb.VolumeSnapshot) {
+	removeSnapshotFiles(snapshot)
+	if snapshot.IsHardLinked {
+		v.dataFileAccessLock.Lock()
+		v.linkedSnapshots = v.countLinkedSnapshots()
+		v.dataFileAccessLock.Unlock()
+	}
+}
+
+func removeSnapshotFiles(snapshot *volume_server_pb.VolumeSnapshot) {
+	// the .snap file goes first, so a partly removed snapshot is not listed
+	for _, ext := range []string{".snap", ".dat", ".idx", ".vif"} {
+		if err := os.Remove(snapshotFileName(snapshot, ext)); err != nil && !os.IsNotExist(err) {
+			glog.Warningf("remove snapshot %s file %s: %v", snapshot.Name, snapshotFileName(snapshot, ext), err)
+		}
+	}
+	// only removed once the snapshots of all volumes are gone
+	os.Remove(snapshot.Dir)
+}
+
+// restoreSnapshotFiles writes the volume files of the snapshot as volume newVolumeId,
+// with the .dat file in dir and the .idx file in dirIdx.
+func restoreSnapshotFiles(snapshot *volume_server_pb.VolumeSnapshot, dir string, dirIdx string, newVolumeId needle.VolumeId) (err error) {
+	datFileName := VolumeFileName(dir, snapshot.Collection, int(newVolumeId)) + ".dat"
+	idxFileName := VolumeFileName(dirIdx, snapshot.Collection, int(newVolumeId)) + ".idx"
+	vifFileName := VolumeFileName(dir, snapshot.Collection, int(newVolumeId)) + ".vif"
+	for _, fileName := range []string{datFileName, idxFileName} {
+		if util.FileExists(fileName) {
+			return fmt.Errorf("volume file %s already exists", fileName)
+		}
+	}
+	if len(snapshot.SuperBlock) < super_block.SuperBlockSize {
+		return fmt.Errorf("snapshot %s of volume %d has no super block", snapshot.Name, snapshot.VolumeId)
+	}
+	defer func() {
+		if err != nil {
+			os.Remove(datFileName)
+			os.Remove(idxFileName)
+			os.Remove(vifFileName)
+		}
+	}()
+
+	copyFrom := func(src string, dst string, size int64, superBlock []byte) error {
+		srcFile, err := os.Open(src)
+		if err != nil {
+			return err
+		}
+		defer srcFile.Close()
+		return copySnapshotFile(srcFile, dst, size, superBlock)
+	}
+	if err = copyFrom(snapshotFileName(snapshot, ".dat"), datFileName, int64(snapshot.DatSize), snapshot.SuperBlock); err != nil {
+		return fmt.Errorf("restore data: %v", err)
+	}
+	if err = copyFrom(snapshotFileName(snapshot, ".idx"), idxFileName, int64(snapshot.IdxSize), nil); err != nil {
+		return fmt.Errorf("restore index: %v", err)
+	}
+	if vifData, readErr := os.ReadFile(snapshotFileName(snapshot, ".vif")); readErr == nil {
+		if err = util.WriteFile(vifFileName, vifData, 0644); err != nil {
+			return fmt.Errorf("restore volume info: %v", err)
+		}
+	}
+	return nil
+}
@@ -0,0 +1,139 @@
+package storage
+
+import (
+	"bytes"
+	"testing"
+	"time"
+
+	"github.com/gateway-dao/seaweedfs/weed/storage/needle"
+	"github.com/gateway-dao/seaweedfs/weed/storage/super_block"
+)
+
+func writeSnapshotTestNeedles(t *testing.T, v *Volume, from, to int) []*needle.Needle {
+	var needles []*needle.Needle
+	for i := from; i <= to; i++ {
+		n := newRandomNeedle(uint64(i))
+		// empty needles are not counted once the index is reloaded
+		n.Data = append(n.Data, byte(i))
+		n.Checksum = needle.NewCRC(n.Data)
+		if _, _, _, err := v.writeNeedle2(n, true, false); err != nil {
+			t.Fatalf("write file %d: %v", i, err)
+		}
+		needles = append(needles, n)
+	}
+	return needles
+}
+
+func restoreSnapshotTestVolume(t *testing.T, v *Volume, name string, newVolumeId needle.VolumeId) *Volume {
+	snapshot, err := loadSnapshotInfo(VolumeFileName(snapshotDir(v.dir, name), v.Collection, int(v.Id)) + ".snap")
+	if err != nil {
+		t.Fatalf("load snapshot: %v", err)
+	}
+	if err = restoreSnapshotFiles(snapshot, v.dir, v.dirIdx, newVolumeId); err != nil {
+		t.Fatalf("restore snapshot: %v", err)
+	}
+	restored, err := NewVolume(v.dir, v.dirIdx, v.Collection, newVolumeId, NeedleMapInMemory, nil, nil, 0, 0, 0)
+	if err != nil {
+		t.Fatalf("load restored volume: %v", err)
+	}
+	return restored
+}
+
+func checkSnapshotTestNeedles(t *testing.T, v *Volume, needles []*needle.Needle, fileCount int) {
+	if v.FileCount() != uint64(fileCount) {
+		t.Fatalf("volume %d has %d files, expected %d", v.Id, v.FileCount(), fileCount)
+	}
+	for _, n := range needles {
+		read := newEmptyNeedle(uint64(n.Id))
+		if _, err := v.readNeedle(read, nil, nil); err != nil {
+			t.Fatalf("read %s: %v", n.Id, err)
+		}
+		if !bytes.Equal(read.Data, n.Data) {
+			t.Fatalf("read %s: unexpected data", n.Id)
+		}
+	}
+}
+
+func TestVolumeSnapshot(t *testing.T) {
+	dir := t.TempDir()
+
+	v, err := NewVolume(dir, dir, "", 1, NeedleMapInMemory, &super_block.ReplicaPlacement{}, &needle.TTL{}, 0, 0, 0)
+	if err != nil {
+		t.Fatalf("volume creation: %v", err)
+	}
+	defer v.Close()
+
+	before := writeSnapshotTestNeedles(t, v, 1, 50)
+	snapshot, err := v.Snapshot("s1", false, 0)
+	if err != nil {
+		t.Fatalf("snapshot: %v", err)
+	}
+	if snapshot.IsHardLinked {
+		t.Fatalf("snapshot should be copied")
+	}
+	if _, err = v.Snapshot("s1", false, 0); err == nil {
+		t.Fatalf("snapshot with the same name should fail")
+	}
+	writeSnapshotTestNeedles(t, v, 51, 100)
+
+	// the needles appended after asOfNs are cut off
+	asOfNs := uint64(time.Now().UnixNano())
+	writeSnapshotTestNeedles(t, v, 101, 150)
+	if _, err = v.Snapshot("s2", false, asOfNs); err != nil {
+		t.Fatalf("snapshot as of %d: %v", asOfNs, err)
+	}
+
+	restored := restoreSnapshotTestVolume(t, v, "s1", 2)
+	defer restored.Close()
+	checkSnapshotTestNeedles(t, restored, before, 50)
+
+	restoredAsOf := restoreSnapshotTestVolume(t, v, "s2", 3)
+	defer restoredAsOf.Close()
+	checkSnapshotTestNeedles(t, restoredAsOf, before, 100)
+	if _, err = restoredAsOf.readNeedle(newEmptyNeedle(101), nil, nil); err != ErrorNotFound {
+		t.Fatalf("needle written after the snapshot time: %v", err)
+	}
+}
+
+func TestVolumeSnapshotHardLink(t *testing.T) {
+	dir := t.TempDir()
+
+	v, err := NewVolume(dir, dir, "", 1, NeedleMapInMemory, &super_block.ReplicaPlacement{}, &needle.TTL{}, 0, 0, 0)
+	if err != nil {
+		t.Fatalf("volume creation: %v", err)
+	}
+	defer v.Close()
+
+	before := writeSnapshotTestNeedles(t, v, 1, 20)
+	last := before[len(before)-1]
+	snapshot, err := v.Snapshot("linked", true, 0)
+	if err != nil {
+		t.Fatalf("snapshot: %v", err)
+	}
+	if !snapshot.IsHardLinked {
+		t.Skip("hard links are not supported")
+	}
+	if v.linkedSnapshots != 1 || !v.hasSnapshots() {
+		t.Fatalf("linked snapshots: %d", v.linkedSnapshots)
+	}
+
+	// the last needle is not extended in place while it is shared with the snapshot
+	nv, _ := v.nm.Get(last.Id)
+	offset := nv.Offset
+	if _, _, err = v.appendNeedle(&needle.Needle{Id: last.Id, Cookie: last.Cookie, Data: []byte("more"), LastModified: 1}, uint64(len(last.Data)), false); err != nil {
+		t.Fatalf("append: %v", err)
+	}
+	if nv, _ = v.nm.Get(last.Id); nv.Offset == offset {
+		t.Fatalf("needle shared with a snapshot is rewritten in place")
+	}
+	writeSnapshotTestNeedles(t, v, 21, 40)
+
+	restored := restoreSnapshotTestVolume(t, v, "linked", 2)
+	defer restored.Close()
+	checkSnapshotTestNeedles(t, restored, before, 20)
+
+	v.removeSnapshotFiles(snapshot)
+	if v.linkedSnapshots != 0 {
+		t.Fatalf("linked snapshots after removal: %d", v.linkedSnapshots)
+	}
+}
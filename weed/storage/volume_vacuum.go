@@ -103,6 +103,9 @@ func (v *Volume) CommitCompact() error {
 	if v.MemoryMapMaxSizeMb != 0 { //it makes no sense to compact in memory
 		return nil
 	}
+	if v.hasCompactCheckpoint() {
+		return v.commitCompactSegmented()
+	}
 	glog.V(0).Infof("Committing volume %d vacuuming...", v.Id)
 
 	v.isCommitCompacting = true
@@ -171,6 +174,9 @@ func (v *Volume) CommitCompact() error {
 }
 
 func (v *Volume) cleanupCompact() error {
+	if v.hasCompactCheckpoint() {
+		return v.cleanupSegmentedCompact()
+	}
 	glog.V(0).Infof("Cleaning up volume %d vacuuming...", v.Id)
 
 	e1 := os.Remove(v.FileName(".cpd"))
@@ -316,6 +322,18 @@ func (v *Volume) makeupDiff(newDatFileName, newIdxFileName, oldDatFileName, oldI
 			if err != nil {
 				return fmt.Errorf("ReadNeedleBlob %s key %d offset %d size %d failed: %v", oldDatFile.Name(), key, increIdxEntry.offset.ToActualOffset(), increIdxEntry.size, err)
 			}
+			n := new(needle.Needle)
+			if parseErr := n.ReadBytes(needleBytes, increIdxEntry.offset.ToActualOffset(), increIdxEntry.size, v.Version()); parseErr == nil && n.IsAppendContinuation() {
+				// the appended needles it links to are not in the new file
+				var flat *needle.Needle
+				if flat, err = v.flattenAppendChain(n, oldDatBackend); err != nil {
+					return fmt.Errorf("flatten appended needle %d: %v", key, err)
+				}
+				if needleBytes, err = flat.ToBlob(v.Version()); err != nil {
+					return fmt.Errorf("flatten appended needle %d: %v", key, err)
+				}
+				idxEntryBytes = needle_map.ToBytes(key, increIdxEntry.offset, flat.Size)
+			}
 			dstDatBackend.Write(needleBytes)
 			if err := dstDatBackend.Sync(); err != nil {
 				return fmt.Errorf("cannot sync needle %s: %v", dstDatBackend.File.Name(), err)
@@ -376,12 +394,18 @@ func (scanner *VolumeFileScanner4Vacuum) VisitNeedle(n *needle.Needle, offset in
 	nv, ok := scanner.v.nm.Get(n.Id)
 	glog.V(4).Infoln("needle expected offset ", offset, "ok", ok, "nv", nv)
 	if ok && nv.Offset.ToActualOffset() == offset && nv.Size > 0 && nv.Size.IsValid() {
-		if err := scanner.nm.Set(n.Id, ToOffset(scanner.newOffset), n.Size); err != nil {
-			return fmt.Errorf("cannot put needle: %s", err)
+		if n.IsAppendContinuation() {
+			var err error
+			if n, err = scanner.v.flattenAppendChain(n, scanner.v.DataBackend); err != nil {
+				return fmt.Errorf("cannot flatten appended needle: %s", err)
+			}
 		}
 		if _, _, _, err := n.Append(scanner.dstBackend, scanner.v.Version()); err != nil {
 			return fmt.Errorf("cannot append needle: %s", err)
 		}
+		if err := scanner.nm.Set(n.Id, ToOffset(scanner.newOffset), n.Size); err != nil {
+			return fmt.Errorf("cannot put needle: %s", err)
+		}
 		delta := n.DiskSize(scanner.version)
 		scanner.newOffset += delta
 		scanner.writeThrottler.MaybeSlowdown(delta)
@@ -471,12 +495,17 @@ func (v *Volume) copyDataBasedOnIndexFile(srcDatName, srcIdxName, dstDatName, da
 			return nil
 		}
 
-		if err = newNm.Set(n.Id, ToOffset(newOffset), n.Size); err != nil {
-			return fmt.Errorf("cannot put needle: %s", err)
+		if n.IsAppendContinuation() {
+			if n, err = v.flattenAppendChain(n, srcDatBackend); err != nil {
+				return fmt.Errorf("cannot flatten appended needle: %s", err)
+			}
 		}
 		if _, _, _, err = n.Append(dstDatBackend, sb.Version); err != nil {
 			return fmt.Errorf("cannot append needle: %s", err)
 		}
+		if err = newNm.Set(n.Id, ToOffset(newOffset), n.Size); err != nil {
+			return fmt.Errorf("cannot put needle: %s", err)
+		}
 		delta := n.DiskSize(version)
 		newOffset += delta
 		writeThrottler.MaybeSlowdown(delta)
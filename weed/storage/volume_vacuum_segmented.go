@@ -0,0 +1,710 @@
+package storage
+
+import (
+	"fmt"
+	"io"
+	"os"
+	"sort"
+	"time"
+
+	"github.com/gateway-dao/seaweedfs/weed/glog"
+	"github.com/gateway-dao/seaweedfs/weed/stats"
+	"github.com/gateway-dao/seaweedfs/weed/storage/backend"
+	"github.com/gateway-dao/seaweedfs/weed/storage/idx"
+	"github.com/gateway-dao/seaweedfs/weed/storage/needle"
+	"github.com/gateway-dao/seaweedfs/weed/storage/needle_map"
+	"github.com/gateway-dao/seaweedfs/weed/storage/super_block"
+	. "github.com/gateway-dao/seaweedfs/weed/storage/types"
+	"github.com/gateway-dao/seaweedfs/weed/util"
+)
+
+/*
+Segmented compaction slides the live needles towards the head of the .dat file
+instead of copying them into a new .cpd file, so vacuuming only needs room for
+one segment instead of a second copy of the volume.
+
+Each round reads the live needles in [readOffset, readOffset+segment) into the
+.cps scratch file and records the planned moves in the .cpk checkpoint. Then,
+holding the data file lock, the scratch bytes are written to writeOffset and the
+needle map entries are updated. Since live data never grows, writeOffset never
+passes readOffset, so only already compacted bytes are overwritten. A crash
+while copying a segment back is repaired by replaying the checkpoint on load.
+
+Tailing and incremental copies read the needles appended after a time in
+append order. While compacting, those are the compacted needles before
+writeOffset, followed by the needles from readOffset onwards, and the readers
+hold compactMoveLock so no segment is moved while they read.
+
+The compaction revision only changes at the commit, so copying the .dat or .idx
+file is refused from the start of the compaction until the commit, and the
+compaction does not start while they are copied.
+
+The commit first compacts the needles written in the meantime while writes go
+on, in a few throttled passes. Then it holds the data file lock to compact the
+last short delta, truncates the .dat file, bumps the compaction revision and
+rewrites the .idx file in offset order, so followers see the same revision
+change as with the copying compaction. Cleaning up an
+unfinished compaction fills the gap between writeOffset and readOffset with
+needles in no needle map, and commits without truncating the .dat file.
+*/
+
+const (
+	DefaultCompactionSegmentSize = 64 * 1024 * 1024
+
+	// passes catching up with the writes before the commit takes the exclusive lock
+	compactCommitCatchUpPasses = 3
+
+	compactCheckpointHeaderSize = 2 + 1 + 8*5 + 4
+	compactMoveSize             = NeedleMapEntrySize + OffsetSize
+)
+
+type compactMove struct {
+	key  NeedleId
+	from Offset
+	to   Offset
+	size Size
+}
+
+type compactCheckpoint struct {
+	revision     uint16 // compaction revision of the volume being compacted
+	committing   bool   // the compacted .cpx is complete, the .dat only needs to be truncated
+	segmentLimit int64  // max bytes staged per segment
+	writeOffset  int64  // compacted data ends here
+	readOffset   int64  // data from here onwards is not compacted yet
+	segmentSize  int64  // bytes staged in .cps to be copied to writeOffset, 0 if none
+	segmentEnd   int64  // readOffset after the staged segment is applied
+	moves        []compactMove
+}
+
+func (v *Volume) hasCompactCheckpoint() bool {
+	return util.FileExists(v.FileName(".cpk"))
+}
+
+// StartFileCopy keeps in place compaction from starting while the .dat or .idx file is copied.
+// The copy is refused if the volume is being compacted in place, since the compaction revision
+// only changes at the commit, while the needles move and the .idx is appended out of order.
+func (v *Volume) StartFileCopy() error {
+	v.dataFileAccessLock.Lock()
+	defer v.dataFileAccessLock.Unlock()
+	if v.isCompactingInPlace || v.hasCompactCheckpoint() {
+		return fmt.Errorf("volume %d is being compacted in place", v.Id)
+	}
+	v.copiesInProgress++
+	return nil
+}
+
+func (v *Volume) FinishFileCopy() {
+	v.dataFileAccessLock.Lock()
+	defer v.dataFileAccessLock.Unlock()
+	v.copiesInProgress--
+}
+
+func loadCompactCheckpoint(fileName string) (*compactCheckpoint, error) {
+	data, err := os.ReadFile(fileName)
+	if err != nil {
+		if os.IsNotExist(err) {
+			return nil, nil
+		}
+		return nil, err
+	}
+	if len(data) < compactCheckpointHeaderSize {
+		return nil, fmt.Errorf("compaction checkpoint %s is truncated", fileName)
+	}
+	cp := &compactCheckpoint{
+		revision:     util.BytesToUint16(data[0:2]),
+		committing:   data[2] == 1,
+		segmentLimit: int64(util.BytesToUint64(data[3:11])),
+		writeOffset:  int64(util.BytesToUint64(data[11:19])),
+		readOffset:   int64(util.BytesToUint64(data[19:27])),
+		segmentSize:  int64(util.BytesToUint64(data[27:35])),
+		segmentEnd:   int64(util.BytesToUint64(data[35:43])),
+	}
+	moveCount := int(util.BytesToUint32(data[43:47]))
+	if len(data) != compactCheckpointHeaderSize+moveCount*compactMoveSize {
+		return nil, fmt.Errorf("compaction checkpoint %s has %d bytes for %d moves", fileName, len(data), moveCount)
+	}
+	for i := 0; i < moveCount; i++ {
+		b := data[compactCheckpointHeaderSize+i*compactMoveSize:]
+		key, to, size := idx.IdxFileEntry(b[:NeedleMapEntrySize])
+		cp.moves = append(cp.moves, compactMove{
+			key:  key,
+			from: BytesToOffset(b[NeedleMapEntrySize:compactMoveSize]),
+			to:   to,
+			size: size,
+		})
+	}
+	return cp, nil
+}
+
+func (cp *compactCheckpoint) save(fileName string) error {
+	data := make([]byte, compactCheckpointHeaderSize, compactCheckpointHeaderSize+len(cp.moves)*compactMoveSize)
+	util.Uint16toBytes(data[0:2], cp.revision)
+	if cp.committing {
+		data[2] = 1
+	}
+	util.Uint64toBytes(data[3:11], uint64(cp.segmentLimit))
+	util.Uint64toBytes(data[11:19], uint64(cp.writeOffset))
+	util.Uint64toBytes(data[19:27], uint64(cp.readOffset))
+	util.Uint64toBytes(data[27:35], uint64(cp.segmentSize))
+	util.Uint64toBytes(data[35:43], uint64(cp.segmentEnd))
+	util.Uint32toBytes(data[43:47], uint32(len(cp.moves)))
+	for _, m := range cp.moves {
+		data = append(data, needle_map.ToBytes(m.key, m.to, m.size)...)
+		from := make([]byte, OffsetSize)
+		OffsetToBytes(from, m.from)
+		data = append(data, from...)
+	}
+
+	// write aside and rename, so a crash never leaves a half written checkpoint
+	tmpFileName := fileName + ".tmp"
+	f, err := os.OpenFile(tmpFileName, os.O_WRONLY|os.O_CREATE|os.O_TRUNC, 0644)
+	if err != nil {
+		return err
+	}
+	if _, err = f.Write(data); err == nil {
+		err = f.Sync()
+	}
+	if closeErr := f.Close(); err == nil {
+		err = closeErr
+	}
+	if err != nil {
+		return fmt.Errorf("write compaction checkpoint %s: %v", tmpFileName, err)
+	}
+	return os.Rename(tmpFileName, fileName)
+}
+
+// CompactSegmented compacts the volume in place, segment by segment.
+// Needles appended after the compaction started are left to CommitCompact.
+func (v *Volume) CompactSegmented(segmentSize int64, compactionBytePerSecond int64, progressFn ProgressFunc) error {
+
+	if v.MemoryMapMaxSizeMb != 0 { //it makes no sense to compact in memory
+		return nil
+	}
+	glog.V(3).Infof("CompactSegmented volume %d ...", v.Id)
+
+	if v.DataBackend == nil {
+		return fmt.Errorf("volume %d backend is empty remote:%v", v.Id, v.HasRemoteFile())
+	}
+	if v.noWriteOrDelete || v.noWriteCanDelete {
+		return fmt.Errorf("volume %d is read only and can not be compacted in place", v.Id)
+	}
+	if segmentSize <= 0 {
+		segmentSize = DefaultCompactionSegmentSize
+	}
+
+	// snapshots and file copies read the needles that in place compaction moves
+	v.dataFileAccessLock.Lock()
+	if v.snapshotsInProgress > 0 || v.linkedSnapshots > 0 {
+		v.dataFileAccessLock.Unlock()
+		return fmt.Errorf("volume %d has snapshots sharing its data file", v.Id)
+	}
+	if v.copiesInProgress > 0 {
+		v.dataFileAccessLock.Unlock()
+		return fmt.Errorf("volume %d has %d files being copied", v.Id, v.copiesInProgress)
+	}
+	v.isCompacting = true
+	v.isCompactingInPlace = true
+	v.compactionBytePerSecond = compactionBytePerSecond
+	v.dataFileAccessLock.Unlock()
+	defer func() {
+		v.isCompacting = false
+		v.isCompactingInPlace = false
+	}()
+
+	// readers in append order tell the volume layout by the checkpoint
+	v.compactMoveLock.Lock()
+	cp, err := loadCompactCheckpoint(v.FileName(".cpk"))
+	if err != nil {
+		v.compactMoveLock.Unlock()
+		return err
+	}
+	if cp == nil {
+		cp = &compactCheckpoint{
+			revision:    v.SuperBlock.CompactionRevision,
+			writeOffset: int64(v.SuperBlock.BlockSize()),
+			readOffset:  int64(v.SuperBlock.BlockSize()),
+		}
+	} else if cp.committing || cp.segmentSize > 0 {
+		v.compactMoveLock.Unlock()
+		return fmt.Errorf("volume %d has an unfinished compaction segment, reload the volume to replay it", v.Id)
+	} else {
+		glog.V(0).Infof("volume %d resumes compaction from offset %d", v.Id, cp.readOffset)
+	}
+	cp.segmentLimit = segmentSize
+	err = cp.save(v.FileName(".cpk"))
+	v.compactMoveLock.Unlock()
+	if err != nil {
+		return err
+	}
+
+	// writes append whole needles under the lock, so the size seen here ends at a needle boundary
+	v.dataFileAccessLock.RLock()
+	if err = v.DataBackend.Sync(); err != nil {
+		glog.V(0).Infof("compact segmented failed to sync volume dat %d: %v", v.Id, err)
+	}
+	endOffset, _, err := v.DataBackend.GetStat()
+	v.dataFileAccessLock.RUnlock()
+	if err != nil {
+		return err
+	}
+
+	writeThrottler := util.NewWriteThrottler(compactionBytePerSecond)
+	for cp.readOffset < endOffset {
+		if progressFn != nil {
+			if !progressFn(cp.readOffset) {
+				return fmt.Errorf("interrupted")
+			}
+		}
+		if err = v.compactNextSegment(cp, endOffset, writeThrottler, false); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (v *Volume) commitCompactSegmented() error {
+	glog.V(0).Infof("Committing volume %d segmented vacuuming...", v.Id)
+
+	v.isCommitCompacting = true
+	defer func() {
+		v.isCommitCompacting = false
+	}()
+
+	v.dataFileAccessLock.Lock()
+	if v.isCompactingInPlace {
+		v.dataFileAccessLock.Unlock()
+		return fmt.Errorf("volume %d is being compacted in place", v.Id)
+	}
+	v.isCompacting = true
+	v.isCompactingInPlace = true
+	v.dataFileAccessLock.Unlock()
+	defer func() {
+		v.isCompacting = false
+		v.isCompactingInPlace = false
+	}()
+
+	cp, err := loadCompactCheckpoint(v.FileName(".cpk"))
+	if err != nil {
+		return err
+	}
+	if cp == nil {
+		return fmt.Errorf("volume %d has no compaction checkpoint", v.Id)
+	}
+	if cp.committing || cp.segmentSize > 0 {
+		return fmt.Errorf("volume %d has an unfinished compaction segment, reload the volume to replay it", v.Id)
+	}
+	if cp.segmentLimit <= 0 {
+		cp.segmentLimit = DefaultCompactionSegmentSize
+	}
+
+	// compact the needles written since CompactSegmented started while writes go on,
+	// so only the needles written during the last pass are compacted under the exclusive lock
+	writeThrottler := util.NewWriteThrottler(v.compactionBytePerSecond)
+	for pass := 0; pass < compactCommitCatchUpPasses; pass++ {
+		v.dataFileAccessLock.RLock()
+		datSize, _, err := v.DataBackend.GetStat()
+		v.dataFileAccessLock.RUnlock()
+		if err != nil {
+			return err
+		}
+		if datSize-cp.readOffset <= cp.segmentLimit {
+			break
+		}
+		for cp.readOffset < datSize {
+			if err = v.compactNextSegment(cp, datSize, writeThrottler, false); err != nil {
+				return err
+			}
+		}
+	}
+
+	v.compactMoveLock.Lock()
+	defer v.compactMoveLock.Unlock()
+	v.dataFileAccessLock.Lock()
+	defer v.dataFileAccessLock.Unlock()
+
+	writeThrottler = util.NewWriteThrottler(0)
+	for {
+		datSize, _, err := v.DataBackend.GetStat()
+		if err != nil {
+			return err
+		}
+		if cp.readOffset >= datSize {
+			break
+		}
+		if err = v.compactNextSegment(cp, datSize, writeThrottler, true); err != nil {
+			return err
+		}
+	}
+
+	return v.commitCompactCheckpoint(cp)
+}
+
+// commitCompactCheckpoint truncates the data file to cp.writeOffset, and reloads the volume.
+// The caller holds dataFileAccessLock.
+func (v *Volume) commitCompactCheckpoint(cp *compactCheckpoint) error {
+	if err := v.nm.Sync(); err != nil {
+		return fmt.Errorf("sync volume %d idx: %v", v.Id, err)
+	}
+	if err := writeCompactedIndexFile(v.FileName(".idx"), v.FileName(".cpx"), cp.writeOffset); err != nil {
+		return err
+	}
+	cp.committing = true
+	if err := cp.save(v.FileName(".cpk")); err != nil {
+		return err
+	}
+
+	v.nm.Close()
+	v.nm = nil
+	if err := v.DataBackend.Close(); err != nil {
+		glog.V(0).Infof("failed to close volume %d", v.Id)
+	}
+	v.DataBackend = nil
+	stats.VolumeServerVolumeGauge.WithLabelValues(v.Collection, "volume").Dec()
+
+	if err := v.finishSegmentedCommit(cp); err != nil {
+		return err
+	}
+
+	glog.V(3).Infof("Loading volume %d commit file...", v.Id)
+	if err := v.load(true, false, v.needleMapKind, 0); err != nil {
+		return err
+	}
+	glog.V(3).Infof("Finish committing volume %d", v.Id)
+	return nil
+}
+
+// compactNextSegment stages the live needles from cp.readOffset up to endOffset, at most one segment,
+// and moves them to cp.writeOffset. The caller holds compactMoveLock and dataFileAccessLock if locked is true.
+func (v *Volume) compactNextSegment(cp *compactCheckpoint, endOffset int64, writeThrottler *util.WriteThrottler, locked bool) error {
+	version := v.Version()
+	now := uint64(time.Now().Unix())
+	ttlSeconds := uint64(v.Ttl.Minutes()) * 60
+
+	scratchFile, err := os.OpenFile(v.FileName(".cps"), os.O_RDWR|os.O_CREATE|os.O_TRUNC, 0644)
+	if err != nil {
+		return fmt.Errorf("create compaction scratch file: %v", err)
+	}
+	defer scratchFile.Close()
+
+	var moves []compactMove
+	var staged int64
+	checkedKeys := make(map[NeedleId]bool)
+	offset := cp.readOffset
+	for offset < endOffset && staged < cp.segmentLimit {
+		n, _, bodyLength, err := needle.ReadNeedleHeader(v.DataBackend, version, offset)
+		if err == io.EOF {
+			// a partially written needle left by a crash, nothing live beyond it
+			offset = endOffset
+			break
+		}
+		if err != nil {
+			return fmt.Errorf("read needle header at %d: %v", offset, err)
+		}
+		needleOffset := offset
+		offset += NeedleHeaderSize + bodyLength
+		nv, ok := v.nm.Get(n.Id)
+		if !ok || nv.Offset.ToActualOffset() != needleOffset || nv.Size != n.Size || nv.Size.IsDeleted() {
+			if ok && nv.Size.IsValid() && nv.Offset.ToActualOffset() > needleOffset && !checkedKeys[n.Id] {
+				// this may be a needle an appended needle links to, which is about to be overwritten
+				checkedKeys[n.Id] = true
+				if err = v.flattenLiveAppendChain(n.Id, locked); err != nil {
+					return err
+				}
+			}
+			continue
+		}
+		blob, err := needle.ReadNeedleBlob(v.DataBackend, needleOffset, n.Size, version)
+		if err != nil {
+			return fmt.Errorf("read needle %d at %d: %v", n.Id, needleOffset, err)
+		}
+		if err = n.ReadBytes(blob, needleOffset, n.Size, version); err != nil {
+			return fmt.Errorf("parse needle %d at %d: %v", n.Id, needleOffset, err)
+		}
+		if ttlSeconds > 0 && n.HasTtl() && now >= n.LastModified+ttlSeconds {
+			// the expired needle is about to be overwritten, a move to offset 0 deletes it
+			moves = append(moves, compactMove{
+				key:  n.Id,
+				from: ToOffset(needleOffset),
+				size: n.Size,
+			})
+			continue
+		}
+		if _, err = scratchFile.Write(blob); err != nil {
+			return fmt.Errorf("stage needle %d: %v", n.Id, err)
+		}
+		moves = append(moves, compactMove{
+			key:  n.Id,
+			from: ToOffset(needleOffset),
+			to:   ToOffset(cp.writeOffset + staged),
+			size: n.Size,
+		})
+		staged += int64(len(blob))
+		writeThrottler.MaybeSlowdown(int64(len(blob)))
+	}
+	if err = scratchFile.Sync(); err != nil {
+		return fmt.Errorf("sync compaction scratch file: %v", err)
+	}
+
+	cp.segmentSize = staged
+	cp.segmentEnd = offset
+	cp.moves = moves
+	if err = cp.save(v.FileName(".cpk")); err != nil {
+		return err
+	}
+
+	if !locked {
+		v.compactMoveLock.Lock()
+		defer v.compactMoveLock.Unlock()
+		v.dataFileAccessLock.Lock()
+		defer v.dataFileAccessLock.Unlock()
+	}
+	if err = copyCompactSegment(scratchFile, v.DataBackend, cp); err != nil {
+		return err
+	}
+	return v.applyCompactMoves(cp)
+}
+
+// copyCompactSegment writes the staged segment at the compaction write offset.
+// It is idempotent, so it can be replayed after a crash.
+func copyCompactSegment(scratchFile *os.File, dst backend.BackendStorageFile, cp *compactCheckpoint) error {
+	buf := make([]byte, 1024*1024)
+	for copied := int64(0); copied < cp.segmentSize; {
+		n, err := scratchFile.ReadAt(buf[:min(len(buf), int(cp.segmentSize-copied))], copied)
+		if n > 0 {
+			if _, writeErr := dst.WriteAt(buf[:n], cp.writeOffset+copied); writeErr != nil {
+				return fmt.Errorf("write compacted segment to %s: %v", dst.Name(), writeErr)
+			}
+			copied += int64(n)
+		}
+		if err != nil && (err != io.EOF || copied < cp.segmentSize) {
+			return fmt.Errorf("read compaction scratch file at %d: %v", copied, err)
+		}
+	}
+	if err := dst.Sync(); err != nil {
+		return fmt.Errorf("sync %s: %v", dst.Name(), err)
+	}
+	return nil
+}
+
+// applyCompactMoves points the needle map to the moved needles, or deletes the expired ones,
+// unless they were overwritten or deleted after being staged, and advances the checkpoint.
+// The caller holds dataFileAccessLock.
+func (v *Volume) applyCompactMoves(cp *compactCheckpoint) error {
+	for _, m := range cp.moves {
+		nv, ok := v.nm.Get(m.key)
+		if !ok || nv.Offset != m.from || nv.Size != m.size {
+			continue
+		}
+		if m.to.IsZero() {
+			if err := v.nm.Delete(m.key, m.from); err != nil {
+				return fmt.Errorf("delete expired needle %d: %v", m.key, err)
+			}
+			continue
+		}
+		if err := v.nm.Put(m.key, m.to, m.size); err != nil {
+			return fmt.Errorf("update needle %d offset: %v", m.key, err)
+		}
+	}
+	if err := v.nm.Sync(); err != nil {
+		return fmt.Errorf("sync volume %d idx: %v", v.Id, err)
+	}
+	// the moved needles overwrite the offsets of older records
+	v.uncacheVolume()
+	v.compactedSegments++
+
+	cp.writeOffset += cp.segmentSize
+	cp.readOffset = cp.segmentEnd
+	cp.segmentSize, cp.segmentEnd, cp.moves = 0, 0, nil
+	return cp.save(v.FileName(".cpk"))
+}
+
+// writeCompactedIndexFile writes the live entries below writeOffset in offset order,
+// which keeps the .idx usable for BinarySearchByAppendAtNs.
+func writeCompactedIndexFile(idxFileName, cpxFileName string, writeOffset int64) error {
+	nm := needle_map.NewMemDb()
+	defer nm.Close()
+	if err := nm.LoadFromIdx(idxFileName); err != nil {
+		return fmt.Errorf("load %s: %v", idxFileName, err)
+	}
+	var values []needle_map.NeedleValue
+	err := nm.AscendingVisit(func(value needle_map.NeedleValue) error {
+		if !value.Size.IsValid() {
+			// empty needles are dropped when loading the needle map anyway
+			return nil
+		}
+		if value.Offset.ToActualOffset() >= writeOffset {
+			return fmt.Errorf("needle %d at offset %d is beyond compacted size %d", value.Key, value.Offset.ToActualOffset(), writeOffset)
+		}
+		values = append(values, value)
+		return nil
+	})
+	if err != nil {
+		return err
+	}
+	sort.Slice(values, func(i, j int) bool {
+		return values[i].Offset.ToActualOffset() < values[j].Offset.ToActualOffset()
+	})
+
+	cpxFile, err := os.OpenFile(cpxFileName, os.O_WRONLY|os.O_CREATE|os.O_TRUNC, 0644)
+	if err != nil {
+		return err
+	}
+	defer cpxFile.Close()
+	for _, value := range values {
+		if _, err = cpxFile.Write(value.ToBytes()); err != nil {
+			return fmt.Errorf("write %s: %v", cpxFileName, err)
+		}
+	}
+	return cpxFile.Sync()
+}
+
+// finishSegmentedCommit truncates the compacted .dat file, bumps its compaction revision
+// and swaps in the compacted index. Every step can be repeated after a crash.
+func (v *Volume) finishSegmentedCommit(cp *compactCheckpoint) error {
+	dataFile, err := os.OpenFile(v.FileName(".dat"), os.O_RDWR, 0644)
+	if err != nil {
+		return err
+	}
+	datBackend := backend.NewDiskFile(dataFile)
+	defer datBackend.Close()
+
+	if err = datBackend.Truncate(cp.writeOffset); err != nil {
+		return fmt.Errorf("truncate %s to %d: %v", v.FileName(".dat"), cp.writeOffset, err)
+	}
+	sb, err := super_block.ReadSuperBlock(datBackend)
+	if err != nil {
+		return err
+	}
+	if sb.CompactionRevision == cp.revision {
+		sb.CompactionRevision++
+		if _, err = datBackend.WriteAt(sb.Bytes(), 0); err != nil {
+			return fmt.Errorf("write super block %s: %v", v.FileName(".dat"), err)
+		}
+	}
+	if err = datBackend.Sync(); err != nil {
+		return err
+	}
+
+	if util.FileExists(v.FileName(".cpx")) {
+		if err = os.Rename(v.FileName(".cpx"), v.FileName(".idx")); err != nil {
+			return fmt.Errorf("rename %s: %v", v.FileName(".cpx"), err)
+		}
+	}
+	os.RemoveAll(v.FileName(".ldb"))
+	os.Remove(v.FileName(".cps"))
+	return os.Remove(v.FileName(".cpk"))
+}
+
+// recoverSegmentedCompactionData replays an interrupted segment copy or commit before the index is loaded.
+func (v *Volume) recoverSegmentedCompactionData() (*compactCheckpoint, error) {
+	cp, err := loadCompactCheckpoint(v.FileName(".cpk"))
+	if err != nil || cp == nil {
+		return nil, err
+	}
+	if cp.committing {
+		glog.V(0).Infof("volume %d finishes interrupted compaction commit", v.Id)
+		return nil, v.finishSegmentedCommit(cp)
+	}
+	if cp.segmentSize == 0 {
+		return nil, nil
+	}
+
+	glog.V(0).Infof("volume %d replays compaction segment [%d,%d)", v.Id, cp.writeOffset, cp.writeOffset+cp.segmentSize)
+	scratchFile, err := os.Open(v.FileName(".cps"))
+	if err != nil {
+		return nil, fmt.Errorf("open compaction scratch file: %v", err)
+	}
+	defer scratchFile.Close()
+	dataFile, err := os.OpenFile(v.FileName(".dat"), os.O_RDWR, 0644)
+	if err != nil {
+		return nil, err
+	}
+	datBackend := backend.NewDiskFile(dataFile)
+	defer datBackend.Close()
+	if err = copyCompactSegment(scratchFile, datBackend, cp); err != nil {
+		return nil, err
+	}
+	return cp, nil
+}
+
+// cleanupSegmentedCompact stops the segmented compaction between segments. The compacted
+// segments are kept, the bytes between them and the data not compacted yet are filled with
+// needles in no needle map, and the volume is committed without truncating the data file.
+func (v *Volume) cleanupSegmentedCompact() error {
+	if v.isCompactingInPlace {
+		return fmt.Errorf("volume %d is being compacted in place", v.Id)
+	}
+	glog.V(0).Infof("Cleaning up volume %d segmented vacuuming...", v.Id)
+
+	v.compactMoveLock.Lock()
+	defer v.compactMoveLock.Unlock()
+	v.dataFileAccessLock.Lock()
+	defer v.dataFileAccessLock.Unlock()
+
+	cp, err := loadCompactCheckpoint(v.FileName(".cpk"))
+	if err != nil || cp == nil {
+		return err
+	}
+	if cp.committing || cp.segmentSize > 0 {
+		return fmt.Errorf("volume %d has an unfinished compaction segment, reload the volume to replay it", v.Id)
+	}
+	if cp.readOffset == int64(v.SuperBlock.BlockSize()) {
+		// no segment is compacted yet
+		os.Remove(v.FileName(".cps"))
+		return os.Remove(v.FileName(".cpk"))
+	}
+
+	if err = writeCompactGap(v.DataBackend, v.Version(), cp.writeOffset, cp.readOffset-cp.writeOffset); err != nil {
+		return err
+	}
+	if err = v.DataBackend.Sync(); err != nil {
+		return err
+	}
+	if cp.writeOffset, _, err = v.DataBackend.GetStat(); err != nil {
+		return err
+	}
+	return v.commitCompactCheckpoint(cp)
+}
+
+// compactGapNeedleLimit is the max size of one needle filling a compaction gap
+const compactGapNeedleLimit int64 = 1024 * 1024
+
+// writeCompactGap fills [offset, offset+size) with needles of id 0 and empty data,
+// so the data file can be scanned needle by needle again.
+func writeCompactGap(dst backend.BackendStorageFile, version needle.Version, offset, size int64) error {
+	for size > 0 {
+		blobSize := size
+		if blobSize > compactGapNeedleLimit {
+			blobSize = compactGapNeedleLimit
+		}
+		if rest := size - blobSize; rest > 0 && rest < 4*NeedleHeaderSize {
+			// leave room for the smallest needle
+			blobSize -= 4 * NeedleHeaderSize
+		}
+		blob, err := compactGapBlob(version, blobSize)
+		if err != nil {
+			return err
+		}
+		if _, err = dst.WriteAt(blob, offset); err != nil {
+			return fmt.Errorf("fill compaction gap of %s at %d: %v", dst.Name(), offset, err)
+		}
+		offset += blobSize
+		size -= blobSize
+	}
+	return nil
+}
+
+func compactGapBlob(version needle.Version, blobSize int64) ([]byte, error) {
+	for dataSize := blobSize - NeedleHeaderSize; dataSize >= 0; dataSize-- {
+		needleSize := Size(dataSize)
+		if version != needle.Version1 && dataSize > 0 {
+			// the data size and the flags
+			needleSize += DataSizeSize + 1
+		}
+		if needle.GetActualSize(needleSize, version) == blobSize {
+			n := &needle.Needle{Data: make([]byte, dataSize)}
+			return n.ToBlob(version)
+		}
+	}
+	return nil, fmt.Errorf("no needle has %d bytes", blobSize)
+}
@@ -1,7 +1,9 @@
 package storage
 
 import (
+	"fmt"
 	"math/rand"
+	"os"
 	"reflect"
 	"testing"
 	"time"
@@ -145,6 +147,257 @@ func testCompaction(t *testing.T, needleMapKind NeedleMapKind) {
 	}
 
 }
+func TestSegmentedCompaction(t *testing.T) {
+	dir := t.TempDir()
+
+	v, err := NewVolume(dir, dir, "", 1, NeedleMapInMemory, &super_block.ReplicaPlacement{}, &needle.TTL{}, 0, 0, 0)
+	if err != nil {
+		t.Fatalf("volume creation: %v", err)
+	}
+
+	fileCount := 2000
+	infos := make([]*needleInfo, 2*fileCount)
+	for i := 1; i <= fileCount; i++ {
+		doSomeWritesDeletes(i, v, t, infos)
+	}
+	// overwrite half of the files to create garbage
+	for i := 1; i <= fileCount; i += 2 {
+		doSomeWritesDeletes(i, v, t, infos)
+	}
+	revision := v.SuperBlock.CompactionRevision
+
+	// file copies and in place compaction exclude each other
+	if err = v.StartFileCopy(); err != nil {
+		t.Fatalf("start file copy: %v", err)
+	}
+	if err = v.CompactSegmented(64*1024, 0, nil); err == nil {
+		t.Fatalf("compaction should be refused while the volume files are copied")
+	}
+	v.FinishFileCopy()
+
+	if err = v.CompactSegmented(64*1024, 0, nil); err != nil {
+		t.Fatalf("compact segmented: %v", err)
+	}
+	if err = v.StartFileCopy(); err == nil {
+		t.Fatalf("file copy should be refused while compacting in place")
+	}
+
+	// writes during compaction, and a restart resuming from the checkpoint
+	for i := fileCount + 1; i <= 2*fileCount; i++ {
+		doSomeWritesDeletes(i, v, t, infos)
+	}
+	v.Close()
+	if v, err = NewVolume(dir, dir, "", 1, NeedleMapInMemory, nil, nil, 0, 0, 0); err != nil {
+		t.Fatalf("volume reloading: %v", err)
+	}
+	if err = v.CompactSegmented(64*1024, 0, nil); err != nil {
+		t.Fatalf("resume compact segmented: %v", err)
+	}
+	// more than a segment written before the commit is caught up with before taking the lock
+	segmentsBefore := v.compactedSegments
+	sinceNs := v.lastAppendAtNs
+	for i := 1; i <= fileCount; i += 3 {
+		doSomeWritesDeletes(i, v, t, infos)
+	}
+
+	// tailing reads the needles in append order while compacting in place
+	if !v.hasCompactCheckpoint() {
+		t.Fatalf("compaction should still be in place before the commit")
+	}
+	appended := scanAppendedSince(t, v, sinceNs)
+	for i := 1; i <= fileCount; i += 3 {
+		if !appended[uint64(i)] {
+			t.Fatalf("file %d written at %d is not tailed", i, sinceNs)
+		}
+	}
+	appended = scanAppendedSince(t, v, 0)
+	for i := 1; i <= 2*fileCount; i++ {
+		if infos[i-1].size != 0 && !appended[uint64(i)] {
+			t.Fatalf("file %d is not copied incrementally", i)
+		}
+	}
+	datSizeBefore, _, _ := v.FileStat()
+	if err = v.CommitCompact(); err != nil {
+		t.Fatalf("commit compact: %v", err)
+	}
+
+	if v.hasCompactCheckpoint() {
+		t.Fatalf("checkpoint should be removed after commit")
+	}
+	if v.compactedSegments < segmentsBefore+2 {
+		t.Fatalf("commit compacted %d segments, expected the writes to span several", v.compactedSegments-segmentsBefore)
+	}
+	if v.SuperBlock.CompactionRevision != revision+1 {
+		t.Fatalf("compaction revision %d, expected %d", v.SuperBlock.CompactionRevision, revision+1)
+	}
+	if datSizeAfter, _, _ := v.FileStat(); datSizeAfter >= datSizeBefore {
+		t.Fatalf("dat size %d is not compacted from %d", datSizeAfter, datSizeBefore)
+	}
+	if realRecordCount := v.nm.IndexFileSize() / types.NeedleMapEntrySize; realRecordCount != v.FileCount() {
+		t.Fatalf("index has %d entries for %d files", realRecordCount, v.FileCount())
+	}
+
+	for i := 1; i <= 2*fileCount; i++ {
+		if infos[i-1].size == 0 {
+			continue
+		}
+		n := newEmptyNeedle(uint64(i))
+		size, err := v.readNeedle(n, nil, nil)
+		if err != nil {
+			t.Fatalf("read file %d: %v", i, err)
+		}
+		if infos[i-1].size != types.Size(size) || infos[i-1].crc != n.Checksum {
+			t.Fatalf("read file %d mismatch", i)
+		}
+	}
+	v.Close()
+}
+
+func TestSegmentedCompactionAbort(t *testing.T) {
+	dir := t.TempDir()
+
+	v, err := NewVolume(dir, dir, "", 1, NeedleMapInMemory, &super_block.ReplicaPlacement{}, &needle.TTL{}, 0, 0, 0)
+	if err != nil {
+		t.Fatalf("volume creation: %v", err)
+	}
+	fileCount := 1000
+	infos := make([]*needleInfo, fileCount)
+	for i := 1; i <= fileCount; i++ {
+		doSomeWritesDeletes(i, v, t, infos)
+	}
+	for i := 1; i <= fileCount; i += 2 {
+		doSomeWritesDeletes(i, v, t, infos)
+	}
+	revision := v.SuperBlock.CompactionRevision
+
+	// stop between segments
+	segments := 0
+	err = v.CompactSegmented(64*1024, 0, func(processed int64) bool {
+		segments++
+		return segments <= 2
+	})
+	if err == nil || !v.hasCompactCheckpoint() {
+		t.Fatalf("compaction is not interrupted: %v", err)
+	}
+	if err = v.cleanupCompact(); err != nil {
+		t.Fatalf("cleanup compact: %v", err)
+	}
+	for _, ext := range []string{".cpk", ".cps", ".cpx"} {
+		if _, err := os.Stat(v.FileName(ext)); !os.IsNotExist(err) {
+			t.Fatalf("%s is not removed: %v", ext, err)
+		}
+	}
+	if v.SuperBlock.CompactionRevision != revision+1 {
+		t.Fatalf("compaction revision %d, expected %d", v.SuperBlock.CompactionRevision, revision+1)
+	}
+	if _, _, err = v.BinarySearchByAppendAtNs(0); err != nil {
+		t.Fatalf("incremental copy after abort: %v", err)
+	}
+
+	check := func() {
+		for i := 1; i <= fileCount; i++ {
+			if infos[i-1].size == 0 {
+				continue
+			}
+			n := newEmptyNeedle(uint64(i))
+			size, err := v.readNeedle(n, nil, nil)
+			if err != nil {
+				t.Fatalf("read file %d: %v", i, err)
+			}
+			if infos[i-1].size != types.Size(size) || infos[i-1].crc != n.Checksum {
+				t.Fatalf("read file %d mismatch", i)
+			}
+		}
+	}
+	check()
+
+	// the gap is scanned like other needles by the copying compaction
+	if err = v.Compact(0, 0); err != nil {
+		t.Fatalf("compact: %v", err)
+	}
+	if err = v.CommitCompact(); err != nil {
+		t.Fatalf("commit compact: %v", err)
+	}
+	check()
+	v.Close()
+}
+
+func TestSegmentedCompactionTtl(t *testing.T) {
+	dir := t.TempDir()
+
+	ttl, _ := needle.ReadTTL("1m")
+	v, err := NewVolume(dir, dir, "", 1, NeedleMapInMemory, &super_block.ReplicaPlacement{}, ttl, 0, 0, 0)
+	if err != nil {
+		t.Fatalf("volume creation: %v", err)
+	}
+	for i := 1; i <= 3; i++ {
+		n := newRandomNeedle(uint64(i))
+		if i == 2 {
+			n.LastModified = uint64(time.Now().Add(-time.Hour).Unix())
+		} else {
+			n.LastModified = uint64(time.Now().Unix())
+		}
+		n.SetHasLastModifiedDate()
+		n.Ttl = ttl
+		n.SetHasTtl()
+		if _, _, _, err = v.writeNeedle2(n, true, false); err != nil {
+			t.Fatalf("write file %d: %v", i, err)
+		}
+	}
+
+	if err = v.CompactSegmented(64*1024, 0, nil); err != nil {
+		t.Fatalf("compact segmented: %v", err)
+	}
+	if nv, ok := v.nm.Get(2); ok && nv.Size.IsValid() {
+		t.Fatalf("expired needle is still at offset %d", nv.Offset.ToActualOffset())
+	}
+	if err = v.CommitCompact(); err != nil {
+		t.Fatalf("commit compact: %v", err)
+	}
+	for i := 1; i <= 3; i += 2 {
+		if _, err = v.readNeedle(newEmptyNeedle(uint64(i)), nil, nil); err != nil {
+			t.Fatalf("read file %d: %v", i, err)
+		}
+	}
+	v.Close()
+}
+
+// scanAppendedSince returns the ids of the needles appended after sinceNs.
+func scanAppendedSince(t *testing.T, v *Volume, sinceNs uint64) map[uint64]bool {
+	scanner := &appendedNeedleScanner{sinceNs: sinceNs, ids: make(map[uint64]bool)}
+	err := v.ReadAppendedSince(sinceNs, func(ranges []DataRange) error {
+		for _, r := range ranges {
+			if err := ScanVolumeFileRange(v.Version(), v.DataBackend, r.Start, r.Stop, scanner); err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+	if err != nil {
+		t.Fatalf("read appended since %d: %v", sinceNs, err)
+	}
+	return scanner.ids
+}
+
+type appendedNeedleScanner struct {
+	sinceNs uint64
+	ids     map[uint64]bool
+}
+
+func (scanner *appendedNeedleScanner) VisitSuperBlock(superBlock super_block.SuperBlock) error {
+	return nil
+}
+func (scanner *appendedNeedleScanner) ReadNeedleBody() bool {
+	return true
+}
+func (scanner *appendedNeedleScanner) VisitNeedle(n *needle.Needle, offset int64, needleHeader, needleBody []byte) error {
+	if n.AppendAtNs <= scanner.sinceNs {
+		return fmt.Errorf("needle %d at %d appended at %d, not after %d", n.Id, offset, n.AppendAtNs, scanner.sinceNs)
+	}
+	scanner.ids[uint64(n.Id)] = true
+	return nil
+}
+
 func doSomeWritesDeletes(i int, v *Volume, t *testing.T, infos []*needleInfo) {
 	n := newRandomNeedle(uint64(i))
 	_, size, _, err := v.writeNeedle2(n, true, false)
@@ -14,6 +14,7 @@ import (
 var ErrorNotFound = errors.New("not found")
 var ErrorDeleted = errors.New("already deleted")
 var ErrorSizeMismatch = errors.New("size mismatch")
+var ErrorAppendConflict = errors.New("append offset conflict")
 
 func (v *Volume) checkReadWriteError(err error) {
 	if err == nil {
@@ -42,6 +43,14 @@ func (v *Volume) isFileUnchanged(n *needle.Needle) bool {
 			glog.V(0).Infof("Failed to check updated file at offset %d size %d: %v", nv.Offset.ToActualOffset(), nv.Size, err)
 			return false
 		}
+		if err = decryptNeedle(oldNeedle, v.dataKey); err != nil {
+			glog.V(0).Infof("Failed to check updated file at offset %d size %d: %v", nv.Offset.ToActualOffset(), nv.Size, err)
+			return false
+		}
+		if oldNeedle.IsAppendContinuation() {
+			// only holds the data appended last
+			return false
+		}
 		if oldNeedle.Cookie == n.Cookie && oldNeedle.Checksum == n.Checksum && bytes.Equal(oldNeedle.Data, n.Data) {
 			n.DataSize = oldNeedle.DataSize
 			return true
@@ -92,13 +101,22 @@ func removeVolumeFiles(filename string) {
 	os.Remove(filename + ".vif")
 	// sorted index file
 	os.Remove(filename + ".sdx")
+	os.Remove(filename + ".sdm")
 	// compaction
 	os.Remove(filename + ".cpd")
 	os.Remove(filename + ".cpx")
+	os.Remove(filename + ".cps")
+	os.Remove(filename + ".cpk")
 	// level db index file
 	os.RemoveAll(filename + ".ldb")
 	// marker for damaged or incomplete volume
 	os.Remove(filename + ".note")
+	// checkpoint of an interrupted copy
+	os.Remove(filename + ".cpy")
+	// position of a replica following another data center
+	os.Remove(filename + ".arp")
+	// quarantined needles found by scrubbing
+	os.Remove(filename + ".qdx")
 }
 
 func (v *Volume) asyncRequestAppend(request *needle.AsyncRequest) {
@@ -150,12 +168,14 @@ func (v *Volume) doWriteRequest(n *needle.Needle, checkCookie bool) (offset uint
 			err = fmt.Errorf("reading existing needle: %v", existingNeedleReadErr)
 			return
 		}
-		if n.Cookie == 0 && !checkCookie {
+		// in place compaction may have moved other needles over the bytes of a deleted needle
+		isSameNeedle := nv.Size.IsValid() || existingNeedle.Id == n.Id
+		if isSameNeedle && n.Cookie == 0 && !checkCookie {
 			// this is from batch deletion, and read back again when tailing a remote volume
 			// which only happens when checkCookie == false and fsync == false
 			n.Cookie = existingNeedle.Cookie
 		}
-		if existingNeedle.Cookie != n.Cookie {
+		if isSameNeedle && existingNeedle.Cookie != n.Cookie {
 			glog.V(0).Infof("write cookie mismatch: existing %s, new %s",
 				needle.NewFileIdFromNeedle(v.Id, existingNeedle), needle.NewFileIdFromNeedle(v.Id, n))
 			err = fmt.Errorf("mismatching cookie %x", n.Cookie)
@@ -165,18 +185,30 @@ func (v *Volume) doWriteRequest(n *needle.Needle, checkCookie bool) (offset uint
 
 	// append to dat file
 	n.UpdateAppendAtNs(v.lastAppendAtNs)
-	offset, size, _, err = n.Append(v.DataBackend, v.Version())
+	toWrite := n
+	if v.IsEncrypted() && len(n.Data) > 0 {
+		if toWrite, err = v.encryptNeedle(n); err != nil {
+			return
+		}
+	}
+	offset, _, _, err = toWrite.Append(v.DataBackend, v.Version())
 	v.checkReadWriteError(err)
 	if err != nil {
 		return
 	}
+	if toWrite != n {
+		// keep the plain text needle consistent with what was appended
+		n.Size, n.DataSize, n.AppendAtNs = toWrite.Size, uint32(len(n.Data)), toWrite.AppendAtNs
+	}
+	size = Size(len(n.Data))
 	v.lastAppendAtNs = n.AppendAtNs
 
 	// add to needle map
 	if !ok || uint64(nv.Offset.ToActualOffset()) < offset {
-		if err = v.nm.Put(n.Id, ToOffset(int64(offset)), n.Size); err != nil {
+		if err = v.nm.Put(n.Id, ToOffset(int64(offset)), toWrite.Size); err != nil {
 			glog.V(4).Infof("failed to save in needle map %d: %v", n.Id, err)
 		}
+		v.uncacheNeedle(n.Id)
 	}
 	if v.lastModifiedTsSeconds < n.LastModified {
 		v.lastModifiedTsSeconds = n.LastModified
@@ -234,6 +266,7 @@ func (v *Volume) doDeleteRequest(n *needle.Needle) (Size, error) {
 		if err = v.nm.Delete(n.Id, ToOffset(int64(offset))); err != nil {
 			return size, err
 		}
+		v.uncacheNeedle(n.Id)
 		return size, err
 	}
 	return 0, nil
@@ -336,6 +369,7 @@ func (v *Volume) WriteNeedleBlob(needleId NeedleId, needleBlob []byte, size Size
 	if err = v.nm.Put(needleId, ToOffset(int64(offset)), size); err != nil {
 		glog.V(4).Infof("failed to put in needle map %d: %v", needleId, err)
 	}
+	v.uncacheNeedle(needleId)
 
 	return err
 }
@@ -6,6 +6,7 @@ import (
 	hashicorpRaft "github.com/hashicorp/raft"
 	"github.com/seaweedfs/raft"
 	"github.com/gateway-dao/seaweedfs/weed/glog"
+	"github.com/gateway-dao/seaweedfs/weed/storage/erasure_coding"
 	"github.com/gateway-dao/seaweedfs/weed/storage/needle"
 )
 
@@ -34,7 +35,87 @@ func (c *MaxVolumeIdCommand) Apply(server raft.Server) (interface{}, error) {
 	return nil, nil
 }
 
-func (s *MaxVolumeIdCommand) Persist(sink hashicorpRaft.SnapshotSink) error {
+// ReserveFileIdsCommand reserves a range of file ids for the sequencer of the leader.
+// The range starts at the max reserved file id, or MinFileId if larger, when the command is applied,
+// so the ranges never overlap even if proposed by different leaders.
+type ReserveFileIdsCommand struct {
+	MinFileId uint64 `json:"minFileId"`
+	Count     uint64 `json:"reserveFileIds"`
+}
+
+func NewReserveFileIdsCommand(minFileId, count uint64) *ReserveFileIdsCommand {
+	return &ReserveFileIdsCommand{
+		MinFileId: minFileId,
+		Count:     count,
+	}
+}
+
+func (c *ReserveFileIdsCommand) CommandName() string {
+	return "ReserveFileIds"
+}
+
+// Apply returns the start of the reserved range.
+func (c *ReserveFileIdsCommand) Apply(server raft.Server) (interface{}, error) {
+	topo := server.Context().(*Topology)
+	return topo.ApplyReserveFileIds(c), nil
+}
+
+// SetNodeStateCommand changes the state of a data node, e.g. to drain it.
+type SetNodeStateCommand struct {
+	Node  string    `json:"node"`
+	State NodeState `json:"nodeState"`
+}
+
+func NewSetNodeStateCommand(node string, state NodeState) *SetNodeStateCommand {
+	return &SetNodeStateCommand{
+		Node:  node,
+		State: state,
+	}
+}
+
+func (c *SetNodeStateCommand) CommandName() string {
+	return "SetNodeState"
+}
+
+func (c *SetNodeStateCommand) Apply(server raft.Server) (interface{}, error) {
+	topo := server.Context().(*Topology)
+	topo.ApplyNodeState(c)
+	return nil, nil
+}
+
+// SetCollectionEcSchemeCommand changes the ec scheme of a collection.
+// The scheme is always set, since the default collection has no name.
+type SetCollectionEcSchemeCommand struct {
+	Collection string `json:"ecCollection"`
+	Scheme     string `json:"ecScheme"`
+}
+
+func NewSetCollectionEcSchemeCommand(collection string, scheme erasure_coding.EcScheme) *SetCollectionEcSchemeCommand {
+	return &SetCollectionEcSchemeCommand{
+		Collection: collection,
+		Scheme:     scheme.String(),
+	}
+}
+
+func (c *SetCollectionEcSchemeCommand) CommandName() string {
+	return "SetCollectionEcScheme"
+}
+
+func (c *SetCollectionEcSchemeCommand) Apply(server raft.Server) (interface{}, error) {
+	topo := server.Context().(*Topology)
+	topo.ApplyCollectionEcScheme(c)
+	return nil, nil
+}
+
+// ClusterState is the state kept in the raft snapshots.
+type ClusterState struct {
+	MaxVolumeId         needle.VolumeId      `json:"maxVolumeId"`
+	MaxFileId           uint64               `json:"maxFileId,omitempty"`
+	NodeStates          map[string]NodeState `json:"nodeStates,omitempty"`          // the data nodes not active, by url
+	CollectionEcSchemes map[string]string    `json:"collectionEcSchemes,omitempty"` // the collections not using the default ec scheme
+}
+
+func (s *ClusterState) Persist(sink hashicorpRaft.SnapshotSink) error {
 	b, err := json.Marshal(s)
 	if err != nil {
 		return fmt.Errorf("marshal: %v", err)
@@ -47,5 +128,5 @@ func (s *MaxVolumeIdCommand) Persist(sink hashicorpRaft.SnapshotSink) error {
 	return sink.Close()
 }
 
-func (s *MaxVolumeIdCommand) Release() {
+func (s *ClusterState) Release() {
 }
@@ -0,0 +1,78 @@
+package topology
+
+import (
+	"encoding/json"
+	"fmt"
+	"time"
+
+	"github.com/gateway-dao/seaweedfs/weed/glog"
+	"github.com/gateway-dao/seaweedfs/weed/storage/erasure_coding"
+)
+
+// SetCollectionEcScheme changes the ec scheme new ec volumes of the collection are encoded with, through the raft log.
+func (t *Topology) SetCollectionEcScheme(collection string, scheme erasure_coding.EcScheme) error {
+	command := NewSetCollectionEcSchemeCommand(collection, scheme)
+
+	t.RaftServerAccessLock.RLock()
+	defer t.RaftServerAccessLock.RUnlock()
+
+	if t.RaftServer != nil {
+		_, err := t.RaftServer.Do(command)
+		return err
+	} else if t.HashicorpRaft != nil {
+		b, err := json.Marshal(command)
+		if err != nil {
+			return fmt.Errorf("failed marshal SetCollectionEcSchemeCommand: %+v", err)
+		}
+		return t.HashicorpRaft.Apply(b, time.Second).Error()
+	}
+	return fmt.Errorf("raft server not ready yet")
+}
+
+// ApplyCollectionEcScheme applies a committed SetCollectionEcSchemeCommand.
+func (t *Topology) ApplyCollectionEcScheme(c *SetCollectionEcSchemeCommand) {
+	scheme, err := erasure_coding.ParseEcScheme(c.Scheme)
+	if err != nil {
+		glog.Errorf("collection %q: %v", c.Collection, err)
+		return
+	}
+	t.ecSchemesLock.Lock()
+	if scheme.IsDefault() {
+		delete(t.ecSchemes, c.Collection)
+	} else {
+		t.ecSchemes[c.Collection] = scheme
+	}
+	t.ecSchemesLock.Unlock()
+	glog.V(0).Infof("collection %q is erasure coded with %s", c.Collection, scheme)
+}
+
+// RestoreCollectionEcSchemes replaces the ec schemes of all collections, from a raft snapshot.
+func (t *Topology) RestoreCollectionEcSchemes(schemes map[string]string) {
+	t.ecSchemesLock.Lock()
+	t.ecSchemes = make(map[string]erasure_coding.EcScheme)
+	t.ecSchemesLock.Unlock()
+	for collection, scheme := range schemes {
+		t.ApplyCollectionEcScheme(&SetCollectionEcSchemeCommand{Collection: collection, Scheme: scheme})
+	}
+}
+
+// GetCollectionEcScheme returns the ec scheme of the collection, or the default scheme.
+func (t *Topology) GetCollectionEcScheme(collection string) erasure_coding.EcScheme {
+	t.ecSchemesLock.RLock()
+	defer t.ecSchemesLock.RUnlock()
+	if scheme, found := t.ecSchemes[collection]; found {
+		return scheme
+	}
+	return erasure_coding.DefaultEcScheme
+}
+
+// CollectionEcSchemes returns the collections not using the default ec scheme.
+func (t *Topology) CollectionEcSchemes() map[string]string {
+	t.ecSchemesLock.RLock()
+	defer t.ecSchemesLock.RUnlock()
+	schemes := make(map[string]string, len(t.ecSchemes))
+	for collection, scheme := range t.ecSchemes {
+		schemes[collection] = scheme.String()
+	}
+	return schemes
+}
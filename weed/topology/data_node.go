@@ -21,6 +21,11 @@ type DataNode struct {
 	LastSeen      int64 // unix time in seconds
 	Counter       int   // in race condition, the previous dataNode was not dead
 	IsTerminating bool
+
+	freeDiskBytes       map[string]uint64 // by disk type, from the heartbeats
+	writeBytesPerSecond uint64
+
+	state atomic.Value // NodeState
 }
 
 func NewDataNode(id string) *DataNode {
@@ -60,6 +65,28 @@ func (dn *DataNode) doAddOrUpdateVolume(v storage.VolumeInfo) (isNew, isChanged
 	return disk.AddOrUpdateVolume(v)
 }
 
+// UpdateLoadStats records the free disk space and write throughput reported in a heartbeat.
+func (dn *DataNode) UpdateLoadStats(freeDiskBytes map[string]uint64, writeBytesPerSecond uint64) {
+	dn.Lock()
+	defer dn.Unlock()
+	dn.freeDiskBytes = freeDiskBytes
+	dn.writeBytesPerSecond = writeBytesPerSecond
+}
+
+// FreeDiskBytes is the free disk space for the disk type, if reported by the volume server.
+func (dn *DataNode) FreeDiskBytes(diskType types.DiskType) (freeBytes uint64, found bool) {
+	dn.RLock()
+	defer dn.RUnlock()
+	freeBytes, found = dn.freeDiskBytes[string(diskType)]
+	return
+}
+
+func (dn *DataNode) WriteBytesPerSecond() uint64 {
+	dn.RLock()
+	defer dn.RUnlock()
+	return dn.writeBytesPerSecond
+}
+
 // UpdateVolumes detects new/deleted/changed volumes on a volume server
 // used in master to notify master clients of these changes.
 func (dn *DataNode) UpdateVolumes(actualVolumes []storage.VolumeInfo) (newVolumes, deletedVolumes, changedVolumes []storage.VolumeInfo) {
@@ -234,6 +261,7 @@ type DataNodeInfo struct {
 	EcShards  int64  `json:"EcShards"`
 	Max       int64  `json:"Max"`
 	VolumeIds string `json:"VolumeIds"`
+	State     string `json:"State"`
 }
 
 func (dn *DataNode) ToInfo() (info DataNodeInfo) {
@@ -258,6 +286,7 @@ func (dn *DataNode) ToInfo() (info DataNodeInfo) {
 	info.EcShards = ecShardCount
 	info.Max = maxVolumeCount
 	info.VolumeIds = volumeIds
+	info.State = string(dn.State())
 
 	return
 }
@@ -268,6 +297,9 @@ func (dn *DataNode) ToDataNodeInfo() *master_pb.DataNodeInfo {
 		DiskInfos: make(map[string]*master_pb.DiskInfo),
 		GrpcPort:  uint32(dn.GrpcPort),
 	}
+	if !dn.IsActive() {
+		m.State = string(dn.State())
+	}
 	for _, c := range dn.Children() {
 		disk := c.(*Disk)
 		m.DiskInfos[string(disk.Id())] = disk.ToDiskInfo()
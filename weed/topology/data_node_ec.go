@@ -34,11 +34,14 @@ func (dn *DataNode) UpdateEcShards(actualShards []*erasure_coding.EcVolumeInfo)
 		deltaDiskUsage := deltaDiskUsages.getOrCreateDisk(types.ToDiskType(ecShards.DiskType))
 
 		vid := ecShards.VolumeId
+		existingSlots := ecSlotsMilli(ecShards.Scheme, ecShards.ShardIdCount())
 		if actualEcShards, ok := actualEcShardMap[vid]; !ok {
 			// dn registered ec shards not found in the new set of ec shards
 			deletedShards = append(deletedShards, ecShards)
 			deletedShardCount += ecShards.ShardIdCount()
+			deltaDiskUsage.ecSlotsMilli = -existingSlots
 		} else {
+			deltaDiskUsage.ecSlotsMilli = ecSlotsMilli(ecShards.Scheme, actualEcShards.ShardIdCount()) - existingSlots
 			// found, but maybe the actual shard could be missing
 			a := actualEcShards.Minus(ecShards)
 			if a.ShardIdCount() > 0 {
@@ -68,6 +71,7 @@ func (dn *DataNode) UpdateEcShards(actualShards []*erasure_coding.EcVolumeInfo)
 		deltaDiskUsages := newDiskUsages()
 		deltaDiskUsage := deltaDiskUsages.getOrCreateDisk(types.ToDiskType(ecShards.DiskType))
 		deltaDiskUsage.ecShardCount = int64(ecShards.ShardIdCount())
+		deltaDiskUsage.ecSlotsMilli = ecSlotsMilli(ecShards.Scheme, ecShards.ShardIdCount())
 		disk.UpAdjustDiskUsageDelta(deltaDiskUsages)
 	}
 
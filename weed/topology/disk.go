@@ -53,6 +53,7 @@ func (d *DiskUsages) negative() *DiskUsages {
 		a.remoteVolumeCount = -b.remoteVolumeCount
 		a.activeVolumeCount = -b.activeVolumeCount
 		a.ecShardCount = -b.ecShardCount
+		a.ecSlotsMilli = -b.ecSlotsMilli
 		a.maxVolumeCount = -b.maxVolumeCount
 
 	}
@@ -97,6 +98,7 @@ type DiskUsageCounts struct {
 	remoteVolumeCount int64
 	activeVolumeCount int64
 	ecShardCount      int64
+	ecSlotsMilli      int64 // the volume slots taken by ec shards, in thousandths
 	maxVolumeCount    int64
 }
 
@@ -105,13 +107,14 @@ func (a *DiskUsageCounts) addDiskUsageCounts(b *DiskUsageCounts) {
 	atomic.AddInt64(&a.remoteVolumeCount, b.remoteVolumeCount)
 	atomic.AddInt64(&a.activeVolumeCount, b.activeVolumeCount)
 	atomic.AddInt64(&a.ecShardCount, b.ecShardCount)
+	atomic.AddInt64(&a.ecSlotsMilli, b.ecSlotsMilli)
 	atomic.AddInt64(&a.maxVolumeCount, b.maxVolumeCount)
 }
 
 func (a *DiskUsageCounts) FreeSpace() int64 {
 	freeVolumeSlotCount := a.maxVolumeCount + a.remoteVolumeCount - a.volumeCount
 	if a.ecShardCount > 0 {
-		freeVolumeSlotCount = freeVolumeSlotCount - a.ecShardCount/erasure_coding.DataShardsCount - 1
+		freeVolumeSlotCount = freeVolumeSlotCount - a.ecSlotsMilli/1000 - 1
 	}
 	return freeVolumeSlotCount
 }
@@ -122,6 +125,7 @@ func (a *DiskUsageCounts) minus(b *DiskUsageCounts) *DiskUsageCounts {
 		remoteVolumeCount: a.remoteVolumeCount - b.remoteVolumeCount,
 		activeVolumeCount: a.activeVolumeCount - b.activeVolumeCount,
 		ecShardCount:      a.ecShardCount - b.ecShardCount,
+		ecSlotsMilli:      a.ecSlotsMilli - b.ecSlotsMilli,
 		maxVolumeCount:    a.maxVolumeCount - b.maxVolumeCount,
 	}
 }
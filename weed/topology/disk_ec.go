@@ -19,19 +19,22 @@ func (d *Disk) AddOrUpdateEcShard(s *erasure_coding.EcVolumeInfo) {
 	d.ecShardsLock.Lock()
 	defer d.ecShardsLock.Unlock()
 
-	delta := 0
+	delta, deltaSlots := 0, int64(0)
 	if existing, ok := d.ecShards[s.VolumeId]; !ok {
 		d.ecShards[s.VolumeId] = s
 		delta = s.ShardBits.ShardIdCount()
+		deltaSlots = ecSlotsMilli(s.Scheme, delta)
 	} else {
 		oldCount := existing.ShardBits.ShardIdCount()
 		existing.ShardBits = existing.ShardBits.Plus(s.ShardBits)
 		delta = existing.ShardBits.ShardIdCount() - oldCount
+		deltaSlots = ecSlotsMilli(existing.Scheme, existing.ShardBits.ShardIdCount()) - ecSlotsMilli(existing.Scheme, oldCount)
 	}
 
 	deltaDiskUsages := newDiskUsages()
 	deltaDiskUsage := deltaDiskUsages.getOrCreateDisk(types.ToDiskType(string(d.Id())))
 	deltaDiskUsage.ecShardCount = int64(delta)
+	deltaDiskUsage.ecSlotsMilli = deltaSlots
 	d.UpAdjustDiskUsageDelta(deltaDiskUsages)
 
 }
@@ -48,6 +51,7 @@ func (d *Disk) DeleteEcShard(s *erasure_coding.EcVolumeInfo) {
 		deltaDiskUsages := newDiskUsages()
 		deltaDiskUsage := deltaDiskUsages.getOrCreateDisk(types.ToDiskType(string(d.Id())))
 		deltaDiskUsage.ecShardCount = int64(delta)
+		deltaDiskUsage.ecSlotsMilli = ecSlotsMilli(existing.Scheme, existing.ShardBits.ShardIdCount()) - ecSlotsMilli(existing.Scheme, oldCount)
 		d.UpAdjustDiskUsageDelta(deltaDiskUsages)
 
 		if existing.ShardBits.ShardIdCount() == 0 {
@@ -57,6 +61,11 @@ func (d *Disk) DeleteEcShard(s *erasure_coding.EcVolumeInfo) {
 
 }
 
+// ecSlotsMilli is the volume slots taken by shardCount shards of an ec volume, in thousandths.
+func ecSlotsMilli(scheme erasure_coding.EcScheme, shardCount int) int64 {
+	return int64(scheme.VolumeSlots(shardCount) * 1000)
+}
+
 func (d *Disk) HasVolumesById(id needle.VolumeId) (hasVolumeId bool) {
 
 	// check whether normal volumes has this volume id
@@ -10,7 +10,6 @@ import (
 
 	"github.com/gateway-dao/seaweedfs/weed/glog"
 	"github.com/gateway-dao/seaweedfs/weed/stats"
-	"github.com/gateway-dao/seaweedfs/weed/storage/erasure_coding"
 	"github.com/gateway-dao/seaweedfs/weed/storage/needle"
 	"github.com/gateway-dao/seaweedfs/weed/storage/types"
 )
@@ -20,7 +19,7 @@ type Node interface {
 	Id() NodeId
 	String() string
 	AvailableSpaceFor(option *VolumeGrowOption) int64
-	ReserveOneVolume(r int64, option *VolumeGrowOption) (*DataNode, error)
+	ReserveOneVolume(r float64, weightFn func(Node) float64) (*DataNode, error)
 	UpAdjustDiskUsageDelta(deltaDiskUsages *DiskUsages)
 	UpAdjustMaxVolumeId(vid needle.VolumeId)
 	GetDiskUsages() *DiskUsages
@@ -59,20 +58,23 @@ func (n *NodeImpl) GetDiskUsages() *DiskUsages {
 }
 
 // the first node must satisfy filterFirstNodeFn(), the rest nodes must have one free slot
-func (n *NodeImpl) PickNodesByWeight(numberOfNodes int, option *VolumeGrowOption, filterFirstNodeFn func(dn Node) error) (firstNode Node, restNodes []Node, err error) {
-	var totalWeights int64
+// PickNodesByWeight picks numberOfNodes children randomly by their weights,
+// with the first node passing filterFirstNodeFn. The children weighing 0 are not picked.
+func (n *NodeImpl) PickNodesByWeight(numberOfNodes int, weightFn func(Node) float64, filterFirstNodeFn func(dn Node) error) (firstNode Node, restNodes []Node, err error) {
+	var totalWeights float64
 	var errs []string
 	n.RLock()
 	candidates := make([]Node, 0, len(n.children))
-	candidatesWeights := make([]int64, 0, len(n.children))
-	//pick nodes which has enough free volumes as candidates, and use free volumes number as node weight.
+	candidatesWeights := make([]float64, 0, len(n.children))
+	//pick nodes which has enough free volumes as candidates
 	for _, node := range n.children {
-		if node.AvailableSpaceFor(option) <= 0 {
+		weight := weightFn(node)
+		if weight <= 0 {
 			continue
 		}
-		totalWeights += node.AvailableSpaceFor(option)
+		totalWeights += weight
 		candidates = append(candidates, node)
-		candidatesWeights = append(candidatesWeights, node.AvailableSpaceFor(option))
+		candidatesWeights = append(candidatesWeights, weight)
 	}
 	n.RUnlock()
 	if len(candidates) < numberOfNodes {
@@ -83,17 +85,23 @@ func (n *NodeImpl) PickNodesByWeight(numberOfNodes int, option *VolumeGrowOption
 	//pick nodes randomly by weights, the node picked earlier has higher final weights
 	sortedCandidates := make([]Node, 0, len(candidates))
 	for i := 0; i < len(candidates); i++ {
-		weightsInterval := rand.Int63n(totalWeights)
-		lastWeights := int64(0)
+		weightsInterval := rand.Float64() * totalWeights
+		lastWeights := float64(0)
+		picked := -1
 		for k, weights := range candidatesWeights {
-			if (weightsInterval >= lastWeights) && (weightsInterval < lastWeights+weights) {
-				sortedCandidates = append(sortedCandidates, candidates[k])
-				candidatesWeights[k] = 0
-				totalWeights -= weights
+			if weights <= 0 {
+				continue
+			}
+			// the last remaining candidate also takes the rounding errors
+			picked = k
+			if weightsInterval < lastWeights+weights {
 				break
 			}
 			lastWeights += weights
 		}
+		sortedCandidates = append(sortedCandidates, candidates[picked])
+		totalWeights -= candidatesWeights[picked]
+		candidatesWeights[picked] = 0
 	}
 
 	restNodes = make([]Node, 0, numberOfNodes-1)
@@ -160,7 +168,7 @@ func (n *NodeImpl) AvailableSpaceFor(option *VolumeGrowOption) int64 {
 	freeVolumeSlotCount := atomic.LoadInt64(&t.maxVolumeCount) + atomic.LoadInt64(&t.remoteVolumeCount) - atomic.LoadInt64(&t.volumeCount)
 	ecShardCount := atomic.LoadInt64(&t.ecShardCount)
 	if ecShardCount > 0 {
-		freeVolumeSlotCount = freeVolumeSlotCount - ecShardCount/erasure_coding.DataShardsCount - 1
+		freeVolumeSlotCount = freeVolumeSlotCount - atomic.LoadInt64(&t.ecSlotsMilli)/1000 - 1
 	}
 	return freeVolumeSlotCount
 }
@@ -185,27 +193,26 @@ func (n *NodeImpl) GetValue() interface{} {
 	return n.value
 }
 
-func (n *NodeImpl) ReserveOneVolume(r int64, option *VolumeGrowOption) (assignedNode *DataNode, err error) {
+// ReserveOneVolume picks a data node under the node, r being a random number below the weight of the node.
+func (n *NodeImpl) ReserveOneVolume(r float64, weightFn func(Node) float64) (assignedNode *DataNode, err error) {
 	n.RLock()
 	defer n.RUnlock()
 	for _, node := range n.children {
-		freeSpace := node.AvailableSpaceFor(option)
-		// fmt.Println("r =", r, ", node =", node, ", freeSpace =", freeSpace)
-		if freeSpace <= 0 {
+		weight := weightFn(node)
+		if weight <= 0 {
 			continue
 		}
-		if r >= freeSpace {
-			r -= freeSpace
+		if r >= weight {
+			r -= weight
 		} else {
-			if node.IsDataNode() && node.AvailableSpaceFor(option) > 0 {
-				// fmt.Println("vid =", vid, " assigned to node =", node, ", freeSpace =", node.FreeSpace())
+			if node.IsDataNode() {
 				dn := node.(*DataNode)
 				if dn.IsTerminating {
 					continue
 				}
 				return dn, nil
 			}
-			assignedNode, err = node.ReserveOneVolume(r, option)
+			assignedNode, err = node.ReserveOneVolume(r, weightFn)
 			if err == nil {
 				return
 			}
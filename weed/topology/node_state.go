@@ -0,0 +1,163 @@
+package topology
+
+import (
+	"encoding/json"
+	"fmt"
+	"time"
+
+	"github.com/gateway-dao/seaweedfs/weed/glog"
+	"github.com/gateway-dao/seaweedfs/weed/storage/types"
+)
+
+// NodeState is the state of a data node set by the operators.
+// Draining and maintenance data nodes get no writes and no new volumes.
+// Draining data nodes are evacuated, while maintenance data nodes keep their data, e.g. for an upgrade.
+type NodeState string
+
+const (
+	NodeStateActive      NodeState = "active"
+	NodeStateDraining    NodeState = "draining"
+	NodeStateMaintenance NodeState = "maintenance"
+)
+
+func ParseNodeState(s string) (NodeState, error) {
+	switch state := NodeState(s); state {
+	case "", NodeStateActive:
+		return NodeStateActive, nil
+	case NodeStateDraining, NodeStateMaintenance:
+		return state, nil
+	}
+	return "", fmt.Errorf("unknown volume server state %q, expecting %s, %s or %s", s, NodeStateActive, NodeStateDraining, NodeStateMaintenance)
+}
+
+// SetNodeState changes the state of the data node through the raft log.
+func (t *Topology) SetNodeState(node string, state NodeState) error {
+	command := NewSetNodeStateCommand(node, state)
+
+	t.RaftServerAccessLock.RLock()
+	defer t.RaftServerAccessLock.RUnlock()
+
+	if t.RaftServer != nil {
+		_, err := t.RaftServer.Do(command)
+		return err
+	} else if t.HashicorpRaft != nil {
+		b, err := json.Marshal(command)
+		if err != nil {
+			return fmt.Errorf("failed marshal SetNodeStateCommand: %+v", err)
+		}
+		return t.HashicorpRaft.Apply(b, time.Second).Error()
+	}
+	return fmt.Errorf("raft server not ready yet")
+}
+
+// ApplyNodeState applies a committed SetNodeStateCommand.
+func (t *Topology) ApplyNodeState(c *SetNodeStateCommand) {
+	t.nodeStatesLock.Lock()
+	if c.State == NodeStateActive {
+		delete(t.nodeStates, c.Node)
+	} else {
+		t.nodeStates[c.Node] = c.State
+	}
+	t.nodeStatesLock.Unlock()
+	glog.V(0).Infof("volume server %s is %s", c.Node, c.State)
+
+	if dn := t.FindDataNode(c.Node); dn != nil {
+		t.updateDataNodeState(dn, c.State)
+	}
+}
+
+// RestoreNodeStates replaces the states of all data nodes, from a raft snapshot.
+func (t *Topology) RestoreNodeStates(states map[string]NodeState) {
+	t.nodeStatesLock.Lock()
+	t.nodeStates = make(map[string]NodeState)
+	for node, state := range states {
+		if state != NodeStateActive {
+			t.nodeStates[node] = state
+		}
+	}
+	t.nodeStatesLock.Unlock()
+
+	for _, dn := range t.listDataNodes() {
+		t.updateDataNodeState(dn, t.GetNodeState(dn.Url()))
+	}
+}
+
+func (t *Topology) GetNodeState(node string) NodeState {
+	t.nodeStatesLock.RLock()
+	defer t.nodeStatesLock.RUnlock()
+	if state, found := t.nodeStates[node]; found {
+		return state
+	}
+	return NodeStateActive
+}
+
+// NodeStates returns the data nodes not active, by url.
+func (t *Topology) NodeStates() map[string]NodeState {
+	t.nodeStatesLock.RLock()
+	defer t.nodeStatesLock.RUnlock()
+	states := make(map[string]NodeState, len(t.nodeStates))
+	for node, state := range t.nodeStates {
+		states[node] = state
+	}
+	return states
+}
+
+// updateDataNodeState sets the state of the data node, and adds or removes its volumes from the writable volumes.
+func (t *Topology) updateDataNodeState(dn *DataNode, state NodeState) {
+	if dn.State() == state {
+		return
+	}
+	dn.setState(state)
+	for _, v := range dn.GetVolumes() {
+		vl := t.GetVolumeLayout(v.Collection, v.ReplicaPlacement, v.Ttl, types.ToDiskType(v.DiskType))
+		vl.EnsureCorrectWritables(&v)
+	}
+}
+
+// FindDataNode returns the data node by its url, or nil.
+func (t *Topology) FindDataNode(node string) *DataNode {
+	for _, dn := range t.listDataNodes() {
+		if dn.Url() == node {
+			return dn
+		}
+	}
+	return nil
+}
+
+func (t *Topology) listDataNodes() (dataNodes []*DataNode) {
+	for _, dc := range t.Children() {
+		for _, rack := range dc.Children() {
+			for _, dn := range rack.Children() {
+				dataNodes = append(dataNodes, dn.(*DataNode))
+			}
+		}
+	}
+	return
+}
+
+// initDataNodeState sets the state of a new data node, if it is linked to a topology.
+func initDataNodeState(dn *DataNode) {
+	var p Node = dn
+	for p.Parent() != nil {
+		p = p.Parent()
+	}
+	if t, ok := p.GetValue().(*Topology); ok {
+		dn.setState(t.GetNodeState(dn.Url()))
+	}
+}
+
+func (dn *DataNode) State() NodeState {
+	if state, ok := dn.state.Load().(NodeState); ok {
+		return state
+	}
+	return NodeStateActive
+}
+
+func (dn *DataNode) setState(state NodeState) {
+	dn.state.Store(state)
+}
+
+// IsActive is false for draining and maintenance data nodes.
+func (dn *DataNode) IsActive() bool {
+	return dn.State() == NodeStateActive
+}
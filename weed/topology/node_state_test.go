@@ -0,0 +1,62 @@
+package topology
+
+import (
+	"testing"
+
+	"github.com/gateway-dao/seaweedfs/weed/storage/types"
+)
+
+func TestNodeState(t *testing.T) {
+	topo := loadVolumeList(t, "../shell/volume.list.txt")
+	dn := topo.FindDataNode(smallNode)
+	if dn == nil {
+		t.Fatalf("data node %s not found", smallNode)
+	}
+	writableVolumes := func() (count int) {
+		for _, v := range dn.GetVolumes() {
+			vl := topo.GetVolumeLayout(v.Collection, v.ReplicaPlacement, v.Ttl, types.ToDiskType(v.DiskType))
+			for _, vid := range vl.writables {
+				if vid == v.Id {
+					count++
+				}
+			}
+		}
+		return
+	}
+	writable := writableVolumes()
+	if writable == 0 {
+		t.Fatalf("no writable volumes on %s", smallNode)
+	}
+
+	topo.ApplyNodeState(NewSetNodeStateCommand(smallNode, NodeStateDraining))
+	if dn.IsActive() || topo.GetNodeState(smallNode) != NodeStateDraining {
+		t.Fatalf("data node is %s", dn.State())
+	}
+	if count := writableVolumes(); count != 0 {
+		t.Errorf("draining data node has %d writable volumes", count)
+	}
+	placed := simulateVolumeGrowth(t, topo, NewDefaultVolumeGrowth(), newSimulationOption(t, "000"), 100)
+	if placed[smallNode] != 0 {
+		t.Errorf("draining data node gets new volumes: %v", placed)
+	}
+
+	// states from a raft snapshot
+	topo.RestoreNodeStates(map[string]NodeState{})
+	if !dn.IsActive() || len(topo.NodeStates()) != 0 {
+		t.Fatalf("data node is still %s", dn.State())
+	}
+	if count := writableVolumes(); count != writable {
+		t.Errorf("active data node has %d writable volumes, expecting %d", count, writable)
+	}
+
+	// data nodes joining later get their states
+	topo.ApplyNodeState(NewSetNodeStateCommand("10.0.0.1:8080", NodeStateMaintenance))
+	rack := topo.GetOrCreateDataCenter("dc9").GetOrCreateRack("rack9")
+	if joined := rack.GetOrCreateDataNode("10.0.0.1", 8080, 18080, "10.0.0.1:8080", nil); joined.State() != NodeStateMaintenance {
+		t.Errorf("joined data node is %s", joined.State())
+	}
+
+	if _, err := ParseNodeState("offline"); err == nil {
+		t.Errorf("unknown state is accepted")
+	}
+}
@@ -49,6 +49,7 @@ func (r *Rack) GetOrCreateDataNode(ip string, port int, grpcPort int, publicUrl
 	dn.PublicUrl = publicUrl
 	dn.LastSeen = time.Now().Unix()
 	r.doLinkChildNode(dn)
+	initDataNodeState(dn)
 	for diskType, maxVolumeCount := range maxVolumeCounts {
 		disk := NewDisk(diskType)
 		disk.diskUsages.getOrCreateDisk(types.ToDiskType(diskType)).maxVolumeCount = int64(maxVolumeCount)
@@ -122,6 +122,84 @@ func ReplicatedWrite(masterFn operation.GetMasterFn, grpcDialOption grpc.DialOpt
 	return
 }
 
+// ReplicatedAppend appends n.Data to the file id at fileOffset locally and on the other replicas.
+// The append fails with storage.ErrorAppendConflict if the file size is not fileOffset.
+// Each replica decides on its own whether to extend the needle in place or to chain it.
+func ReplicatedAppend(masterFn operation.GetMasterFn, grpcDialOption grpc.DialOption, s *storage.Store, volumeId needle.VolumeId, n *needle.Needle, fileOffset uint64, r *http.Request, contentMd5 string) (size types.Size, err error) {
+
+	//check JWT
+	jwt := security.GetJwt(r)
+
+	var remoteLocations []operation.Location
+	if r.FormValue("type") != "replicate" {
+		remoteLocations, err = GetWritableRemoteReplications(s, grpcDialOption, volumeId, masterFn)
+		if err != nil {
+			glog.V(0).Infoln(err)
+			return
+		}
+	}
+
+	fsync := r.FormValue("fsync") == "true"
+
+	if s.GetVolume(volumeId) != nil {
+		start := time.Now()
+		size, err = s.AppendVolumeNeedle(volumeId, n, fileOffset, fsync)
+		stats.VolumeServerRequestHistogram.WithLabelValues(stats.WriteToLocalDisk).Observe(time.Since(start).Seconds())
+		if err != nil {
+			stats.VolumeServerHandlerCounter.WithLabelValues(stats.ErrorWriteToLocalDisk).Inc()
+			err = fmt.Errorf("failed to append to local disk: %w", err)
+			glog.V(0).Infoln(err)
+			return
+		}
+	}
+
+	if len(remoteLocations) > 0 { //send to other replica locations
+		start := time.Now()
+		err = DistributedOperation(remoteLocations, func(location operation.Location) error {
+			u := url.URL{
+				Scheme: "http",
+				Host:   location.Url,
+				Path:   r.URL.Path,
+			}
+			q := url.Values{
+				"type":   {"replicate"},
+				"op":     {"append"},
+				"offset": {strconv.FormatUint(fileOffset, 10)},
+				"ts":     {strconv.FormatUint(n.LastModified, 10)},
+			}
+			if fsync {
+				q.Set("fsync", "true")
+			}
+			u.RawQuery = q.Encode()
+
+			bytesBuffer := buffer_pool.SyncPoolGetBuffer()
+			defer buffer_pool.SyncPoolPutBuffer(bytesBuffer)
+
+			uploadOption := &operation.UploadOption{
+				UploadUrl:   u.String(),
+				Cipher:      false,
+				Jwt:         jwt,
+				Md5:         contentMd5,
+				BytesBuffer: bytesBuffer,
+			}
+
+			_, err := operation.UploadData(n.Data, uploadOption)
+			if err != nil {
+				glog.Errorf("replication-AppendData, err:%v, url:%s", err, u.String())
+			}
+			return err
+		})
+		stats.VolumeServerRequestHistogram.WithLabelValues(stats.WriteToReplicas).Observe(time.Since(start).Seconds())
+		if err != nil {
+			stats.VolumeServerHandlerCounter.WithLabelValues(stats.ErrorWriteToReplicas).Inc()
+			err = fmt.Errorf("failed to append to replicas for volume %d: %v", volumeId, err)
+			glog.V(0).Infoln(err)
+			return 0, err
+		}
+	}
+	return
+}
+
 func ReplicatedDelete(masterFn operation.GetMasterFn, grpcDialOption grpc.DialOption, store *storage.Store, volumeId needle.VolumeId, n *needle.Needle, r *http.Request) (size types.Size, err error) {
 
 	//check JWT
@@ -210,6 +288,25 @@ func GetWritableRemoteReplications(s *storage.Store, grpcDialOption grpc.DialOpt
 		return
 	}
 
+	if v != nil && v.ReplicaPlacement.DiffDataCenterCount > 0 && s.AsyncReplication.IsAsync(v.Collection) {
+		// the replicas in other data centers follow the primary data center asynchronously
+		if selfDataCenter, primaryDataCenter, found := AsyncReplicationDataCenters(s, lookupResult.Locations); found && selfDataCenter == primaryDataCenter {
+			var dataCenterLocations []operation.Location
+			for _, location := range remoteLocations {
+				if location.DataCenter == selfDataCenter {
+					dataCenterLocations = append(dataCenterLocations, location)
+				}
+			}
+			remoteLocations = dataCenterLocations
+			copyCount := v.ReplicaPlacement.DiffRackCount + v.ReplicaPlacement.SameRackCount + 1
+			if len(remoteLocations)+1 < copyCount {
+				err = fmt.Errorf("replicating operations [%d] is less than volume %d replication copy count [%d] in data center %s",
+					len(remoteLocations)+1, volumeId, copyCount, selfDataCenter)
+			}
+			return
+		}
+	}
+
 	if v != nil {
 		// has one local and has remote replications
 		copyCount := v.ReplicaPlacement.GetCopyCount()
@@ -221,3 +318,17 @@ func GetWritableRemoteReplications(s *storage.Store, grpcDialOption grpc.DialOpt
 
 	return
 }
+
+// AsyncReplicationDataCenters finds the data center of this volume server, and the primary data center
+// accepting the writes, among the replica locations of an asynchronously replicated volume.
+func AsyncReplicationDataCenters(s *storage.Store, locations []operation.Location) (selfDataCenter, primaryDataCenter string, found bool) {
+	selfUrl := util.JoinHostPort(s.Ip, s.Port)
+	var dataCenters []string
+	for _, location := range locations {
+		dataCenters = append(dataCenters, location.DataCenter)
+		if location.Url == selfUrl {
+			selfDataCenter, found = location.DataCenter, true
+		}
+	}
+	return selfDataCenter, s.AsyncReplication.PrimaryDataCenter(dataCenters), found
+}
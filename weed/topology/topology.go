@@ -18,9 +18,11 @@ import (
 
 	"github.com/gateway-dao/seaweedfs/weed/glog"
 	"github.com/gateway-dao/seaweedfs/weed/pb/master_pb"
+	"github.com/gateway-dao/seaweedfs/weed/security"
 	"github.com/gateway-dao/seaweedfs/weed/sequence"
 	"github.com/gateway-dao/seaweedfs/weed/stats"
 	"github.com/gateway-dao/seaweedfs/weed/storage"
+	"github.com/gateway-dao/seaweedfs/weed/storage/erasure_coding"
 	"github.com/gateway-dao/seaweedfs/weed/storage/needle"
 	"github.com/gateway-dao/seaweedfs/weed/storage/super_block"
 	"github.com/gateway-dao/seaweedfs/weed/util"
@@ -52,6 +54,18 @@ type Topology struct {
 	HashicorpRaft        *hashicorpRaft.Raft
 	UuidAccessLock       sync.RWMutex
 	UuidMap              map[string][]string
+
+	AsyncReplication *storage.AsyncReplication
+	KeyWrapper       security.KeyWrapper // wraps the data keys of new volumes, if set
+
+	maxFileId     uint64 // the file ids below are reserved through the raft log
+	maxFileIdLock sync.Mutex
+
+	nodeStates     map[string]NodeState // the data nodes not active, by url, kept in the raft log
+	nodeStatesLock sync.RWMutex
+
+	ecSchemes     map[string]erasure_coding.EcScheme // the collections not using the default ec scheme, kept in the raft log
+	ecSchemesLock sync.RWMutex
 }
 
 func NewTopology(id string, seq sequence.Sequencer, volumeSizeLimit uint64, pulse int, replicationAsMin bool) *Topology {
@@ -63,6 +77,8 @@ func NewTopology(id string, seq sequence.Sequencer, volumeSizeLimit uint64, puls
 	t.children = make(map[NodeId]Node)
 	t.collectionMap = util.NewConcurrentReadMap()
 	t.ecShardMap = make(map[needle.VolumeId]*EcShardLocations)
+	t.nodeStates = make(map[string]NodeState)
+	t.ecSchemes = make(map[string]erasure_coding.EcScheme)
 	t.pulse = int64(pulse)
 	t.volumeSizeLimit = volumeSizeLimit
 	t.replicationAsMin = replicationAsMin
@@ -202,6 +218,71 @@ func (t *Topology) NextVolumeId() (needle.VolumeId, error) {
 	return next, nil
 }
 
+// ReserveFileIds reserves count file ids through the raft log, none of them below minFileId,
+// and returns the start of the reserved range.
+func (t *Topology) ReserveFileIds(minFileId, count uint64) (uint64, error) {
+	command := NewReserveFileIdsCommand(minFileId, count)
+
+	t.RaftServerAccessLock.RLock()
+	defer t.RaftServerAccessLock.RUnlock()
+
+	if t.RaftServer != nil {
+		ret, err := t.RaftServer.Do(command)
+		if err != nil {
+			return 0, err
+		}
+		if start, ok := ret.(uint64); ok {
+			return start, nil
+		}
+		return 0, fmt.Errorf("unexpected ReserveFileIdsCommand response %v", ret)
+	} else if t.HashicorpRaft != nil {
+		b, err := json.Marshal(command)
+		if err != nil {
+			return 0, fmt.Errorf("failed marshal ReserveFileIdsCommand: %+v", err)
+		}
+		future := t.HashicorpRaft.Apply(b, time.Second)
+		if future.Error() != nil {
+			return 0, future.Error()
+		}
+		switch ret := future.Response().(type) {
+		case uint64:
+			return ret, nil
+		case error:
+			return 0, ret
+		default:
+			return 0, fmt.Errorf("unexpected ReserveFileIdsCommand response %v", ret)
+		}
+	}
+	return 0, fmt.Errorf("raft server not ready yet")
+}
+
+// ApplyReserveFileIds applies a committed ReserveFileIdsCommand, and returns the start of the reserved range.
+func (t *Topology) ApplyReserveFileIds(c *ReserveFileIdsCommand) uint64 {
+	t.maxFileIdLock.Lock()
+	defer t.maxFileIdLock.Unlock()
+	start := t.maxFileId
+	if start < c.MinFileId {
+		start = c.MinFileId
+	}
+	t.maxFileId = start + c.Count
+	glog.V(1).Infof("max file id %d ==> %d", start, t.maxFileId)
+	return start
+}
+
+func (t *Topology) GetMaxFileId() uint64 {
+	t.maxFileIdLock.Lock()
+	defer t.maxFileIdLock.Unlock()
+	return t.maxFileId
+}
+
+func (t *Topology) UpAdjustMaxFileId(maxFileId uint64) {
+	t.maxFileIdLock.Lock()
+	defer t.maxFileIdLock.Unlock()
+	if t.maxFileId < maxFileId {
+		t.maxFileId = maxFileId
+	}
+}
+
 func (t *Topology) PickForWrite(requestedCount uint64, option *VolumeGrowOption, volumeLayout *VolumeLayout) (fileId string, count uint64, volumeLocationList *VolumeLocationList, shouldGrow bool, err error) {
 	var vid needle.VolumeId
 	vid, count, volumeLocationList, shouldGrow, err = volumeLayout.PickForWrite(requestedCount, option)
@@ -211,7 +292,11 @@ func (t *Topology) PickForWrite(requestedCount uint64, option *VolumeGrowOption,
 	if volumeLocationList.Length() == 0 {
 		return "", 0, nil, shouldGrow, fmt.Errorf("no writable volumes available for collection:%s replication:%s ttl:%s", option.Collection, option.ReplicaPlacement.String(), option.Ttl.String())
 	}
-	nextFileId := t.Sequence.NextFileId(requestedCount)
+	volumeLocationList = t.preferPrimaryDataCenter(option, volumeLocationList)
+	nextFileId, err := t.Sequence.NextFileId(requestedCount)
+	if err != nil {
+		return "", 0, nil, shouldGrow, fmt.Errorf("failed to allocate file id: %v", err)
+	}
 	fileId = needle.NewFileId(vid, nextFileId, rand.Uint32()).String()
 	return fileId, count, volumeLocationList, shouldGrow, nil
 }
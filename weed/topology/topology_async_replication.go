@@ -0,0 +1,64 @@
+package topology
+
+import (
+	"sort"
+	"time"
+
+	"github.com/gateway-dao/seaweedfs/weed/storage/needle"
+)
+
+// IsStaleReplica tells whether the replica of the volume on the data node lags behind the primary data center
+// for longer than allowed, so that reads should not be routed to it.
+func (t *Topology) IsStaleReplica(dn *DataNode, vid needle.VolumeId) bool {
+	if t.AsyncReplication == nil {
+		return false
+	}
+	v, err := dn.GetVolumesById(vid)
+	if err != nil {
+		return false
+	}
+	return time.Duration(v.ReplicationLagSeconds)*time.Second > t.AsyncReplication.MaxLag()
+}
+
+// StaleReplicas lists the volumes of the data node lagging too far behind the primary data center.
+func (t *Topology) StaleReplicas(dn *DataNode) map[needle.VolumeId]bool {
+	stale := make(map[needle.VolumeId]bool)
+	if t.AsyncReplication == nil {
+		return stale
+	}
+	maxLag := t.AsyncReplication.MaxLag()
+	for _, v := range dn.GetVolumes() {
+		if time.Duration(v.ReplicationLagSeconds)*time.Second > maxLag {
+			stale[v.Id] = true
+		}
+	}
+	return stale
+}
+
+// SortByReplicationLag puts the stale replicas of the volume last, keeping the order of the others.
+func (t *Topology) SortByReplicationLag(vid needle.VolumeId, dataNodes []*DataNode) []*DataNode {
+	sorted := make([]*DataNode, len(dataNodes))
+	copy(sorted, dataNodes)
+	sort.SliceStable(sorted, func(i, j int) bool {
+		return !t.IsStaleReplica(sorted[i], vid) && t.IsStaleReplica(sorted[j], vid)
+	})
+	return sorted
+}
+
+// preferPrimaryDataCenter puts the replicas in the primary data center first,
+// so that writes to asynchronously replicated volumes are accepted there.
+func (t *Topology) preferPrimaryDataCenter(option *VolumeGrowOption, locationList *VolumeLocationList) *VolumeLocationList {
+	if option.ReplicaPlacement == nil || option.ReplicaPlacement.DiffDataCenterCount == 0 || !t.AsyncReplication.IsAsync(option.Collection) {
+		return locationList
+	}
+	var dataCenters []string
+	for _, dn := range locationList.list {
+		dataCenters = append(dataCenters, dn.GetDataCenterId())
+	}
+	primary := t.AsyncReplication.PrimaryDataCenter(dataCenters)
+	sorted := locationList.Copy()
+	sort.SliceStable(sorted.list, func(i, j int) bool {
+		return sorted.list[i].GetDataCenterId() == primary && sorted.list[j].GetDataCenterId() != primary
+	})
+	return sorted
+}
@@ -83,6 +83,9 @@ func (t *Topology) ToVolumeLocations() (volumeLocations []*master_pb.VolumeLocat
 					GrpcPort:   uint32(dn.GrpcPort),
 				}
 				for _, v := range dn.GetVolumes() {
+					if t.IsStaleReplica(dn, v.Id) {
+						continue
+					}
 					volumeLocation.NewVids = append(volumeLocation.NewVids, uint32(v.Id))
 				}
 				for _, s := range dn.GetEcShards() {
@@ -4,6 +4,7 @@ import (
 	"encoding/json"
 	"fmt"
 	"github.com/gateway-dao/seaweedfs/weed/pb/master_pb"
+	"github.com/gateway-dao/seaweedfs/weed/pb/volume_server_pb"
 	"math/rand"
 	"sync"
 	"time"
@@ -15,6 +16,7 @@ import (
 	"github.com/gateway-dao/seaweedfs/weed/storage/needle"
 	"github.com/gateway-dao/seaweedfs/weed/storage/super_block"
 	"github.com/gateway-dao/seaweedfs/weed/storage/types"
+	"github.com/gateway-dao/seaweedfs/weed/util"
 )
 
 /*
@@ -62,6 +64,7 @@ type VolumeGrowOption struct {
 
 type VolumeGrowth struct {
 	accessLock sync.Mutex
+	Placement  *VolumePlacement
 }
 
 func (o *VolumeGrowOption) String() string {
@@ -70,7 +73,9 @@ func (o *VolumeGrowOption) String() string {
 }
 
 func NewDefaultVolumeGrowth() *VolumeGrowth {
-	return &VolumeGrowth{}
+	return &VolumeGrowth{
+		Placement: NewVolumePlacement(),
+	}
 }
 
 // one replication type may need rp.GetCopyCount() actual volumes
@@ -143,9 +148,13 @@ func (vg *VolumeGrowth) findAndGrow(grpcDialOption grpc.DialOption, topo *Topolo
 // 2.2 collect all data centers that have DiffRackCount+rp.SameRackCount+1
 // 2. find rest data nodes
 func (vg *VolumeGrowth) findEmptySlotsForOneVolume(topo *Topology, option *VolumeGrowOption) (servers []*DataNode, err error) {
+	weightFn := func(node Node) float64 {
+		return vg.Placement.NodeWeight(topo, node, option)
+	}
+
 	//find main datacenter and other data centers
 	rp := option.ReplicaPlacement
-	mainDataCenter, otherDataCenters, dc_err := topo.PickNodesByWeight(rp.DiffDataCenterCount+1, option, func(node Node) error {
+	mainDataCenter, otherDataCenters, dc_err := topo.PickNodesByWeight(rp.DiffDataCenterCount+1, weightFn, func(node Node) error {
 		if option.DataCenter != "" && node.IsDataCenter() && node.Id() != NodeId(option.DataCenter) {
 			return fmt.Errorf("Not matching preferred data center:%s", option.DataCenter)
 		}
@@ -159,7 +168,7 @@ func (vg *VolumeGrowth) findEmptySlotsForOneVolume(topo *Topology, option *Volum
 		for _, rack := range node.Children() {
 			possibleDataNodesCount := 0
 			for _, n := range rack.Children() {
-				if n.AvailableSpaceFor(option) >= 1 {
+				if weightFn(n) > 0 {
 					possibleDataNodesCount++
 				}
 			}
@@ -177,7 +186,7 @@ func (vg *VolumeGrowth) findEmptySlotsForOneVolume(topo *Topology, option *Volum
 	}
 
 	//find main rack and other racks
-	mainRack, otherRacks, rackErr := mainDataCenter.(*DataCenter).PickNodesByWeight(rp.DiffRackCount+1, option, func(node Node) error {
+	mainRack, otherRacks, rackErr := mainDataCenter.(*DataCenter).PickNodesByWeight(rp.DiffRackCount+1, weightFn, func(node Node) error {
 		if option.Rack != "" && node.IsRack() && node.Id() != NodeId(option.Rack) {
 			return fmt.Errorf("Not matching preferred rack:%s", option.Rack)
 		}
@@ -190,7 +199,7 @@ func (vg *VolumeGrowth) findEmptySlotsForOneVolume(topo *Topology, option *Volum
 		}
 		possibleDataNodesCount := 0
 		for _, n := range node.Children() {
-			if n.AvailableSpaceFor(option) >= 1 {
+			if weightFn(n) > 0 {
 				possibleDataNodesCount++
 			}
 		}
@@ -204,7 +213,7 @@ func (vg *VolumeGrowth) findEmptySlotsForOneVolume(topo *Topology, option *Volum
 	}
 
 	//find main server and other servers
-	mainServer, otherServers, serverErr := mainRack.(*Rack).PickNodesByWeight(rp.SameRackCount+1, option, func(node Node) error {
+	mainServer, otherServers, serverErr := mainRack.(*Rack).PickNodesByWeight(rp.SameRackCount+1, weightFn, func(node Node) error {
 		if option.DataNode != "" && node.IsDataNode() && node.Id() != NodeId(option.DataNode) {
 			return fmt.Errorf("Not matching preferred data node:%s", option.DataNode)
 		}
@@ -222,16 +231,16 @@ func (vg *VolumeGrowth) findEmptySlotsForOneVolume(topo *Topology, option *Volum
 		servers = append(servers, server.(*DataNode))
 	}
 	for _, rack := range otherRacks {
-		r := rand.Int63n(rack.AvailableSpaceFor(option))
-		if server, e := rack.ReserveOneVolume(r, option); e == nil {
+		r := rand.Float64() * weightFn(rack)
+		if server, e := rack.ReserveOneVolume(r, weightFn); e == nil {
 			servers = append(servers, server)
 		} else {
 			return servers, e
 		}
 	}
 	for _, datacenter := range otherDataCenters {
-		r := rand.Int63n(datacenter.AvailableSpaceFor(option))
-		if server, e := datacenter.ReserveOneVolume(r, option); e == nil {
+		r := rand.Float64() * weightFn(datacenter)
+		if server, e := datacenter.ReserveOneVolume(r, weightFn); e == nil {
 			servers = append(servers, server)
 		} else {
 			return servers, e
@@ -242,8 +251,17 @@ func (vg *VolumeGrowth) findEmptySlotsForOneVolume(topo *Topology, option *Volum
 
 func (vg *VolumeGrowth) grow(grpcDialOption grpc.DialOption, topo *Topology, vid needle.VolumeId, option *VolumeGrowOption, servers ...*DataNode) (growErr error) {
 	var createdVolumes []storage.VolumeInfo
+	var encryption *volume_server_pb.VolumeEncryption
+	if topo.KeyWrapper != nil {
+		// the data key only leaves the master wrapped
+		keyId, wrapped, err := topo.KeyWrapper.WrapKey(util.GenCipherKey())
+		if err != nil {
+			return fmt.Errorf("wrap data key of volume %d: %v", vid, err)
+		}
+		encryption = &volume_server_pb.VolumeEncryption{KeyId: keyId, WrappedKey: wrapped}
+	}
 	for _, server := range servers {
-		if err := AllocateVolume(server, grpcDialOption, vid, option); err == nil {
+		if err := AllocateVolume(server, grpcDialOption, vid, option, encryption); err == nil {
 			createdVolumes = append(createdVolumes, storage.VolumeInfo{
 				Id:               vid,
 				Size:             0,
@@ -236,6 +236,9 @@ func (vl *VolumeLayout) ensureCorrectWritables(vid needle.VolumeId) {
 func (vl *VolumeLayout) isAllWritable(vid needle.VolumeId) bool {
 	if location, ok := vl.vid2location[vid]; ok {
 		for _, dn := range location.list {
+			if !dn.IsActive() {
+				return false
+			}
 			if v, getError := dn.GetVolumesById(vid); getError == nil {
 				if v.ReadOnly {
 					return false
@@ -436,7 +439,7 @@ func (vl *VolumeLayout) SetVolumeWritable(dn *DataNode, vid needle.VolumeId) boo
 		vl.readonlyVolumes.Remove(vid, dn)
 	}
 
-	if vl.enoughCopies(vid) {
+	if vl.enoughCopies(vid) && vl.isAllActive(vid) {
 		return vl.setVolumeWritable(vid)
 	}
 	return false
@@ -473,12 +476,22 @@ func (vl *VolumeLayout) SetVolumeAvailable(dn *DataNode, vid needle.VolumeId, is
 		return false
 	}
 
-	if vl.enoughCopies(vid) {
+	if vl.enoughCopies(vid) && vl.isAllActive(vid) {
 		return vl.setVolumeWritable(vid)
 	}
 	return false
 }
 
+// isAllActive is false if a replica is on a draining or maintenance data node.
+func (vl *VolumeLayout) isAllActive(vid needle.VolumeId) bool {
+	for _, dn := range vl.vid2location[vid].list {
+		if !dn.IsActive() {
+			return false
+		}
+	}
+	return true
+}
+
 func (vl *VolumeLayout) enoughCopies(vid needle.VolumeId) bool {
 	locations := vl.vid2location[vid].Length()
 	desired := vl.rp.GetCopyCount()
@@ -0,0 +1,188 @@
+package topology
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+	"sync"
+
+	"github.com/gateway-dao/seaweedfs/weed/util"
+)
+
+const (
+	VolumePlacementPrefix = "master.volume_growth.placement"
+
+	DefaultWriteLoadReferenceMBps = 16
+)
+
+// PlacementStrategy weighs the data nodes with a free slot, when placing the replicas of a new volume.
+// The weights of all strategies are multiplied. A data node weighing 0 is not picked.
+type PlacementStrategy interface {
+	Weight(topo *Topology, dn *DataNode, option *VolumeGrowOption) float64
+}
+
+// FreeSlotsPlacement picks the data nodes by their free volume slots.
+type FreeSlotsPlacement struct{}
+
+func (FreeSlotsPlacement) Weight(topo *Topology, dn *DataNode, option *VolumeGrowOption) float64 {
+	return float64(dn.AvailableSpaceFor(option))
+}
+
+// FreeCapacityPlacement picks the data nodes by how many volumes fit in their free disk space.
+// It falls back to the free volume slots for volume servers not reporting the free disk space.
+type FreeCapacityPlacement struct{}
+
+func (FreeCapacityPlacement) Weight(topo *Topology, dn *DataNode, option *VolumeGrowOption) float64 {
+	freeSlots := float64(dn.AvailableSpaceFor(option))
+	freeBytes, found := dn.FreeDiskBytes(option.DiskType)
+	if !found || topo.volumeSizeLimit == 0 {
+		return freeSlots
+	}
+	volumes := float64(freeBytes) / float64(topo.volumeSizeLimit)
+	if volumes > freeSlots {
+		return freeSlots
+	}
+	return volumes
+}
+
+// CollectionSpreadPlacement avoids the data nodes with more writable volumes of the same collection,
+// to spread the writes of a collection across the volume servers.
+type CollectionSpreadPlacement struct{}
+
+func (CollectionSpreadPlacement) Weight(topo *Topology, dn *DataNode, option *VolumeGrowOption) float64 {
+	writableCount := 0
+	for _, v := range dn.GetVolumes() {
+		if v.Collection == option.Collection && !v.ReadOnly && v.Size < topo.volumeSizeLimit {
+			writableCount++
+		}
+	}
+	return 1 / float64(1+writableCount)
+}
+
+// WriteLoadPlacement avoids the data nodes with more writes, as reported in the heartbeats.
+// The weight halves at the reference write throughput.
+type WriteLoadPlacement struct {
+	ReferenceBytesPerSecond uint64
+}
+
+func (p WriteLoadPlacement) Weight(topo *Topology, dn *DataNode, option *VolumeGrowOption) float64 {
+	if p.ReferenceBytesPerSecond == 0 {
+		return 1
+	}
+	return 1 / (1 + float64(dn.WriteBytesPerSecond())/float64(p.ReferenceBytesPerSecond))
+}
+
+// VolumePlacement weighs the data nodes for new volumes, by the placement strategies and the weights set by operators.
+type VolumePlacement struct {
+	sync.RWMutex
+	strategies    []PlacementStrategy
+	nodeWeights   map[string]float64 // by data node url
+	excludedNodes map[string]bool    // by data node url
+}
+
+func NewVolumePlacement(strategies ...PlacementStrategy) *VolumePlacement {
+	if len(strategies) == 0 {
+		strategies = []PlacementStrategy{FreeSlotsPlacement{}}
+	}
+	return &VolumePlacement{
+		strategies:    strategies,
+		nodeWeights:   make(map[string]float64),
+		excludedNodes: make(map[string]bool),
+	}
+}
+
+// LoadVolumePlacement reads the placement strategies, node weights and exclusions from the master configuration.
+func LoadVolumePlacement(config *util.ViperProxy) (*VolumePlacement, error) {
+	var strategies []PlacementStrategy
+	for _, name := range config.GetStringSlice(VolumePlacementPrefix + ".strategies") {
+		switch name {
+		case "free_slots":
+			strategies = append(strategies, FreeSlotsPlacement{})
+		case "free_capacity":
+			strategies = append(strategies, FreeCapacityPlacement{})
+		case "collection_spread":
+			strategies = append(strategies, CollectionSpreadPlacement{})
+		case "write_load":
+			referenceMBps := config.GetInt(VolumePlacementPrefix + ".write_load_reference_mbps")
+			if referenceMBps <= 0 {
+				referenceMBps = DefaultWriteLoadReferenceMBps
+			}
+			strategies = append(strategies, WriteLoadPlacement{ReferenceBytesPerSecond: uint64(referenceMBps) * 1024 * 1024})
+		default:
+			return nil, fmt.Errorf("unknown volume placement strategy %q", name)
+		}
+	}
+	p := NewVolumePlacement(strategies...)
+	for _, nodeWeight := range config.GetStringSlice(VolumePlacementPrefix + ".node_weights") {
+		node, weightString, found := strings.Cut(nodeWeight, "=")
+		weight, err := strconv.ParseFloat(weightString, 64)
+		if !found || err != nil || weight < 0 {
+			return nil, fmt.Errorf("invalid volume placement node weight %q, expecting <ip:port>=<weight>", nodeWeight)
+		}
+		p.SetNodeWeight(node, weight)
+	}
+	for _, node := range config.GetStringSlice(VolumePlacementPrefix + ".excluded_nodes") {
+		p.ExcludeNode(node, true)
+	}
+	return p, nil
+}
+
+// SetNodeWeight scales the placement weight of the data node. 1 resets it.
+func (p *VolumePlacement) SetNodeWeight(node string, weight float64) {
+	p.Lock()
+	defer p.Unlock()
+	if weight == 1 {
+		delete(p.nodeWeights, node)
+		return
+	}
+	p.nodeWeights[node] = weight
+}
+
+// ExcludeNode stops or resumes placing new volumes on the data node, e.g. during maintenance.
+func (p *VolumePlacement) ExcludeNode(node string, excluded bool) {
+	p.Lock()
+	defer p.Unlock()
+	if excluded {
+		p.excludedNodes[node] = true
+	} else {
+		delete(p.excludedNodes, node)
+	}
+}
+
+func (p *VolumePlacement) IsExcluded(node string) bool {
+	p.RLock()
+	defer p.RUnlock()
+	return p.excludedNodes[node]
+}
+
+// Weight is how likely a replica of a new volume is placed on the data node, 0 if not possible.
+func (p *VolumePlacement) Weight(topo *Topology, dn *DataNode, option *VolumeGrowOption) float64 {
+	if dn.IsTerminating || !dn.IsActive() || dn.AvailableSpaceFor(option) <= 0 {
+		return 0
+	}
+	p.RLock()
+	defer p.RUnlock()
+	if p.excludedNodes[dn.Url()] {
+		return 0
+	}
+	weight := 1.0
+	if nodeWeight, found := p.nodeWeights[dn.Url()]; found {
+		weight = nodeWeight
+	}
+	for _, strategy := range p.strategies {
+		weight *= strategy.Weight(topo, dn, option)
+	}
+	return weight
+}
+
+// NodeWeight sums the placement weights of the data nodes under the node.
+func (p *VolumePlacement) NodeWeight(topo *Topology, node Node, option *VolumeGrowOption) float64 {
+	if node.IsDataNode() {
+		return p.Weight(topo, node.(*DataNode), option)
+	}
+	var weight float64
+	for _, child := range node.Children() {
+		weight += p.NodeWeight(topo, child, option)
+	}
+	return weight
+}
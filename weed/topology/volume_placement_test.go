@@ -0,0 +1,209 @@
+package topology
+
+import (
+	"bufio"
+	"net"
+	"os"
+	"strconv"
+	"strings"
+	"testing"
+
+	"google.golang.org/protobuf/encoding/prototext"
+
+	"github.com/gateway-dao/seaweedfs/weed/pb/master_pb"
+	"github.com/gateway-dao/seaweedfs/weed/sequence"
+	"github.com/gateway-dao/seaweedfs/weed/storage"
+	"github.com/gateway-dao/seaweedfs/weed/storage/needle"
+	"github.com/gateway-dao/seaweedfs/weed/storage/super_block"
+	"github.com/gateway-dao/seaweedfs/weed/storage/types"
+)
+
+// loadVolumeList builds a topology from the output of the volume.list shell command.
+func loadVolumeList(t *testing.T, fileName string) *Topology {
+	f, err := os.Open(fileName)
+	if err != nil {
+		t.Fatalf("open %s: %v", fileName, err)
+	}
+	defer f.Close()
+
+	var topo *Topology
+	var dc *DataCenter
+	var rack *Rack
+	var dn *DataNode
+	var diskType types.DiskType
+	scanner := bufio.NewScanner(f)
+	scanner.Buffer(make([]byte, 1024*1024), 1024*1024)
+	for scanner.Scan() {
+		line := strings.TrimSpace(scanner.Text())
+		parts := strings.Split(line, " ")
+		if len(parts) < 2 || strings.Contains(line, " total size:") {
+			continue
+		}
+		switch parts[0] {
+		case "Topology":
+			limitMB, _ := strconv.ParseUint(strings.TrimPrefix(parts[1], "volumeSizeLimit:"), 10, 64)
+			topo = NewTopology("weedfs", sequence.NewMemorySequencer(), limitMB*1024*1024, 5, false)
+		case "DataCenter":
+			dc = topo.GetOrCreateDataCenter(parts[1])
+		case "Rack":
+			rack = dc.GetOrCreateRack(parts[1])
+		case "DataNode":
+			host, portString, _ := net.SplitHostPort(parts[1])
+			port, _ := strconv.Atoi(portString)
+			dn = rack.GetOrCreateDataNode(host, port, port+10000, parts[1], nil)
+		case "Disk":
+			diskType = types.ToDiskType(parts[1][:strings.Index(parts[1], "(")])
+			maxVolumeCount, _ := strconv.Atoi(parts[1][strings.Index(parts[1], "/")+1:])
+			deltaDiskUsages := newDiskUsages()
+			deltaDiskUsages.getOrCreateDisk(diskType).maxVolumeCount = int64(maxVolumeCount)
+			dn.getOrCreateDisk(string(diskType)).UpAdjustDiskUsageDelta(deltaDiskUsages)
+		case "volume":
+			message := &master_pb.VolumeInformationMessage{}
+			if err := prototext.Unmarshal([]byte(line[len("volume "):]), message); err != nil {
+				t.Fatalf("parse %s: %v", line, err)
+			}
+			message.DiskType = string(diskType)
+			vi, err := storage.NewVolumeInfo(message)
+			if err != nil {
+				t.Fatalf("volume info %s: %v", line, err)
+			}
+			dn.AddOrUpdateVolume(vi)
+			topo.RegisterVolumeLayout(vi, dn)
+		}
+	}
+	if err := scanner.Err(); err != nil {
+		t.Fatalf("read %s: %v", fileName, err)
+	}
+	return topo
+}
+
+// simulateVolumeGrowth places count new volumes, and returns how many replicas each data node gets.
+func simulateVolumeGrowth(t *testing.T, topo *Topology, vg *VolumeGrowth, option *VolumeGrowOption, count int) map[string]int {
+	placed := make(map[string]int)
+	for i := 0; i < count; i++ {
+		servers, err := vg.findEmptySlotsForOneVolume(topo, option)
+		if err != nil {
+			t.Fatalf("place volume %d: %v", i, err)
+		}
+		vid := topo.GetMaxVolumeId().Next()
+		for _, server := range servers {
+			vi := storage.VolumeInfo{
+				Id:               vid,
+				Collection:       option.Collection,
+				ReplicaPlacement: option.ReplicaPlacement,
+				Ttl:              option.Ttl,
+				Version:          needle.CurrentVersion,
+				DiskType:         option.DiskType.String(),
+			}
+			server.AddOrUpdateVolume(vi)
+			topo.RegisterVolumeLayout(vi, server)
+			placed[server.Url()]++
+		}
+	}
+	return placed
+}
+
+func newSimulationOption(t *testing.T, replication string) *VolumeGrowOption {
+	rp, err := super_block.NewReplicaPlacementFromString(replication)
+	if err != nil {
+		t.Fatalf("replication %s: %v", replication, err)
+	}
+	return &VolumeGrowOption{
+		Collection:       "simulated",
+		ReplicaPlacement: rp,
+		Ttl:              needle.EMPTY_TTL,
+	}
+}
+
+const (
+	smallNode = "192.168.1.2:8080" // 154 free slots
+	largeNode = "192.168.1.5:8080" // 2701 free slots
+)
+
+func TestVolumePlacementFreeSlots(t *testing.T) {
+	topo := loadVolumeList(t, "../shell/volume.list.txt")
+	vg := NewDefaultVolumeGrowth()
+
+	placed := simulateVolumeGrowth(t, topo, vg, newSimulationOption(t, "000"), 500)
+	if placed[largeNode] < 3*placed[smallNode] {
+		t.Fatalf("free slots are not weighed: %v", placed)
+	}
+
+	// replicas in other data centers
+	placed = simulateVolumeGrowth(t, topo, vg, newSimulationOption(t, "100"), 100)
+	total := 0
+	for _, count := range placed {
+		total += count
+	}
+	if total != 200 {
+		t.Fatalf("placed %d replicas: %v", total, placed)
+	}
+}
+
+func TestVolumePlacementExclusion(t *testing.T) {
+	topo := loadVolumeList(t, "../shell/volume.list.txt")
+	vg := NewDefaultVolumeGrowth()
+	vg.Placement.ExcludeNode(largeNode, true)
+	vg.Placement.SetNodeWeight(smallNode, 0)
+
+	placed := simulateVolumeGrowth(t, topo, vg, newSimulationOption(t, "000"), 200)
+	if placed[largeNode] != 0 || placed[smallNode] != 0 {
+		t.Fatalf("excluded data nodes get volumes: %v", placed)
+	}
+
+	vg.Placement.ExcludeNode(largeNode, false)
+	placed = simulateVolumeGrowth(t, topo, vg, newSimulationOption(t, "000"), 200)
+	if placed[largeNode] == 0 {
+		t.Fatalf("data node is still excluded: %v", placed)
+	}
+}
+
+func TestVolumePlacementFreeCapacity(t *testing.T) {
+	topo := loadVolumeList(t, "../shell/volume.list.txt")
+	vg := &VolumeGrowth{Placement: NewVolumePlacement(FreeCapacityPlacement{})}
+	for _, dn := range topo.listDataNodes() {
+		freeBytes := 100 * topo.volumeSizeLimit
+		if dn.Url() == largeNode {
+			// most free slots, but the disk is full
+			freeBytes = topo.volumeSizeLimit / 2
+		}
+		dn.UpdateLoadStats(map[string]uint64{"": freeBytes}, 0)
+	}
+
+	placed := simulateVolumeGrowth(t, topo, vg, newSimulationOption(t, "000"), 300)
+	if placed[largeNode] > placed[smallNode]/10 {
+		t.Fatalf("free disk space is not weighed: %v", placed)
+	}
+}
+
+func TestVolumePlacementCollectionSpread(t *testing.T) {
+	topo := loadVolumeList(t, "../shell/volume.list.txt")
+	vg := &VolumeGrowth{Placement: NewVolumePlacement(CollectionSpreadPlacement{})}
+
+	placed := simulateVolumeGrowth(t, topo, vg, newSimulationOption(t, "000"), 250)
+	if len(placed) != 5 {
+		t.Fatalf("not spread to all data nodes: %v", placed)
+	}
+	for node, count := range placed {
+		if count < 35 || count > 65 {
+			t.Fatalf("%s gets %d volumes: %v", node, count, placed)
+		}
+	}
+}
+
+func TestVolumePlacementWriteLoad(t *testing.T) {
+	topo := loadVolumeList(t, "../shell/volume.list.txt")
+	vg := &VolumeGrowth{Placement: NewVolumePlacement(CollectionSpreadPlacement{}, WriteLoadPlacement{ReferenceBytesPerSecond: 16 * 1024 * 1024})}
+	for _, dn := range topo.listDataNodes() {
+		var writeBytesPerSecond uint64
+		if dn.Url() == largeNode {
+			writeBytesPerSecond = 16 * 1024 * 1024 * 100
+		}
+		dn.UpdateLoadStats(map[string]uint64{"": 100 * topo.volumeSizeLimit}, writeBytesPerSecond)
+	}
+
+	placed := simulateVolumeGrowth(t, topo, vg, newSimulationOption(t, "000"), 250)
+	if placed[largeNode] > placed[smallNode]/4 {
+		t.Fatalf("write load is not weighed: %v", placed)
+	}
+}
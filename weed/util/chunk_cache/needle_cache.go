@@ -0,0 +1,252 @@
+package chunk_cache
+
+import (
+	"math/rand"
+	"strconv"
+	"sync"
+	"time"
+
+	"github.com/karlseguin/ccache/v2"
+
+	"github.com/gateway-dao/seaweedfs/weed/stats"
+	"github.com/gateway-dao/seaweedfs/weed/storage"
+	"github.com/gateway-dao/seaweedfs/weed/storage/needle"
+	"github.com/gateway-dao/seaweedfs/weed/storage/types"
+	"github.com/gateway-dao/seaweedfs/weed/util"
+)
+
+/*
+NeedleCache is the read cache of a volume server, for popular small needles.
+
+Needles are kept in memory, and optionally in an on disk layer, usually on a
+SSD. A needle is only admitted when it was read at least twice recently,
+estimated by a TinyLFU frequency sketch, so one-off reads of cold needles do
+not evict the hot ones.
+
+The on disk layer can not delete entries. Each entry there is stamped with the
+volume id, the needle offset and an epoch of the volume, which changes when
+the volume is compacted or loaded again, and also with every restart.
+*/
+type NeedleCache struct {
+	memCache      *ccache.Cache
+	diskCache     *OnDiskCacheLayer
+	diskLock      sync.RWMutex
+	sketch        *frequencySketch
+	maxNeedleSize types.Size
+
+	epochBase uint64
+	epochs    map[needle.VolumeId]uint64
+	epochLock sync.RWMutex
+}
+
+var _ storage.NeedleCache = &NeedleCache{}
+
+const (
+	needleCacheAdmission = 2 // reads in the sketch window before a needle is cached
+
+	diskEntryHeaderSize = 4 + 8 + 8 // volume id, epoch, offset
+)
+
+type cachedNeedle struct {
+	offset int64
+	record []byte
+}
+
+func (c *cachedNeedle) Size() int64 {
+	return int64(len(c.record)) + 64
+}
+
+func NewNeedleCache(memorySizeMB int64, dir string, diskSizeMB int64, maxNeedleSizeKB int64) *NeedleCache {
+	c := &NeedleCache{
+		maxNeedleSize: types.Size(maxNeedleSizeKB * 1024),
+		epochBase:     rand.Uint64(),
+		epochs:        make(map[needle.VolumeId]uint64),
+	}
+	cacheSize := memorySizeMB * 1024 * 1024
+	if memorySizeMB > 0 {
+		c.memCache = ccache.New(ccache.Configure().MaxSize(cacheSize))
+	}
+	if dir != "" && diskSizeMB > 0 {
+		c.diskCache = NewOnDiskCacheLayer(dir, "needle", diskSizeMB*1024*1024, 3)
+		cacheSize += diskSizeMB * 1024 * 1024
+	}
+	// track about 4 times the needles that fit into the cache, assuming 4KB needles
+	c.sketch = newFrequencySketch(cacheSize / 4096 * 4)
+	return c
+}
+
+func (c *NeedleCache) MaxNeedleSize() types.Size {
+	return c.maxNeedleSize
+}
+
+func (c *NeedleCache) GetNeedle(vid needle.VolumeId, id types.NeedleId, offset int64) []byte {
+	c.sketch.increment(vid, id)
+
+	if c.memCache != nil {
+		if item := c.memCache.Get(needleCacheKey(vid, id)); item != nil {
+			if cached := item.Value().(*cachedNeedle); cached.offset == offset {
+				stats.VolumeServerNeedleCacheCounter.WithLabelValues("hit_memory").Inc()
+				return cached.record
+			}
+		}
+	}
+
+	if c.diskCache != nil {
+		c.diskLock.RLock()
+		data := c.diskCache.getChunk(id)
+		c.diskLock.RUnlock()
+		if len(data) > diskEntryHeaderSize &&
+			util.BytesToUint32(data[0:4]) == uint32(vid) &&
+			util.BytesToUint64(data[4:12]) == c.epoch(vid) &&
+			int64(util.BytesToUint64(data[12:20])) == offset {
+			stats.VolumeServerNeedleCacheCounter.WithLabelValues("hit_disk").Inc()
+			record := data[diskEntryHeaderSize:]
+			c.setMemory(vid, id, offset, record)
+			return record
+		}
+	}
+
+	stats.VolumeServerNeedleCacheCounter.WithLabelValues("miss").Inc()
+	return nil
+}
+
+// SetNeedle caches the record if it is read often enough. The record is shared
+// with the caller, and must not be modified.
+func (c *NeedleCache) SetNeedle(vid needle.VolumeId, id types.NeedleId, offset int64, record []byte) {
+	if c.sketch.estimate(vid, id) < needleCacheAdmission {
+		stats.VolumeServerNeedleCacheCounter.WithLabelValues("rejected").Inc()
+		return
+	}
+	stats.VolumeServerNeedleCacheCounter.WithLabelValues("admitted").Inc()
+
+	c.setMemory(vid, id, offset, record)
+
+	if c.diskCache != nil {
+		data := make([]byte, diskEntryHeaderSize+len(record))
+		util.Uint32toBytes(data[0:4], uint32(vid))
+		util.Uint64toBytes(data[4:12], c.epoch(vid))
+		util.Uint64toBytes(data[12:20], uint64(offset))
+		copy(data[diskEntryHeaderSize:], record)
+		c.diskLock.Lock()
+		c.diskCache.setChunk(id, data)
+		c.diskLock.Unlock()
+	}
+}
+
+func (c *NeedleCache) setMemory(vid needle.VolumeId, id types.NeedleId, offset int64, record []byte) {
+	if c.memCache == nil {
+		return
+	}
+	c.memCache.Set(needleCacheKey(vid, id), &cachedNeedle{offset: offset, record: record}, time.Hour)
+	stats.VolumeServerNeedleCacheGauge.Set(float64(c.memCache.ItemCount()))
+}
+
+func (c *NeedleCache) DeleteNeedle(vid needle.VolumeId, id types.NeedleId) {
+	if c.memCache != nil {
+		c.memCache.Delete(needleCacheKey(vid, id))
+	}
+}
+
+func (c *NeedleCache) DeleteVolume(vid needle.VolumeId) {
+	c.epochLock.Lock()
+	c.epochs[vid]++
+	c.epochLock.Unlock()
+	if c.memCache != nil {
+		c.memCache.DeletePrefix(vid.String() + ",")
+		stats.VolumeServerNeedleCacheGauge.Set(float64(c.memCache.ItemCount()))
+	}
+}
+
+func (c *NeedleCache) Shutdown() {
+	if c.memCache != nil {
+		c.memCache.Stop()
+	}
+	if c.diskCache != nil {
+		c.diskLock.Lock()
+		c.diskCache.shutdown()
+		c.diskLock.Unlock()
+	}
+}
+
+func (c *NeedleCache) epoch(vid needle.VolumeId) uint64 {
+	c.epochLock.RLock()
+	defer c.epochLock.RUnlock()
+	return c.epochBase + c.epochs[vid]
+}
+
+func needleCacheKey(vid needle.VolumeId, id types.NeedleId) string {
+	return vid.String() + "," + strconv.FormatUint(uint64(id), 16)
+}
+
+// frequencySketch is a count-min sketch of 4 bit counters, estimating how
+// often needles are read. All counters are halved after a sample period, so
+// the estimates follow recent reads, as in TinyLFU.
+type frequencySketch struct {
+	sync.Mutex
+	counters     []uint8 // two 4 bit counters per byte
+	mask         uint64
+	additions    int64
+	samplePeriod int64
+}
+
+func newFrequencySketch(expectedEntries int64) *frequencySketch {
+	width := int64(1 << 12)
+	for width < expectedEntries && width < 1<<26 {
+		width <<= 1
+	}
+	return &frequencySketch{
+		counters:     make([]uint8, width/2),
+		mask:         uint64(width - 1),
+		samplePeriod: 10 * width,
+	}
+}
+
+func (s *frequencySketch) indexes(vid needle.VolumeId, id types.NeedleId) (indexes [4]uint64) {
+	h := uint64(id) ^ uint64(vid)<<40
+	for i := range indexes {
+		// splitmix64, with a different seed for each row
+		h += 0x9e3779b97f4a7c15
+		z := h
+		z = (z ^ (z >> 30)) * 0xbf58476d1ce4e5b9
+		z = (z ^ (z >> 27)) * 0x94d049bb133111eb
+		indexes[i] = (z ^ (z >> 31)) & s.mask
+	}
+	return
+}
+
+func (s *frequencySketch) counter(index uint64) uint8 {
+	return (s.counters[index/2] >> ((index % 2) * 4)) & 0x0f
+}
+
+func (s *frequencySketch) increment(vid needle.VolumeId, id types.NeedleId) {
+	indexes := s.indexes(vid, id)
+
+	s.Lock()
+	defer s.Unlock()
+	for _, index := range indexes {
+		if s.counter(index) < 15 {
+			s.counters[index/2] += 1 << ((index % 2) * 4)
+		}
+	}
+	s.additions++
+	if s.additions >= s.samplePeriod {
+		for i, b := range s.counters {
+			s.counters[i] = (b >> 1) & 0x77
+		}
+		s.additions /= 2
+	}
+}
+
+func (s *frequencySketch) estimate(vid needle.VolumeId, id types.NeedleId) uint8 {
+	indexes := s.indexes(vid, id)
+
+	s.Lock()
+	defer s.Unlock()
+	frequency := uint8(15)
+	for _, index := range indexes {
+		if c := s.counter(index); c < frequency {
+			frequency = c
+		}
+	}
+	return frequency
+}
@@ -0,0 +1,47 @@
+package chunk_cache
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/gateway-dao/seaweedfs/weed/storage/needle"
+	"github.com/gateway-dao/seaweedfs/weed/storage/types"
+)
+
+func TestNeedleCache(t *testing.T) {
+	cache := NewNeedleCache(1, t.TempDir(), 1, 64)
+	defer cache.Shutdown()
+
+	vid, id, offset := needle.VolumeId(3), types.NeedleId(7), int64(4096)
+	record := []byte("needle record")
+
+	// a needle read once is not admitted
+	if cache.GetNeedle(vid, id, offset) != nil {
+		t.Fatalf("empty cache should miss")
+	}
+	cache.SetNeedle(vid, id, offset, record)
+	if cache.GetNeedle(vid, id, offset) != nil {
+		t.Fatalf("needle read once should not be cached")
+	}
+	cache.SetNeedle(vid, id, offset, record)
+	if got := cache.GetNeedle(vid, id, offset); !bytes.Equal(got, record) {
+		t.Fatalf("needle read twice should be cached, got %q", got)
+	}
+
+	// moved needles miss
+	if cache.GetNeedle(vid, id, offset+8) != nil {
+		t.Fatalf("needle at another offset should miss")
+	}
+
+	// the disk layer serves needles deleted from memory
+	cache.DeleteNeedle(vid, id)
+	if got := cache.GetNeedle(vid, id, offset); !bytes.Equal(got, record) {
+		t.Fatalf("needle should be read from the disk layer, got %q", got)
+	}
+
+	// compaction invalidates both layers
+	cache.DeleteVolume(vid)
+	if cache.GetNeedle(vid, id, offset) != nil {
+		t.Fatalf("needles of a compacted volume should miss")
+	}
+}
@@ -4,9 +4,10 @@ import (
 	"bytes"
 	"fmt"
 	"strings"
+	"sync"
 
 	"github.com/gateway-dao/seaweedfs/weed/glog"
-	// "github.com/klauspost/compress/zstd"
+	"github.com/klauspost/compress/zstd"
 )
 
 var (
@@ -60,11 +61,9 @@ func DecompressData(input []byte) ([]byte, error) {
 	if IsGzippedContent(input) {
 		return ungzipData(input)
 	}
-	/*
-		if IsZstdContent(input) {
-			return unzstdData(input)
-		}
-	*/
+	if IsZstdContent(input) {
+		return unzstdData(input)
+	}
 	return input, UnsupportedCompression
 }
 
@@ -75,17 +74,77 @@ func IsGzippedContent(data []byte) bool {
 	return data[0] == 31 && data[1] == 139
 }
 
-/*
+// Compressor compresses data not compressed by the client, e.g. GzipData or ZstdData.
+type Compressor func(input []byte) ([]byte, error)
+
 var zstdEncoder, _ = zstd.NewWriter(nil)
 
 func ZstdData(input []byte) ([]byte, error) {
 	return zstdEncoder.EncodeAll(input, nil), nil
 }
 
-var decoder, _ = zstd.NewReader(nil)
+/*
+Zstd dictionaries are trained from sampled needles of a collection.
+The dictionary id is written in the zstd frame header of each compressed
+needle, so the decoder picks the right dictionary without any other hint.
+All dictionaries ever added are kept, to read needles compressed by older ones.
+*/
+var (
+	zstdDecoder, _      = zstd.NewReader(nil)
+	zstdDictionaries    = make(map[uint32][]byte)
+	zstdDictEncoders    = make(map[uint32]*zstd.Encoder)
+	zstdDictionariesMux sync.RWMutex
+)
+
+// AddZstdDictionary makes the dictionary available for compression and decompression.
+func AddZstdDictionary(dictionary []byte) (dictId uint32, err error) {
+	info, err := zstd.InspectDictionary(dictionary)
+	if err != nil {
+		return 0, fmt.Errorf("invalid zstd dictionary: %v", err)
+	}
+	dictId = info.ID()
+
+	zstdDictionariesMux.Lock()
+	defer zstdDictionariesMux.Unlock()
+	if _, found := zstdDictionaries[dictId]; found {
+		return dictId, nil
+	}
+	encoder, err := zstd.NewWriter(nil, zstd.WithEncoderDict(dictionary))
+	if err != nil {
+		return 0, fmt.Errorf("zstd dictionary %d: %v", dictId, err)
+	}
+	dictionaries := make([][]byte, 0, len(zstdDictionaries)+1)
+	for _, d := range zstdDictionaries {
+		dictionaries = append(dictionaries, d)
+	}
+	dictionaries = append(dictionaries, dictionary)
+	decoder, err := zstd.NewReader(nil, zstd.WithDecoderDicts(dictionaries...))
+	if err != nil {
+		encoder.Close()
+		return 0, fmt.Errorf("zstd dictionary %d: %v", dictId, err)
+	}
+	zstdDictionaries[dictId] = dictionary
+	zstdDictEncoders[dictId] = encoder
+	// no decoding is in flight, unzstdData holds the read lock while decoding
+	zstdDecoder.Close()
+	zstdDecoder = decoder
+	return dictId, nil
+}
+
+func ZstdDataWithDictionary(input []byte, dictId uint32) ([]byte, error) {
+	zstdDictionariesMux.RLock()
+	encoder, found := zstdDictEncoders[dictId]
+	zstdDictionariesMux.RUnlock()
+	if !found {
+		return nil, fmt.Errorf("zstd dictionary %d not found", dictId)
+	}
+	return encoder.EncodeAll(input, nil), nil
+}
 
 func unzstdData(input []byte) ([]byte, error) {
-	return decoder.DecodeAll(input, nil)
+	zstdDictionariesMux.RLock()
+	defer zstdDictionariesMux.RUnlock()
+	return zstdDecoder.DecodeAll(input, nil)
 }
 
 func IsZstdContent(data []byte) bool {
@@ -94,7 +153,6 @@ func IsZstdContent(data []byte) bool {
 	}
 	return data[3] == 0xFD && data[2] == 0x2F && data[1] == 0xB5 && data[0] == 0x28
 }
-*/
 
 /*
 * Default not to compressed since compression can be done on client side.
@@ -0,0 +1,67 @@
+package util
+
+import (
+	"bytes"
+	"fmt"
+	"testing"
+
+	"github.com/klauspost/compress/dict"
+)
+
+func TestZstdDictionary(t *testing.T) {
+	var samples [][]byte
+	for i := 0; i < 1000; i++ {
+		samples = append(samples, []byte(fmt.Sprintf(`{"id":%d,"level":"info","service":"volume","message":"request %d served","status":%d}`, i, i*7, 200+i%3)))
+	}
+	dictionary, err := dict.BuildZstdDict(samples, dict.Options{MaxDictSize: 4096, HashBytes: 6})
+	if err != nil {
+		t.Fatalf("build dictionary: %v", err)
+	}
+	dictId, err := AddZstdDictionary(dictionary)
+	if err != nil {
+		t.Fatalf("add dictionary: %v", err)
+	}
+
+	input := samples[42]
+	withDictionary, err := ZstdDataWithDictionary(input, dictId)
+	if err != nil {
+		t.Fatalf("compress: %v", err)
+	}
+	withoutDictionary, _ := ZstdData(input)
+	if len(withDictionary) >= len(withoutDictionary) {
+		t.Errorf("dictionary does not help: %d >= %d bytes", len(withDictionary), len(withoutDictionary))
+	}
+
+	for _, compressed := range [][]byte{withDictionary, withoutDictionary} {
+		if !IsZstdContent(compressed) {
+			t.Fatalf("not detected as zstd")
+		}
+		uncompressed, err := DecompressData(compressed)
+		if err != nil {
+			t.Fatalf("decompress: %v", err)
+		}
+		if !bytes.Equal(uncompressed, input) {
+			t.Fatalf("decompressed data mismatch")
+		}
+	}
+
+	// adding a dictionary replaces the decoder, which still reads the older dictionaries
+	var otherSamples [][]byte
+	for i := 0; i < 1000; i++ {
+		otherSamples = append(otherSamples, []byte(fmt.Sprintf(`<event seq="%d"><user>u%d</user><action>login</action></event>`, i, i%17)))
+	}
+	otherDictionary, err := dict.BuildZstdDict(otherSamples, dict.Options{MaxDictSize: 4096, HashBytes: 6})
+	if err != nil {
+		t.Fatalf("build other dictionary: %v", err)
+	}
+	if _, err = AddZstdDictionary(otherDictionary); err != nil {
+		t.Fatalf("add other dictionary: %v", err)
+	}
+	uncompressed, err := DecompressData(withDictionary)
+	if err != nil {
+		t.Fatalf("decompress with older dictionary: %v", err)
+	}
+	if !bytes.Equal(uncompressed, input) {
+		t.Fatalf("decompressed data mismatch")
+	}
+}